@@ -0,0 +1,9 @@
+# Audit the last week of warnings and errors
+data "jellyfin_activity_log" "recent" {
+  min_date = timeadd(plantimestamp(), "-168h")
+  limit    = 500
+}
+
+output "problems" {
+  value = [for e in data.jellyfin_activity_log.recent.entries : "${e.date}: ${e.name}" if contains(["Warning", "Error", "Critical"], e.severity)]
+}
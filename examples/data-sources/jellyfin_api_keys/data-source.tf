@@ -0,0 +1,9 @@
+# With key_name_prefix = "terraform-" set on the provider,
+# list only the keys created through Terraform
+data "jellyfin_api_keys" "managed" {
+  managed_only = true
+}
+
+output "managed_key_names" {
+  value = [for k in data.jellyfin_api_keys.managed.keys : k.app_name]
+}
@@ -0,0 +1,7 @@
+data "jellyfin_auth_info" "current" {}
+
+# Only look up the signed-in user when the provider authenticated with a password,
+# since API keys aren't tied to a user
+data "jellyfin_me" "current" {
+  count = data.jellyfin_auth_info.current.method == "password" ? 1 : 0
+}
@@ -0,0 +1,5 @@
+data "jellyfin_collection_types" "available" {}
+
+output "collection_types" {
+  value = data.jellyfin_collection_types.available.collection_types
+}
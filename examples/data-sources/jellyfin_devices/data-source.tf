@@ -0,0 +1,6 @@
+data "jellyfin_devices" "all" {}
+
+# Find devices nobody has used this year
+output "stale_device_ids" {
+  value = [for d in data.jellyfin_devices.all.devices : d.id if d.date_last_activity < "2024-01-01"]
+}
@@ -0,0 +1,28 @@
+data "jellyfin_item" "movie" {
+  id = "0f9e8d7c6b5a49382716a5b4c3d2e1f0"
+}
+
+# Fields without a dedicated attribute are available through full_json
+output "production_year" {
+  value = jsondecode(data.jellyfin_item.movie.full_json).ProductionYear
+}
+
+# Wait up to ten minutes for a library scan to add the item before failing
+data "jellyfin_item" "new_release" {
+  id       = "7a6b5c4d3e2f41908f7e6d5c4b3a2918"
+  wait_for = true
+  timeout  = "10m"
+}
+
+# Report the codec and resolution of the item's video
+data "jellyfin_item" "movie_streams" {
+  id                    = "0f9e8d7c6b5a49382716a5b4c3d2e1f0"
+  include_media_streams = true
+}
+
+output "video_streams" {
+  value = [
+    for stream in data.jellyfin_item.movie_streams.media_streams :
+    "${stream.codec} ${stream.width}x${stream.height}" if stream.type == "Video"
+  ]
+}
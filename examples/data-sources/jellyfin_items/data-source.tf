@@ -0,0 +1,26 @@
+# Every movie on the server, across all libraries
+data "jellyfin_items" "movies" {
+  include_item_types = ["Movie"]
+  recursive          = true
+}
+
+# Count movies per library
+output "movies_per_library" {
+  value = {
+    for library_id, items in {
+      for item in data.jellyfin_items.movies.items : coalesce(item.library_id, "none") => item.id...
+    } : library_id => length(items)
+  }
+}
+
+# Drive collection membership from a search rather than hardcoded IDs
+data "jellyfin_items" "alien" {
+  search_term        = "Alien"
+  include_item_types = ["Movie"]
+  recursive          = true
+}
+
+resource "jellyfin_collection" "alien" {
+  name     = "Alien Anthology"
+  item_ids = data.jellyfin_items.alien.items[*].id
+}
@@ -0,0 +1,14 @@
+data "jellyfin_libraries" "all" {}
+
+output "library_count" {
+  value = data.jellyfin_libraries.all.library_count
+}
+
+# e.g. { movies = 2, tvshows = 1 }
+output "libraries_by_type" {
+  value = data.jellyfin_libraries.all.count_by_type
+}
+
+output "library_names" {
+  value = [for library in data.jellyfin_libraries.all.libraries : library.name]
+}
@@ -0,0 +1,13 @@
+# Look up a library by name
+data "jellyfin_library" "movies" {
+  name = "Movies"
+}
+
+output "movies_library_id" {
+  value = data.jellyfin_library.movies.id
+}
+
+# Report how much storage the library uses
+output "movies_size_gb" {
+  value = data.jellyfin_library.movies.size_bytes / 1e9
+}
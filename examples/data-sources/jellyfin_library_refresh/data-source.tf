@@ -0,0 +1,11 @@
+data "jellyfin_library" "movies" {
+  name = "Movies"
+}
+
+data "jellyfin_library_refresh" "movies" {
+  item_id = data.jellyfin_library.movies.id
+}
+
+output "movies_refresh_done" {
+  value = data.jellyfin_library_refresh.movies.refresh_status == "Idle"
+}
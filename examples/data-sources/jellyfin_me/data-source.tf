@@ -0,0 +1,9 @@
+data "jellyfin_me" "current" {}
+
+# Fail the run if the automation account has been idle for over 30 days
+check "automation_account_active" {
+  assert {
+    condition     = timecmp(data.jellyfin_me.current.last_activity_date, timeadd(plantimestamp(), "-720h")) >= 0
+    error_message = "The automation account hasn't been active in the last 30 days."
+  }
+}
@@ -0,0 +1,9 @@
+data "jellyfin_network_config" "current" {}
+
+# Fail the plan if the server is reachable from outside the local network
+check "remote_access_disabled" {
+  assert {
+    condition     = !data.jellyfin_network_config.current.enable_remote_access
+    error_message = "Remote access must be disabled on this server."
+  }
+}
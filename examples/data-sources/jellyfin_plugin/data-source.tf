@@ -0,0 +1,17 @@
+data "jellyfin_plugin" "webhook" {
+  name = "Webhook"
+}
+
+# Only act when a newer version is available
+output "webhook_update" {
+  value = data.jellyfin_plugin.webhook.update_available ? data.jellyfin_plugin.webhook.latest_version : null
+}
+
+# Look up a plugin by its GUID
+data "jellyfin_plugin" "by_id" {
+  id = "71552a5a-5c5c-4350-a2ae-ebe451a30173"
+}
+
+output "webhook_status" {
+  value = data.jellyfin_plugin.by_id.status
+}
@@ -0,0 +1,11 @@
+# List every plugin, installed or available
+data "jellyfin_plugin_catalog" "all" {}
+
+# Only installed plugins, e.g. to report outdated ones
+data "jellyfin_plugin_catalog" "installed" {
+  installed = true
+}
+
+output "outdated_plugins" {
+  value = [for p in data.jellyfin_plugin_catalog.installed.plugins : p.name if p.update_available]
+}
@@ -0,0 +1,6 @@
+data "jellyfin_plugins" "all" {}
+
+# Plugins waiting for a server restart to take effect
+output "plugins_pending_restart" {
+  value = [for p in data.jellyfin_plugins.all.plugins : p.name if p.status == "Restart"]
+}
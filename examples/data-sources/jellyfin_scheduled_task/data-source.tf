@@ -0,0 +1,15 @@
+data "jellyfin_scheduled_task" "library_scan" {
+  name = "Scan Media Library"
+}
+
+# Warn when the last library scan failed
+check "library_scan" {
+  assert {
+    condition     = data.jellyfin_scheduled_task.library_scan.last_execution_status != "Failed"
+    error_message = "The last library scan failed: ${coalesce(data.jellyfin_scheduled_task.library_scan.last_execution_error_message, "no error message")}"
+  }
+}
+
+output "library_scan_last_finished" {
+  value = data.jellyfin_scheduled_task.library_scan.last_execution_end_time
+}
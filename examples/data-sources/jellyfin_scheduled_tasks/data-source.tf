@@ -0,0 +1,13 @@
+# List the library tasks, such as scans and chapter image extraction
+data "jellyfin_scheduled_tasks" "library" {
+  category = "Library"
+}
+
+output "library_task_keys" {
+  value = [for task in data.jellyfin_scheduled_tasks.library.tasks : task.key]
+}
+
+# Discover which categories the server has
+output "task_categories" {
+  value = data.jellyfin_scheduled_tasks.library.categories
+}
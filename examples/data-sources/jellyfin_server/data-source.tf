@@ -0,0 +1,10 @@
+data "jellyfin_server" "current" {}
+
+output "jellyfin_version" {
+  value = data.jellyfin_server.current.version
+}
+
+# Null when the server version doesn't report its start time
+output "jellyfin_uptime_seconds" {
+  value = data.jellyfin_server.current.uptime_seconds
+}
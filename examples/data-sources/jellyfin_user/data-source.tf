@@ -0,0 +1,13 @@
+# Look up a user by name
+data "jellyfin_user" "by_name" {
+  name = "alice"
+}
+
+# Look up a user by ID
+data "jellyfin_user" "by_id" {
+  id = "00000000000000000000000000000000"
+}
+
+output "alice_is_admin" {
+  value = data.jellyfin_user.by_name.is_administrator
+}
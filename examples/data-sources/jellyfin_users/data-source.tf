@@ -0,0 +1,18 @@
+# All users whose name contains "kids", e.g. to apply a parental-control policy
+data "jellyfin_users" "kids" {
+  name_contains = "kids"
+}
+
+output "kid_user_ids" {
+  value = data.jellyfin_users.kids.users[*].id
+}
+
+# Enabled administrators, keyed by name
+data "jellyfin_users" "admins" {
+  is_administrator = true
+  is_disabled      = false
+}
+
+output "admin_ids_by_name" {
+  value = { for user in data.jellyfin_users.admins.users : user.name => user.id }
+}
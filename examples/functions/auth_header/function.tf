@@ -0,0 +1,16 @@
+variable "jellyfin_api_key" {
+  type      = string
+  sensitive = true
+}
+
+# Call an endpoint the provider doesn't manage with the http provider
+data "http" "sessions" {
+  url = "https://your-jellyfin-server.com/Sessions"
+
+  request_headers = {
+    Accept = "application/json"
+    Authorization = provider::jellyfin::auth_header(
+      "Terraform", "Terraform Provider", "terraform-provider-jellyfin", "1.0.0", var.jellyfin_api_key
+    )
+  }
+}
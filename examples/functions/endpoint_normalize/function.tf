@@ -0,0 +1,9 @@
+variable "jellyfin_url" {
+  type    = string
+  default = "HTTPS://Media.Example.com/jellyfin/"
+}
+
+# A canonical link to the server's web client, "https://media.example.com/jellyfin/web/"
+output "jellyfin_web_url" {
+  value = "${provider::jellyfin::endpoint_normalize(var.jellyfin_url)}/web/"
+}
@@ -0,0 +1,8 @@
+# Combine library IDs contributed by several modules into one stable list
+output "enabled_folders" {
+  value = provider::jellyfin::merge_folders(
+    module.movies.library_ids,
+    module.shows.library_ids,
+    ["f137a2dd21bbc1b99aa5c0f6bf02a805"],
+  )
+}
@@ -0,0 +1,8 @@
+resource "jellyfin_api_key" "ci" {
+  app_name = "ci"
+}
+
+# When the key should be rotated, 90 days after it was created
+output "ci_key_rotate_at" {
+  value = provider::jellyfin::next_rotation(jellyfin_api_key.ci.date_created, "2160h")
+}
@@ -0,0 +1,9 @@
+data "jellyfin_api_keys" "all" {}
+
+# API keys created more than 90 days ago
+output "stale_api_key_names" {
+  value = [
+    for key in data.jellyfin_api_keys.all.keys : key.app_name
+    if timecmp(timeadd(provider::jellyfin::parse_date(key.date_created), "2160h"), plantimestamp()) < 0
+  ]
+}
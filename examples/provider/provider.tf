@@ -3,3 +3,73 @@ provider "jellyfin" {
   username = "your-username"
   password = "your-password"
 }
+
+# Alternatively, authenticate with an API key kept in a file, for example one
+# rotated by a secrets sidecar
+provider "jellyfin" {
+  alias        = "api_key"
+  endpoint     = "https://your-jellyfin-server.com"
+  api_key_file = "/run/secrets/jellyfin-api-key"
+}
+
+# Read credentials from secrets mounted as files, for example in CI
+provider "jellyfin" {
+  alias         = "mounted_secrets"
+  endpoint      = "https://your-jellyfin-server.com"
+  username_file = "/run/secrets/jellyfin-username"
+  password_file = "/run/secrets/jellyfin-password"
+}
+
+# Mark API keys created through Terraform so they can be told apart from
+# keys created by hand
+provider "jellyfin" {
+  alias           = "tagged"
+  endpoint        = "https://your-jellyfin-server.com"
+  username        = "your-username"
+  password        = "your-password"
+  key_name_prefix = "terraform-"
+}
+
+# Fail fast with a clear error when the server is still starting up, for
+# example when it is deployed in the same run
+provider "jellyfin" {
+  alias                            = "healthy"
+  endpoint                         = "https://your-jellyfin-server.com"
+  username                         = "your-username"
+  password                         = "your-password"
+  require_healthy                  = true
+  require_startup_wizard_completed = true
+  health_check_timeout             = "10s"
+}
+
+# Sign in with Quick Connect on servers that only allow it. Run Terraform with
+# TF_LOG=WARN to see the code, then approve it in a signed-in Jellyfin client
+provider "jellyfin" {
+  alias                 = "quick_connect"
+  endpoint              = "https://your-jellyfin-server.com"
+  auth_method           = "quickconnect"
+  quick_connect_timeout = "10m"
+}
+
+# Send extra headers required by a reverse proxy in front of the server, such
+# as Cloudflare Access service token credentials
+provider "jellyfin" {
+  alias    = "behind_proxy"
+  endpoint = "https://your-jellyfin-server.com"
+  username = "your-username"
+  password = "your-password"
+
+  headers = {
+    "CF-Access-Client-Id"     = "your-client-id"
+    "CF-Access-Client-Secret" = "your-client-secret"
+  }
+}
+
+# Reach the server through a corporate proxy
+provider "jellyfin" {
+  alias     = "proxied"
+  endpoint  = "https://your-jellyfin-server.com"
+  username  = "your-username"
+  password  = "your-password"
+  proxy_url = "http://proxy.example.com:3128"
+}
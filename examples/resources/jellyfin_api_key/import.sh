@@ -1,2 +1,5 @@
 # Import an existing API key by its access token
 terraform import jellyfin_api_key.example <access_token>
+
+# Or by its app name, if no other key has the same name
+terraform import jellyfin_api_key.example app_name:<app_name>
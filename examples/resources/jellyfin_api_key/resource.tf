@@ -12,3 +12,13 @@ output "api_key_token" {
 output "api_key_created_at" {
   value = jellyfin_api_key.example.date_created
 }
+
+# Rotate a key without downtime: the replacement is created before the old
+# key is revoked, so consumers always have a valid token
+resource "jellyfin_api_key" "rotating" {
+  app_name = "Media Dashboard"
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
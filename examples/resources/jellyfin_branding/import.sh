@@ -0,0 +1,2 @@
+# The branding is a singleton, imported by its fixed ID
+terraform import jellyfin_branding.example branding
@@ -0,0 +1,9 @@
+resource "jellyfin_branding" "example" {
+  login_disclaimer = "Authorized users only. Contact the admin for an account."
+
+  custom_css = <<-CSS
+    .skinHeader { background: #101010; }
+  CSS
+
+  splashscreen_enabled = true
+}
@@ -0,0 +1,2 @@
+# Collections can be imported by their item ID
+terraform import jellyfin_collection.example a1b2c3d4e5f6a1b2c3d4e5f6a1b2c3d4
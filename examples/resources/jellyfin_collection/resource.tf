@@ -0,0 +1,16 @@
+# Group the movies of a franchise into a collection with a poster, listed in release order
+resource "jellyfin_collection" "example" {
+  name = "Alien Collection"
+  item_ids = [
+    "a1b2c3d4e5f6a1b2c3d4e5f6a1b2c3d4",
+    "b2c3d4e5f6a1b2c3d4e5f6a1b2c3d4e5",
+  ]
+
+  primary_image_url = "https://example.com/posters/alien-collection.jpg"
+}
+
+# Upload the poster from a local file instead
+resource "jellyfin_collection" "local_poster" {
+  name               = "Studio Ghibli"
+  primary_image_path = "${path.module}/posters/ghibli.png"
+}
@@ -0,0 +1,2 @@
+# Import a device by device ID
+terraform import jellyfin_device.old_tv <device_id>
@@ -0,0 +1,5 @@
+# Adopt an old device; running `terraform destroy -target=jellyfin_device.old_tv`
+# deregisters it and revokes its access token
+resource "jellyfin_device" "old_tv" {
+  device_id = "5e8f1c2a9b7d4e3f"
+}
@@ -0,0 +1,2 @@
+# Import custom display preferences by user ID, display preferences ID and client
+terraform import jellyfin_display_preferences.example <user_id>/usersettings/emby
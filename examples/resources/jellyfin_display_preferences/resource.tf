@@ -0,0 +1,11 @@
+# Give a user the same web client home screen on every browser
+resource "jellyfin_display_preferences" "example" {
+  user_id = jellyfin_user.example.id
+
+  custom_preferences = {
+    homesection0 = "smalllibrarytiles"
+    homesection1 = "resume"
+    homesection2 = "nextup"
+    homesection3 = "latestmedia"
+  }
+}
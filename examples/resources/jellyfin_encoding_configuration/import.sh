@@ -0,0 +1,2 @@
+# The encoding configuration is a singleton, imported by its fixed ID
+terraform import jellyfin_encoding_configuration.example encoding_configuration
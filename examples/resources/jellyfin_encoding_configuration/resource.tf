@@ -0,0 +1,8 @@
+# Transcode with VA-API on an Intel or AMD GPU, keeping temporary files on
+# a dedicated cache volume.
+resource "jellyfin_encoding_configuration" "example" {
+  hardware_acceleration_type = "vaapi"
+  enable_hardware_encoding   = true
+  transcoding_temp_path      = "/cache/transcodes"
+  encoder_preset             = "veryfast"
+}
@@ -0,0 +1,2 @@
+# Import a library's image extraction settings by library ID
+terraform import jellyfin_image_extraction.example <library_id>
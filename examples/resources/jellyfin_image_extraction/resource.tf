@@ -0,0 +1,11 @@
+data "jellyfin_library" "movies" {
+  name = "Movies"
+}
+
+# Extract chapter thumbnails overnight instead of slowing down scans
+resource "jellyfin_image_extraction" "movies" {
+  library_id                                 = data.jellyfin_library.movies.id
+  enable_photos                              = false
+  enable_chapter_image_extraction            = true
+  extract_chapter_images_during_library_scan = false
+}
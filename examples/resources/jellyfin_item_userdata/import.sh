@@ -0,0 +1,2 @@
+# Import existing item user data by user ID and item ID
+terraform import jellyfin_item_userdata.example <user_id>/<item_id>
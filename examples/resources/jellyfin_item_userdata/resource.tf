@@ -0,0 +1,7 @@
+# Mark an item as a watched favorite for a user
+resource "jellyfin_item_userdata" "example" {
+  user_id     = "5b3d0a8f1e2c4d6fa7b8c9d0e1f2a3b4"
+  item_id     = "0f9e8d7c6b5a49382716a5b4c3d2e1f0"
+  is_favorite = true
+  played      = true
+}
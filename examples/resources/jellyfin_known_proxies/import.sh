@@ -0,0 +1,2 @@
+# Importing adopts every proxy currently trusted by the server
+terraform import jellyfin_known_proxies.example known_proxies
@@ -0,0 +1,8 @@
+# Trust the reverse proxy in front of Jellyfin so client addresses
+# are taken from X-Forwarded-For
+resource "jellyfin_known_proxies" "example" {
+  proxies = [
+    "10.0.0.5",
+    "172.18.0.0/16",
+  ]
+}
@@ -0,0 +1,11 @@
+variable "movie_paths" {
+  type    = list(string)
+  default = ["/media/movies", "/mnt/archive/movies"]
+}
+
+# Scan the libraries again whenever the configured paths change
+resource "jellyfin_library_scan" "after_paths_change" {
+  triggers = {
+    movie_paths = join(",", var.movie_paths)
+  }
+}
@@ -0,0 +1,2 @@
+# The network configuration is a singleton, imported by its fixed ID
+terraform import jellyfin_network_configuration.example network_configuration
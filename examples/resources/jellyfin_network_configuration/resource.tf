@@ -0,0 +1,6 @@
+# Serve Jellyfin under /jellyfin behind a reverse proxy that terminates TLS.
+# After the server restarts, the provider endpoint must include the base URL.
+resource "jellyfin_network_configuration" "example" {
+  base_url     = "/jellyfin"
+  enable_https = false
+}
@@ -0,0 +1,2 @@
+# The network ports are a singleton with a fixed ID
+terraform import jellyfin_network_ports.example network_ports
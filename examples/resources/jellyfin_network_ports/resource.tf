@@ -0,0 +1,11 @@
+# Advertise the standard ports to remote clients behind a reverse proxy.
+# The server must be restarted for port changes to take effect.
+resource "jellyfin_network_ports" "example" {
+  http_port         = 8096
+  https_port        = 8920
+  public_http_port  = 80
+  public_https_port = 443
+
+  # Setting this to false also blocks Terraform runs from outside the local network
+  enable_remote_access = true
+}
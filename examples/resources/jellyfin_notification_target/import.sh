@@ -0,0 +1,2 @@
+# Import an existing notification target by type and name
+terraform import jellyfin_notification_target.discord discord/media-updates
@@ -0,0 +1,11 @@
+# Send new movie and episode notifications to a Discord channel
+resource "jellyfin_notification_target" "discord" {
+  name = "media-updates"
+  type = "discord"
+  settings_json = jsonencode({
+    WebhookUri        = "https://discord.com/api/webhooks/123/abc"
+    EnableMovies      = true
+    EnableEpisodes    = true
+    SendAllProperties = false
+  })
+}
@@ -0,0 +1,2 @@
+# Import an installed plugin by name
+terraform import jellyfin_plugin.webhook Webhook
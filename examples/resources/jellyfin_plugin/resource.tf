@@ -0,0 +1,10 @@
+# Install the latest compatible version of a plugin
+resource "jellyfin_plugin" "open_subtitles" {
+  name = "Open Subtitles"
+}
+
+# Pin a plugin to a specific version
+resource "jellyfin_plugin" "webhook" {
+  name    = "Webhook"
+  version = "15.0.0.0"
+}
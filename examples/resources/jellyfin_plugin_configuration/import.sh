@@ -0,0 +1,2 @@
+# Import a plugin's configuration by plugin ID
+terraform import jellyfin_plugin_configuration.trakt <plugin_id>
@@ -0,0 +1,12 @@
+data "jellyfin_plugin" "trakt" {
+  name = "Trakt"
+}
+
+# Manage a plugin the provider doesn't model, using its own field names
+resource "jellyfin_plugin_configuration" "trakt" {
+  plugin_id = data.jellyfin_plugin.trakt.id
+
+  configuration_json = jsonencode({
+    TraktUsers = []
+  })
+}
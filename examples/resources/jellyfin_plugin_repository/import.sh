@@ -0,0 +1,2 @@
+# Import a plugin repository by name
+terraform import jellyfin_plugin_repository.intro_skipper "Intro Skipper"
@@ -0,0 +1,11 @@
+# Register a third-party repository, then install a plugin from it
+resource "jellyfin_plugin_repository" "intro_skipper" {
+  name = "Intro Skipper"
+  url  = "https://manifest.intro-skipper.org/manifest.json"
+}
+
+resource "jellyfin_plugin" "intro_skipper" {
+  name = "Intro Skipper"
+
+  depends_on = [jellyfin_plugin_repository.intro_skipper]
+}
@@ -0,0 +1,2 @@
+# Import a plugin's enabled state by plugin ID
+terraform import jellyfin_plugin_state.webhook <plugin_id>
@@ -0,0 +1,9 @@
+data "jellyfin_plugin" "webhook" {
+  name = "Webhook"
+}
+
+# Turn the plugin off without uninstalling it; takes effect after a restart
+resource "jellyfin_plugin_state" "webhook" {
+  plugin_id = data.jellyfin_plugin.webhook.id
+  enabled   = false
+}
@@ -0,0 +1,2 @@
+# Import a scheduled task's triggers by task ID
+terraform import jellyfin_scheduled_task_trigger.example <task_id>
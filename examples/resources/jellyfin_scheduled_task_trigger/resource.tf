@@ -0,0 +1,27 @@
+# Scan the libraries every 6 hours and once after each server start
+resource "jellyfin_scheduled_task_trigger" "library_scan" {
+  task = "RefreshLibrary"
+
+  triggers = [
+    {
+      type           = "interval"
+      interval_ticks = 216000000000 # 6 hours
+    },
+    {
+      type = "startup"
+    },
+  ]
+}
+
+# Clean the cache every Sunday at 03:00
+resource "jellyfin_scheduled_task_trigger" "clean_cache" {
+  task = "DeleteCacheFiles"
+
+  triggers = [
+    {
+      type              = "weekly"
+      day_of_week       = "Sunday"
+      time_of_day_ticks = 108000000000 # 03:00
+    },
+  ]
+}
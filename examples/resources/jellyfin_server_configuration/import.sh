@@ -0,0 +1,2 @@
+# The server configuration is a singleton with a fixed ID
+terraform import jellyfin_server_configuration.example server_configuration
@@ -0,0 +1,8 @@
+# Set the default web interface language and generate trickplay previews
+# every five seconds at two widths
+resource "jellyfin_server_configuration" "example" {
+  ui_culture = "en-US"
+
+  trickplay_interval          = 5000
+  trickplay_width_resolutions = [320, 640]
+}
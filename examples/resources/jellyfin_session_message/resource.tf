@@ -0,0 +1,15 @@
+variable "session_id" {
+  type = string
+}
+
+# Warn a session about upcoming maintenance; bump `notice` to send again
+resource "jellyfin_session_message" "maintenance" {
+  session_id = var.session_id
+  header     = "Server maintenance"
+  text       = "The server restarts in 10 minutes."
+  timeout_ms = 30000
+
+  triggers = {
+    notice = "2024-06-01"
+  }
+}
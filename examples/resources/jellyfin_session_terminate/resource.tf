@@ -0,0 +1,12 @@
+variable "stale_session_id" {
+  type = string
+}
+
+# Sign out a stale session; bump `rotation` to terminate again
+resource "jellyfin_session_terminate" "stale" {
+  session_id = var.stale_session_id
+
+  triggers = {
+    rotation = "2024-06-01"
+  }
+}
@@ -0,0 +1,2 @@
+# Import a user by ID
+terraform import jellyfin_user.example <user_id>
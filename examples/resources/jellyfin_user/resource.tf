@@ -0,0 +1,33 @@
+variable "guest_password" {
+  type      = string
+  sensitive = true
+}
+
+# A local user
+resource "jellyfin_user" "guest" {
+  name     = "guest"
+  password = var.guest_password
+}
+
+# A user who signs in through the LDAP plugin
+resource "jellyfin_user" "ldap" {
+  name             = "alice"
+  auth_provider_id = "Jellyfin.Plugin.LDAP_Auth.LdapAuthenticationProviderPlugin"
+}
+
+# Lock the account after three failed sign-ins and allow one session at a time
+resource "jellyfin_user" "kiosk" {
+  name     = "kiosk"
+  password = var.guest_password
+
+  login_attempts_before_lockout = 3
+  max_active_sessions           = 1
+}
+
+# Restrict an account to the local network
+resource "jellyfin_user" "local_only" {
+  name     = "living-room"
+  password = var.guest_password
+
+  enable_remote_access = false
+}
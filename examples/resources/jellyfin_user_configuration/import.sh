@@ -0,0 +1,2 @@
+# Import a user's playback preferences by user ID
+terraform import jellyfin_user_configuration.example <user_id>
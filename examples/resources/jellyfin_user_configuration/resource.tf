@@ -0,0 +1,7 @@
+# Prefer English audio and show German subtitles only when the audio differs
+resource "jellyfin_user_configuration" "example" {
+  user_id                      = "5b3d0a8f1e2c4d6fa7b8c9d0e1f2a3b4"
+  audio_language_preference    = "eng"
+  subtitle_language_preference = "ger"
+  subtitle_mode                = "Smart"
+}
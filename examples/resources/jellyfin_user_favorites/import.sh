@@ -0,0 +1,2 @@
+# Import favorites by user ID and a comma-separated list of item IDs
+terraform import jellyfin_user_favorites.example <user_id>/<item_id>,<item_id>
@@ -0,0 +1,9 @@
+# Favorite a few genres and a collection to steer recommendations
+resource "jellyfin_user_favorites" "example" {
+  user_id = "5b3d0a8f1e2c4d6fa7b8c9d0e1f2a3b4"
+  item_ids = [
+    "3c8e1b7a9d2f4e6a8b0c1d2e3f4a5b6c", # Science Fiction genre
+    "7d9f2c8b0e3a4f5b9c1d2e3f4a5b6c7d", # Documentary genre
+    "1a2b3c4d5e6f47a8b9c0d1e2f3a4b5c6", # Studio Ghibli collection
+  ]
+}
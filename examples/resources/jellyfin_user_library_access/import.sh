@@ -0,0 +1,2 @@
+# Import a user's library access by user ID
+terraform import jellyfin_user_library_access.example <user_id>
@@ -0,0 +1,14 @@
+data "jellyfin_user" "guest" {
+  name = "guest"
+}
+
+data "jellyfin_library" "movies" {
+  name = "Movies"
+}
+
+# Limit a user to a single library, leaving the rest of their policy untouched
+resource "jellyfin_user_library_access" "example" {
+  user_id            = data.jellyfin_user.guest.id
+  enable_all         = false
+  enabled_folder_ids = [data.jellyfin_library.movies.id]
+}
@@ -0,0 +1,14 @@
+variable "kids_password" {
+  type      = string
+  sensitive = true
+}
+
+data "jellyfin_user" "kids" {
+  name = "kids"
+}
+
+# Rotate a shared account's password by changing the variable
+resource "jellyfin_user_password" "kids" {
+  user_id  = data.jellyfin_user.kids.id
+  password = var.kids_password
+}
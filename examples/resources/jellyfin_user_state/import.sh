@@ -0,0 +1,2 @@
+# Import a user's account state by user ID
+terraform import jellyfin_user_state.example <user_id>
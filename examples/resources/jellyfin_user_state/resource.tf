@@ -0,0 +1,9 @@
+data "jellyfin_user" "former_employee" {
+  name = "jdoe"
+}
+
+# Disable the account when someone leaves, keeping their settings and history
+resource "jellyfin_user_state" "example" {
+  user_id     = data.jellyfin_user.former_employee.id
+  is_disabled = true
+}
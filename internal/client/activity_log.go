@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// activityLogPageSize is how many entries are requested per page.
+const activityLogPageSize = 100
+
+// ActivityLogEntry represents a single entry in the server's activity log.
+type ActivityLogEntry struct {
+	Id       int64  `json:"Id"`
+	Name     string `json:"Name"`
+	Overview string `json:"Overview"`
+	Type     string `json:"Type"`
+	ItemId   string `json:"ItemId"`
+	Date     string `json:"Date"`
+	UserId   string `json:"UserId"`
+
+	// Severity is "Trace", "Debug", "Information", "Warning", "Error" or "Critical".
+	Severity string `json:"Severity"`
+}
+
+// ActivityLogQueryResult represents the response from the activity log endpoint.
+type ActivityLogQueryResult struct {
+	Items            []ActivityLogEntry `json:"Items"`
+	TotalRecordCount int                `json:"TotalRecordCount"`
+	StartIndex       int                `json:"StartIndex"`
+}
+
+// getActivityLogPage queries a single page of activity log entries.
+func (c *Client) getActivityLogPage(ctx context.Context, startIndex, limit int, minDate string) (*ActivityLogQueryResult, error) {
+	params := url.Values{}
+	params.Set("startIndex", strconv.Itoa(startIndex))
+	params.Set("limit", strconv.Itoa(limit))
+	if minDate != "" {
+		params.Set("minDate", minDate)
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodGet, "/System/ActivityLog/Entries?"+params.Encode())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var result ActivityLogQueryResult
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetActivityLogEntries returns activity log entries, newest first, skipping the
+// first startIndex and paging through the results until limit entries have been
+// read or the log is exhausted. A limit of zero or less reads every entry. An empty
+// minDate returns entries of any age.
+func (c *Client) GetActivityLogEntries(ctx context.Context, startIndex, limit int, minDate string) ([]ActivityLogEntry, error) {
+	entries := []ActivityLogEntry{}
+
+	for start := startIndex; limit <= 0 || len(entries) < limit; {
+		pageSize := activityLogPageSize
+		if limit > 0 {
+			pageSize = min(pageSize, limit-len(entries))
+		}
+
+		result, err := c.getActivityLogPage(ctx, start, pageSize, minDate)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, result.Items...)
+		start += len(result.Items)
+
+		if len(result.Items) == 0 || start >= result.TotalRecordCount {
+			break
+		}
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+// activityLogServer serves a log of total entries, recording the query of each page request.
+func activityLogServer(t *testing.T, total int, queries *[]map[string]string) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if r.URL.Path != "/System/ActivityLog/Entries" {
+			t.Errorf("Expected path /System/ActivityLog/Entries, got %s", r.URL.Path)
+		}
+
+		query := r.URL.Query()
+		*queries = append(*queries, map[string]string{
+			"startIndex": query.Get("startIndex"),
+			"limit":      query.Get("limit"),
+			"minDate":    query.Get("minDate"),
+		})
+
+		start, _ := strconv.Atoi(query.Get("startIndex"))
+		limit, _ := strconv.Atoi(query.Get("limit"))
+
+		items := []ActivityLogEntry{}
+		for i := start; i < start+limit && i < total; i++ {
+			items = append(items, ActivityLogEntry{Id: int64(total - i), Name: "entry", Severity: "Information"})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(ActivityLogQueryResult{Items: items, TotalRecordCount: total, StartIndex: start})
+	}))
+}
+
+func TestGetActivityLogEntries(t *testing.T) {
+	var queries []map[string]string
+	server := activityLogServer(t, 250, &queries)
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	entries, err := client.GetActivityLogEntries(context.Background(), 0, 0, "")
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(entries) != 250 {
+		t.Errorf("Expected 250 entries, got %d", len(entries))
+	}
+
+	if entries[0].Id != 250 || entries[249].Id != 1 {
+		t.Errorf("Expected entries 250 to 1 in order, got %d to %d", entries[0].Id, entries[len(entries)-1].Id)
+	}
+
+	if len(queries) != 3 {
+		t.Fatalf("Expected 3 page requests, got %d", len(queries))
+	}
+
+	for i, query := range queries {
+		if query["startIndex"] != strconv.Itoa(i*activityLogPageSize) {
+			t.Errorf("Expected page %d to start at %d, got %s", i, i*activityLogPageSize, query["startIndex"])
+		}
+		if query["minDate"] != "" {
+			t.Errorf("Expected no minDate, got %s", query["minDate"])
+		}
+	}
+}
+
+func TestGetActivityLogEntries_filtered(t *testing.T) {
+	var queries []map[string]string
+	server := activityLogServer(t, 500, &queries)
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	entries, err := client.GetActivityLogEntries(context.Background(), 50, 150, "2024-01-01T00:00:00Z")
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(entries) != 150 {
+		t.Errorf("Expected 150 entries, got %d", len(entries))
+	}
+
+	if entries[0].Id != 450 {
+		t.Errorf("Expected the first entry to be 450, got %d", entries[0].Id)
+	}
+
+	expected := []map[string]string{
+		{"startIndex": "50", "limit": "100", "minDate": "2024-01-01T00:00:00Z"},
+		{"startIndex": "150", "limit": "50", "minDate": "2024-01-01T00:00:00Z"},
+	}
+
+	if len(queries) != len(expected) {
+		t.Fatalf("Expected %d page requests, got %d", len(expected), len(queries))
+	}
+
+	for i := range expected {
+		for key, value := range expected[i] {
+			if queries[i][key] != value {
+				t.Errorf("Expected page %d %s %s, got %s", i, key, value, queries[i][key])
+			}
+		}
+	}
+}
+
+func TestGetActivityLogEntries_startBeyondEnd(t *testing.T) {
+	var queries []map[string]string
+	server := activityLogServer(t, 10, &queries)
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	entries, err := client.GetActivityLogEntries(context.Background(), 20, 0, "")
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(entries) != 0 {
+		t.Errorf("Expected no entries, got %d", len(entries))
+	}
+
+	if len(queries) != 1 {
+		t.Errorf("Expected 1 page request, got %d", len(queries))
+	}
+}
+
+func TestGetActivityLogEntries_error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	if _, err := client.GetActivityLogEntries(context.Background(), 0, 10, ""); err == nil {
+		t.Error("Expected error for unauthorized response")
+	}
+}
@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import "context"
+
+// BrandingConfigurationKey is the named configuration section holding the server's
+// branding options: the login disclaimer, custom CSS and splash screen setting.
+const BrandingConfigurationKey = "branding"
+
+// GetBrandingConfiguration retrieves the server's branding options.
+func (c *Client) GetBrandingConfiguration(ctx context.Context) (ServerConfiguration, error) {
+	return c.GetConfiguration(ctx, BrandingConfigurationKey)
+}
+
+// PatchBrandingConfiguration sets the given branding fields, leaving any other
+// branding options untouched. It returns the branding options as written.
+func (c *Client) PatchBrandingConfiguration(ctx context.Context, fields map[string]interface{}) (ServerConfiguration, error) {
+	return c.PatchConfiguration(ctx, BrandingConfigurationKey, fields)
+}
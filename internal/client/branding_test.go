@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestBrandingConfiguration_roundTrip(t *testing.T) {
+	stored := `{"LoginDisclaimer":"","CustomCss":"","SplashscreenEnabled":false,"SplashscreenLocation":"/config/splash.png"}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/System/Configuration/branding" {
+			t.Errorf("Expected path /System/Configuration/branding, got %s", r.URL.Path)
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(stored))
+		case http.MethodPost:
+			var body json.RawMessage
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("Failed to decode posted branding: %v", err)
+			}
+			stored = string(body)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected %s request", r.Method)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	_, err := client.PatchBrandingConfiguration(context.Background(), map[string]interface{}{
+		"LoginDisclaimer":     "Authorized users only",
+		"CustomCss":           "body { color: red; }",
+		"SplashscreenEnabled": true,
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	config, err := client.GetBrandingConfiguration(context.Background())
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.String("LoginDisclaimer") != "Authorized users only" {
+		t.Errorf("Expected login disclaimer to round-trip, got %q", config.String("LoginDisclaimer"))
+	}
+
+	if config.String("CustomCss") != "body { color: red; }" {
+		t.Errorf("Expected custom CSS to round-trip, got %q", config.String("CustomCss"))
+	}
+
+	if !config.Bool("SplashscreenEnabled") {
+		t.Error("Expected the splash screen to be enabled")
+	}
+
+	if config.String("SplashscreenLocation") != "/config/splash.png" {
+		t.Errorf("Expected SplashscreenLocation to be preserved, got %q", config.String("SplashscreenLocation"))
+	}
+}
@@ -7,11 +7,21 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math/rand"
 	"net/http"
 	"net/url"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/sync/singleflight"
+	"golang.org/x/time/rate"
 )
 
 const (
@@ -20,13 +30,92 @@ const (
 	DefaultDeviceName    = "Terraform Provider"
 	DefaultDeviceID      = "terraform-provider-jellyfin"
 	DefaultClientVersion = "1.0.0"
+
+	// DefaultUserAgent is sent as the User-Agent header when none is configured.
+	DefaultUserAgent = "terraform-provider-jellyfin/" + DefaultClientVersion
+)
+
+const (
+	// Default retry behavior for transient failures.
+	DefaultRetryMax     = 3
+	DefaultRetryWaitMin = 1 * time.Second
+	DefaultRetryWaitMax = 30 * time.Second
 )
 
+// DefaultTimeout limits how long a single request may take when no timeout is configured.
+const DefaultTimeout = 30 * time.Second
+
+// passwordSignInPath is the endpoint for signing in with a username and password.
+const passwordSignInPath = "/Users/AuthenticateByName"
+
+// jsonContentType is the content type of API request bodies other than uploads.
+const jsonContentType = "application/json"
+
 // Client is a Jellyfin API client.
 type Client struct {
-	endpoint    string
-	accessToken string
-	httpClient  *http.Client
+	endpoint     string
+	tokenMu      sync.RWMutex
+	accessToken  string
+	tokenFile    string
+	httpClient   *http.Client
+	header       http.Header
+	retryMax     int
+	retryWaitMin time.Duration
+	retryWaitMax time.Duration
+
+	// limiter paces API requests; it allows every request when no rate is configured.
+	limiter *rate.Limiter
+
+	maxResponseBytes int64
+	keyNamePrefix    string
+	requireKeyImport bool
+	expectContinue   bool
+
+	// sessionAuth is set when the token is a session token from signing in with a
+	// password or Quick Connect rather than an API key. sessionMethod says which.
+	sessionAuth   bool
+	sessionMethod string
+
+	// credentials is the AuthenticateByName request body, kept when signing in with a
+	// password so the client can sign in again if the session token is revoked or
+	// expires. signInHeader identifies the client when doing so.
+	credentials  []byte
+	signInHeader http.Header
+
+	// renewMu serializes signing in again, so requests rejected at the same time share
+	// one new session rather than each creating their own.
+	renewMu sync.Mutex
+
+	// userID and serverID are reported by the server when signing in.
+	userID   string
+	serverID string
+
+	// reads coalesces identical list requests made concurrently, such as several data
+	// sources reading the API keys during the same plan.
+	reads singleflight.Group
+
+	// patches serializes read-modify-writes of the same object, such as resources
+	// setting different fields of the network configuration in parallel, so one
+	// write doesn't undo another.
+	patches keyedMutex
+}
+
+// Authentication methods reported by AuthInfo.
+const (
+	AuthMethodPassword     = "password"
+	AuthMethodQuickConnect = "quickconnect"
+	AuthMethodAPIKey       = "api_key"
+)
+
+// AuthInfo describes how a client authenticated. It never carries the token itself.
+type AuthInfo struct {
+	Method string
+
+	// UserID is the signed-in user, or empty for API keys, which aren't tied to a user.
+	UserID string
+
+	// ServerID is the server's ID as reported when signing in, or empty if unknown.
+	ServerID string
 }
 
 // ClientConfig holds configuration for creating a new client.
@@ -36,6 +125,92 @@ type ClientConfig struct {
 	DeviceName    string
 	DeviceID      string
 	ClientVersion string
+
+	// UserAgent is sent as the User-Agent header on every request, including
+	// authentication. Empty uses DefaultUserAgent.
+	UserAgent string
+
+	// Headers are added to every request, including authentication, e.g. a key required
+	// by a reverse proxy in front of the server. Headers the client sets itself, such as
+	// Authorization, take precedence; see IsReservedHeader.
+	Headers map[string]string
+
+	// RetryMax is the number of times a transient failure is retried. Zero uses
+	// DefaultRetryMax and a negative value disables retries.
+	RetryMax int
+
+	// RetryWaitMin and RetryWaitMax bound the exponential backoff between retries.
+	// RetryWaitMax also caps a delay requested by the server with Retry-After. Zero
+	// values use DefaultRetryWaitMin and DefaultRetryWaitMax.
+	RetryWaitMin time.Duration
+	RetryWaitMax time.Duration
+
+	// Timeout limits how long a single request attempt may take, including reading the
+	// response body. Zero uses DefaultTimeout.
+	Timeout time.Duration
+
+	// RequestsPerSecond limits how many API requests, counting each retry, are sent per
+	// second across all concurrent callers, to avoid overwhelming a small server. Zero
+	// or less means no limit.
+	RequestsPerSecond float64
+
+	// CAFile and CADir add PEM-encoded certificate authorities to trust when
+	// connecting over HTTPS. CADir loads every file in the directory.
+	CAFile string
+	CADir  string
+
+	// CACertPEM adds PEM-encoded certificate authorities given inline, e.g. a
+	// private CA whose certificate is held in a variable rather than a file.
+	CACertPEM string
+
+	// IgnoreSystemCAs trusts only the authorities from CAFile, CADir and CACertPEM instead of
+	// adding them to the system certificate pool.
+	IgnoreSystemCAs bool
+
+	// InsecureSkipVerify accepts any server certificate, such as a self-signed one,
+	// without verifying it. Connections are then open to interception.
+	InsecureSkipVerify bool
+
+	// HTTPClient, if set, is used as is for every request, including authentication,
+	// instead of a client built from Timeout, the CA, TLS and proxy settings and Middleware,
+	// which are then ignored. Retries and the response size limit still apply.
+	HTTPClient *http.Client
+
+	// ProxyURL, if set, sends every request through the given proxy instead of the one
+	// from the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables. The http,
+	// https, socks5 and socks5h schemes are supported; see ParseProxyURL.
+	ProxyURL string
+
+	// Middleware, if set, wraps the final transport used for every request, including
+	// authentication, e.g. to add tracing or metrics. It sits below the retry logic, so
+	// each attempt passes through it.
+	Middleware func(http.RoundTripper) http.RoundTripper
+
+	// MaxResponseBytes caps the size of response bodies read from the server. Zero uses
+	// DefaultMaxResponseBytes and a negative value removes the limit.
+	MaxResponseBytes int64
+
+	// KeyNamePrefix marks API keys as managed by this client's owner; see IsManagedKeyName.
+	KeyNamePrefix string
+
+	// RequireKeyImport tells callers that API keys must be created outside the client
+	// and imported rather than created through it; see RequireKeyImport.
+	RequireKeyImport bool
+
+	// ExpectContinue sends "Expect: 100-continue" with upload requests, such as item
+	// images, so the server can reject them before the body is sent. Go doesn't send
+	// the header by default, and some proxies mishandle it, so it is off unless set.
+	ExpectContinue bool
+
+	// OnQuickConnectCode, if set, is called by NewClientWithQuickConnect with the code to
+	// approve as soon as it is known, so it can be shown while the client waits.
+	OnQuickConnectCode func(code string)
+
+	// QuickConnectTimeout limits how long NewClientWithQuickConnect waits for approval, and
+	// QuickConnectPollInterval is how often it checks. Zero values use
+	// DefaultQuickConnectTimeout and DefaultQuickConnectPollInterval.
+	QuickConnectTimeout      time.Duration
+	QuickConnectPollInterval time.Duration
 }
 
 // AuthenticateRequest represents the request body for authentication.
@@ -78,43 +253,98 @@ type APIKeyQueryResult struct {
 	StartIndex       int      `json:"StartIndex"`
 }
 
-// NewClient creates a new Jellyfin API client with a pre-existing access token.
+// NewClient creates a new Jellyfin API client with a pre-existing access token and the
+// default configuration. Use NewClientWithConfig to customize the client or to have an
+// invalid endpoint reported; here such an endpoint is used as given, so requests fail.
 func NewClient(endpoint, accessToken string) *Client {
-	return &Client{
-		endpoint:    strings.TrimSuffix(endpoint, "/"),
-		accessToken: accessToken,
-		httpClient:  http.DefaultClient,
+	c, err := NewClientWithConfig(endpoint, accessToken, nil)
+	if err != nil {
+		return newClient(endpoint, accessToken, nil)
 	}
+	return c
 }
 
-// NewClientWithAuth creates a new Jellyfin API client by authenticating with username and password.
-func NewClientWithAuth(ctx context.Context, endpoint, username, password string) (*Client, error) {
-	return NewClientWithAuthAndConfig(ctx, endpoint, username, password, nil)
+// NewClientWithConfig creates a new Jellyfin API client with a pre-existing access token
+// and custom client configuration, such as TLS, proxy and timeout settings.
+func NewClientWithConfig(endpoint, accessToken string, config *ClientConfig) (*Client, error) {
+	endpoint, err := NormalizeEndpoint(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient, err := newHTTPClient(config)
+	if err != nil {
+		return nil, err
+	}
+
+	c := newClient(endpoint, accessToken, config)
+	c.httpClient = httpClient
+
+	return c, nil
 }
 
-// NewClientWithAuthAndConfig creates a new Jellyfin API client with custom client configuration.
-func NewClientWithAuthAndConfig(ctx context.Context, endpoint, username, password string, config *ClientConfig) (*Client, error) {
-	endpoint = strings.TrimSuffix(endpoint, "/")
+// newClient creates a client, filling in defaults for any unset configuration.
+func newClient(endpoint, accessToken string, config *ClientConfig) *Client {
+	c := &Client{
+		endpoint:     strings.TrimSuffix(endpoint, "/"),
+		accessToken:  accessToken,
+		httpClient:   &http.Client{Timeout: DefaultTimeout},
+		header:       requestHeader(config),
+		retryMax:     DefaultRetryMax,
+		retryWaitMin: DefaultRetryWaitMin,
+		retryWaitMax: DefaultRetryWaitMax,
+		limiter:      rate.NewLimiter(rate.Inf, 0),
 
-	// Use defaults if config not provided
-	clientName := DefaultClientName
-	deviceName := DefaultDeviceName
-	deviceID := DefaultDeviceID
-	clientVersion := DefaultClientVersion
+		maxResponseBytes: DefaultMaxResponseBytes,
+	}
 
 	if config != nil {
-		if config.ClientName != "" {
-			clientName = config.ClientName
+		if config.RetryMax < 0 {
+			c.retryMax = 0
+		} else if config.RetryMax > 0 {
+			c.retryMax = config.RetryMax
 		}
-		if config.DeviceName != "" {
-			deviceName = config.DeviceName
+		if config.RetryWaitMin > 0 {
+			c.retryWaitMin = config.RetryWaitMin
 		}
-		if config.DeviceID != "" {
-			deviceID = config.DeviceID
+		if config.RetryWaitMax > 0 {
+			c.retryWaitMax = config.RetryWaitMax
 		}
-		if config.ClientVersion != "" {
-			clientVersion = config.ClientVersion
+		if config.MaxResponseBytes < 0 {
+			c.maxResponseBytes = 0
+		} else if config.MaxResponseBytes > 0 {
+			c.maxResponseBytes = config.MaxResponseBytes
+		}
+		if config.RequestsPerSecond > 0 {
+			c.limiter = rate.NewLimiter(rate.Limit(config.RequestsPerSecond), 1)
 		}
+		c.keyNamePrefix = config.KeyNamePrefix
+		c.requireKeyImport = config.RequireKeyImport
+		c.expectContinue = config.ExpectContinue
+	}
+
+	if c.retryWaitMax < c.retryWaitMin {
+		c.retryWaitMax = c.retryWaitMin
+	}
+
+	return c
+}
+
+// NewClientWithAuth creates a new Jellyfin API client by authenticating with username and password.
+func NewClientWithAuth(ctx context.Context, endpoint, username, password string) (*Client, error) {
+	return NewClientWithAuthAndConfig(ctx, endpoint, username, password, nil)
+}
+
+// NewClientWithAuthAndConfig creates a new Jellyfin API client with custom client configuration.
+func NewClientWithAuthAndConfig(ctx context.Context, endpoint, username, password string, config *ClientConfig) (*Client, error) {
+	endpoint, err := NormalizeEndpoint(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient, err := newHTTPClient(config)
+	if err != nil {
+		return nil, err
 	}
 
 	// Create authentication request
@@ -128,27 +358,59 @@ func NewClientWithAuthAndConfig(ctx context.Context, endpoint, username, passwor
 		return nil, fmt.Errorf("failed to marshal auth request: %w", err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint+"/Users/AuthenticateByName", bytes.NewReader(body))
+	c, err := authenticate(ctx, httpClient, endpoint, passwordSignInPath, body, config, AuthMethodPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	c.credentials = body
+
+	return c, nil
+}
+
+// authenticate signs in by posting body to path and returns a client using the
+// session token from the response. method is reported by AuthInfo.
+func authenticate(ctx context.Context, httpClient *http.Client, endpoint, path string, body []byte, config *ClientConfig, method string) (*Client, error) {
+	header := requestHeader(config)
+	header.Set("Authorization", clientAuthorization(config))
+
+	authResp, err := signIn(ctx, httpClient, endpoint+path, header, body)
+	if err != nil {
+		return nil, err
+	}
+
+	c := newClient(endpoint, authResp.AccessToken, config)
+	c.httpClient = httpClient
+	c.signInHeader = header
+	c.sessionAuth = true
+	c.sessionMethod = method
+	c.userID = authResp.User.Id
+	c.serverID = authResp.ServerId
+
+	return c, nil
+}
+
+// signIn posts a sign-in request with the given headers and returns the server's
+// response, which is guaranteed to carry an access token.
+func signIn(ctx context.Context, httpClient *http.Client, url string, header http.Header, body []byte) (*AuthenticateResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create auth request: %w", err)
 	}
 
 	// Set headers for unauthenticated request
+	req.Header = header.Clone()
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", fmt.Sprintf(
-		`MediaBrowser Client="%s", Device="%s", DeviceId="%s", Version="%s"`,
-		clientName, deviceName, deviceID, clientVersion,
-	))
+	req.Header.Set("Accept", "application/json")
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := httpClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to authenticate: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		respBody, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("authentication failed with status %d: %s", resp.StatusCode, string(respBody))
+		return nil, fmt.Errorf("authentication failed: %w", newAPIError(resp))
 	}
 
 	var authResp AuthenticateResponse
@@ -160,42 +422,414 @@ func NewClientWithAuthAndConfig(ctx context.Context, endpoint, username, passwor
 		return nil, fmt.Errorf("authentication succeeded but no access token returned")
 	}
 
-	return &Client{
-		endpoint:    endpoint,
-		accessToken: authResp.AccessToken,
-		httpClient:  http.DefaultClient,
-	}, nil
+	return &authResp, nil
+}
+
+// reauthenticate signs in again with the stored credentials once the server has
+// refused the token passed as rejected, and reports whether the client now has a
+// different token. If another request already signed in again since, its token is
+// used instead.
+func (c *Client) reauthenticate(ctx context.Context, rejected string) (bool, error) {
+	c.renewMu.Lock()
+	defer c.renewMu.Unlock()
+
+	if c.token() != rejected {
+		return true, nil
+	}
+
+	authResp, err := signIn(ctx, c.httpClient, c.endpoint+passwordSignInPath, c.signInHeader, c.credentials)
+	if err != nil {
+		return false, err
+	}
+
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	c.accessToken = authResp.AccessToken
+	return true, nil
+}
+
+// clientAuthorization returns the Authorization header identifying the client to the
+// server before it has a token.
+func clientAuthorization(config *ClientConfig) string {
+	// Use defaults if config not provided
+	clientName := DefaultClientName
+	deviceName := DefaultDeviceName
+	deviceID := DefaultDeviceID
+	clientVersion := DefaultClientVersion
+
+	if config != nil {
+		if config.ClientName != "" {
+			clientName = config.ClientName
+		}
+		if config.DeviceName != "" {
+			deviceName = config.DeviceName
+		}
+		if config.DeviceID != "" {
+			deviceID = config.DeviceID
+		}
+		if config.ClientVersion != "" {
+			clientVersion = config.ClientVersion
+		}
+	}
+
+	return FormatAuthorization(clientName, deviceName, deviceID, clientVersion, "")
+}
+
+// FormatAuthorization returns a MediaBrowser Authorization header identifying a client,
+// with the access token appended when token isn't empty.
+func FormatAuthorization(clientName, deviceName, deviceID, clientVersion, token string) string {
+	header := fmt.Sprintf(
+		`MediaBrowser Client="%s", Device="%s", DeviceId="%s", Version="%s"`,
+		clientName, deviceName, deviceID, clientVersion,
+	)
+
+	if token != "" {
+		header += fmt.Sprintf(`, Token="%s"`, token)
+	}
+
+	return header
+}
+
+// reservedHeaders are set by the client itself and can't be replaced through
+// ClientConfig.Headers.
+var reservedHeaders = []string{"Accept", "Authorization", "Content-Type", "Expect", "User-Agent"}
+
+// IsReservedHeader reports whether name is a header the client sets itself, so a value
+// for it in ClientConfig.Headers is ignored. Names are compared case-insensitively.
+func IsReservedHeader(name string) bool {
+	return slices.Contains(reservedHeaders, http.CanonicalHeaderKey(name))
+}
+
+// requestHeader returns the headers sent with every request for the given
+// configuration: the custom headers followed by the User-Agent. Callers set the
+// remaining reserved headers on top.
+func requestHeader(config *ClientConfig) http.Header {
+	header := http.Header{}
+	userAgent := DefaultUserAgent
+
+	if config != nil {
+		for name, value := range config.Headers {
+			if !IsReservedHeader(name) {
+				header.Set(name, value)
+			}
+		}
+		if config.UserAgent != "" {
+			userAgent = config.UserAgent
+		}
+	}
+
+	header.Set("User-Agent", userAgent)
+
+	return header
+}
+
+// AuthInfo reports how the client authenticated.
+func (c *Client) AuthInfo() AuthInfo {
+	if c.sessionAuth {
+		return AuthInfo{Method: c.sessionMethod, UserID: c.userID, ServerID: c.serverID}
+	}
+
+	return AuthInfo{Method: AuthMethodAPIKey}
 }
 
 // doRequest makes an HTTP request to the Jellyfin API.
 func (c *Client) doRequest(ctx context.Context, method, path string) (*http.Response, error) {
-	req, err := http.NewRequestWithContext(ctx, method, c.endpoint+path, nil)
+	return c.doRequestWithBody(ctx, method, path, nil)
+}
+
+// doRequestWithBody makes an HTTP request to the Jellyfin API with a JSON request body.
+func (c *Client) doRequestWithBody(ctx context.Context, method, path string, body []byte) (*http.Response, error) {
+	return c.doRequestWithContent(ctx, method, path, body, jsonContentType)
+}
+
+// doRequestWithContent makes an HTTP request to the Jellyfin API with a request body of
+// the given content type.
+func (c *Client) doRequestWithContent(ctx context.Context, method, path string, body []byte, contentType string) (*http.Response, error) {
+	if method != http.MethodGet && method != http.MethodHead {
+		// A shared read already in flight may predate this write, so later reads must
+		// not join it.
+		defer c.forgetReads()
+	}
+
+	rejected := c.token()
+
+	resp, err := c.doWithRetry(ctx, method, path, body, contentType)
+
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	// The token may have been rotated, revoked or expired since it was obtained; retry
+	// once with a new token if one can be had. The retry is never renewed again, so a
+	// credential the server keeps rejecting fails instead of looping.
+	if renewed, renewErr := c.renewToken(ctx, rejected); renewErr != nil || !renewed {
+		return resp, err
+	}
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+
+	return c.doWithRetry(ctx, method, path, body, contentType)
+}
+
+// renewToken obtains a new token once the server has refused the token passed as
+// rejected, by re-reading the token file or signing in again with the stored
+// credentials, and reports whether the token changed. Clients without either can't
+// renew their token.
+func (c *Client) renewToken(ctx context.Context, rejected string) (bool, error) {
+	switch {
+	case c.tokenFile != "":
+		return c.reloadToken()
+	case c.credentials != nil:
+		return c.reauthenticate(ctx, rejected)
+	}
+
+	return false, nil
+}
+
+// doWithRetry performs a request, retrying transient failures with exponential backoff,
+// or after the delay the server asks for in a Retry-After header. When the retries are
+// exhausted or the context ends between attempts, the returned error wraps the last
+// failure, and the context error if any, so callers can still use errors.Is and
+// errors.As on them.
+func (c *Client) doWithRetry(ctx context.Context, method, path string, body []byte, contentType string) (*http.Response, error) {
+	var lastErr error
+
+	for attempt := 1; ; attempt++ {
+		resp, err := c.send(ctx, method, path, body, contentType)
+
+		if err != nil && lastErr != nil && ctx.Err() != nil {
+			return nil, fmt.Errorf("request cancelled after %d attempts: %w (last error: %w)", attempt, ctx.Err(), lastErr)
+		}
+
+		if c.retryMax == 0 || !shouldRetry(method, resp, err) {
+			return resp, err
+		}
+
+		wait := c.backoff(attempt - 1)
+
+		lastErr = err
+		if resp != nil {
+			if after, ok := retryAfter(resp, time.Now()); ok {
+				wait = min(after, c.retryWaitMax)
+			}
+
+			lastErr = newAPIError(resp)
+			resp.Body.Close()
+		}
+
+		if attempt > c.retryMax {
+			return nil, fmt.Errorf("giving up after %d attempts: %w", attempt, lastErr)
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, fmt.Errorf("request cancelled after %d attempts: %w (last error: %w)", attempt, ctx.Err(), lastErr)
+		case <-timer.C:
+		}
+	}
+}
+
+// send performs a single HTTP request attempt.
+func (c *Client) send(ctx context.Context, method, path string, body []byte, contentType string) (*http.Response, error) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.endpoint+path, reader)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	// Use MediaBrowser authorization header format with token
-	req.Header.Set("Authorization", fmt.Sprintf(`MediaBrowser Token="%s"`, c.accessToken))
+	req.Header = c.header.Clone()
+
+	if body != nil {
+		req.Header.Set("Content-Type", contentType)
+
+		// Bodies other than JSON are uploads, such as item images.
+		if c.expectContinue && contentType != jsonContentType {
+			req.Header.Set("Expect", "100-continue")
+		}
+	}
+
+	// Some Emby-compatible endpoints default to XML; always ask for JSON.
+	req.Header.Set("Accept", "application/json")
+
+	// Use MediaBrowser authorization header format with token. Clients without a token,
+	// such as the one CheckHealth uses, send no credentials.
+	if token := c.token(); token != "" {
+		req.Header.Set("Authorization", fmt.Sprintf(`MediaBrowser Token="%s"`, token))
+	}
+
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, fmt.Errorf("failed to wait for the request rate limit: %w", err)
+	}
+
+	logCtx := c.logContext(ctx, path)
+	fields := map[string]interface{}{
+		"method": method,
+		"path":   path,
+	}
+	start := time.Now()
 
 	resp, err := c.httpClient.Do(req)
+
+	fields["duration_ms"] = time.Since(start).Milliseconds()
+
 	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(logCtx, "Jellyfin API request failed", fields)
 		return nil, fmt.Errorf("failed to execute request: %w", err)
 	}
 
+	fields["status_code"] = resp.StatusCode
+	tflog.Debug(logCtx, "Jellyfin API request", fields)
+
+	c.limitBody(resp)
+
 	return resp, nil
 }
 
-// GetKeys retrieves all API keys.
+// limitBody caps how much of the response body can be read, if a limit is configured.
+func (c *Client) limitBody(resp *http.Response) {
+	if c.maxResponseBytes > 0 {
+		resp.Body = newLimitedBody(resp.Body, c.maxResponseBytes)
+	}
+}
+
+// shouldRetry reports whether a request attempt failed transiently. Throttling and
+// unavailable responses are retried for every method since the server refused the
+// request without acting on it. Gateway errors and connection errors are only
+// retried for idempotent methods, as the server may already have applied the request.
+func shouldRetry(method string, resp *http.Response, err error) bool {
+	if err != nil && (errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)) {
+		return false
+	}
+
+	idempotent := false
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		idempotent = true
+	}
+
+	if err != nil {
+		return idempotent
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return idempotent
+	}
+
+	return false
+}
+
+// retryAfter parses a response's Retry-After header, given either as a number of
+// seconds or as an HTTP date. It reports false if the header is absent or invalid.
+func retryAfter(resp *http.Response, now time.Time) (time.Duration, bool) {
+	value := strings.TrimSpace(resp.Header.Get("Retry-After"))
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	at, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+
+	return max(at.Sub(now), 0), true
+}
+
+// backoff returns the wait before the given retry attempt (starting at 0): an
+// exponentially growing base with up to 50% jitter, clamped to [retryWaitMin, retryWaitMax].
+func (c *Client) backoff(attempt int) time.Duration {
+	wait := c.retryWaitMin
+	for i := 0; i < attempt && wait < c.retryWaitMax; i++ {
+		wait *= 2
+	}
+
+	if wait > 0 {
+		wait += time.Duration(rand.Int63n(int64(wait)/2 + 1))
+	}
+
+	if wait > c.retryWaitMax {
+		wait = c.retryWaitMax
+	}
+	if wait < c.retryWaitMin {
+		wait = c.retryWaitMin
+	}
+
+	return wait
+}
+
+// keysPageSize is how many API keys are requested per page when listing all keys.
+const keysPageSize = 100
+
+// GetKeys retrieves all API keys, paging through them. Concurrent calls share a single
+// listing.
 func (c *Client) GetKeys(ctx context.Context) (*APIKeyQueryResult, error) {
-	resp, err := c.doRequest(ctx, http.MethodGet, "/Auth/Keys")
+	result, err := coalesce(ctx, &c.reads, keysReadKey, c.getAllKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	// Each caller gets its own copy, since the result is shared.
+	keys := *result
+	keys.Items = append([]APIKey(nil), result.Items...)
+
+	return &keys, nil
+}
+
+// getAllKeys requests every page of API keys and concatenates them into one result.
+func (c *Client) getAllKeys(ctx context.Context) (*APIKeyQueryResult, error) {
+	all := &APIKeyQueryResult{}
+
+	for {
+		page, err := c.GetKeysPaged(ctx, len(all.Items), keysPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		all.Items = append(all.Items, page.Items...)
+		all.TotalRecordCount = page.TotalRecordCount
+
+		// Servers that ignore paging return every key in the first page.
+		if len(all.Items) >= page.TotalRecordCount || len(page.Items) == 0 {
+			return all, nil
+		}
+	}
+}
+
+// GetKeysPaged retrieves one page of API keys, starting at startIndex. A limit of zero
+// or less leaves the page size to the server.
+func (c *Client) GetKeysPaged(ctx context.Context, startIndex, limit int) (*APIKeyQueryResult, error) {
+	params := url.Values{}
+	params.Set("startIndex", strconv.Itoa(startIndex))
+	if limit > 0 {
+		params.Set("limit", strconv.Itoa(limit))
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodGet, "/Auth/Keys?"+params.Encode())
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+		return nil, newAPIError(resp)
 	}
 
 	var result APIKeyQueryResult
@@ -208,13 +842,23 @@ func (c *Client) GetKeys(ctx context.Context) (*APIKeyQueryResult, error) {
 
 // GetKeyByID retrieves a specific API key by its ID.
 func (c *Client) GetKeyByID(ctx context.Context, id int64) (*APIKey, error) {
+	return c.findKey(ctx, func(key APIKey) bool { return key.Id == id })
+}
+
+// GetKeyByAccessToken retrieves a specific API key by its access token.
+func (c *Client) GetKeyByAccessToken(ctx context.Context, accessToken string) (*APIKey, error) {
+	return c.findKey(ctx, func(key APIKey) bool { return key.AccessToken == accessToken })
+}
+
+// findKey returns the first API key that matches, or nil if none do.
+func (c *Client) findKey(ctx context.Context, match func(APIKey) bool) (*APIKey, error) {
 	result, err := c.GetKeys(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	for _, key := range result.Items {
-		if key.Id == id {
+		if match(key) {
 			return &key, nil
 		}
 	}
@@ -222,20 +866,29 @@ func (c *Client) GetKeyByID(ctx context.Context, id int64) (*APIKey, error) {
 	return nil, nil // Not found
 }
 
-// GetKeyByAccessToken retrieves a specific API key by its access token.
-func (c *Client) GetKeyByAccessToken(ctx context.Context, accessToken string) (*APIKey, error) {
+// GetKeysExcludingSelf retrieves all API keys except the one the client is
+// authenticated with, so cleanup tooling never deletes the credential in use. When
+// the client signed in with a password or Quick Connect there is no such key and
+// nothing is excluded.
+func (c *Client) GetKeysExcludingSelf(ctx context.Context) ([]APIKey, error) {
 	result, err := c.GetKeys(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	if c.sessionAuth {
+		return result.Items, nil
+	}
+
+	self := c.token()
+	keys := make([]APIKey, 0, len(result.Items))
 	for _, key := range result.Items {
-		if key.AccessToken == accessToken {
-			return &key, nil
+		if key.AccessToken != self {
+			keys = append(keys, key)
 		}
 	}
 
-	return nil, nil // Not found
+	return keys, nil
 }
 
 // CreateKey creates a new API key.
@@ -249,13 +902,75 @@ func (c *Client) CreateKey(ctx context.Context, appName string) error {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+		return newAPIError(resp)
 	}
 
 	return nil
 }
 
+// CreateKeyAndGet creates a new API key and returns it. Jellyfin doesn't return the
+// key it creates, so the keys are listed before and after and the new key is the one
+// whose access token only appears afterwards; app names aren't unique, so they can't
+// identify it alone. If several new keys share the app name, such as when another
+// client created one concurrently, the most recently created is returned.
+func (c *Client) CreateKeyAndGet(ctx context.Context, appName string) (*APIKey, error) {
+	before, err := c.GetKeys(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list existing API keys: %w", err)
+	}
+
+	if err := c.CreateKey(ctx, appName); err != nil {
+		return nil, err
+	}
+
+	after, err := c.GetKeys(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list API keys after creation: %w", err)
+	}
+
+	key := findCreatedKey(before.Items, after.Items, appName)
+	if key == nil {
+		return nil, fmt.Errorf("the newly created API key %q was not found", appName)
+	}
+
+	return key, nil
+}
+
+// findCreatedKey returns the key named appName whose access token is in after but not
+// before, preferring the latest DateCreated when there is more than one.
+func findCreatedKey(before, after []APIKey, appName string) *APIKey {
+	existing := make(map[string]bool, len(before))
+	for _, key := range before {
+		existing[key.AccessToken] = true
+	}
+
+	var created *APIKey
+	for i := range after {
+		key := &after[i]
+		if existing[key.AccessToken] || key.AppName != appName {
+			continue
+		}
+
+		if created == nil || createdAfter(key.DateCreated, created.DateCreated) {
+			created = key
+		}
+	}
+
+	return created
+}
+
+// createdAfter reports whether timestamp a is later than b. Jellyfin's timestamps are
+// RFC 3339; unparseable ones fall back to comparing the strings.
+func createdAfter(a, b string) bool {
+	ta, errA := time.Parse(time.RFC3339Nano, a)
+	tb, errB := time.Parse(time.RFC3339Nano, b)
+	if errA != nil || errB != nil {
+		return a > b
+	}
+
+	return ta.After(tb)
+}
+
 // DeleteKey deletes an API key by its access token.
 func (c *Client) DeleteKey(ctx context.Context, accessToken string) error {
 	path := fmt.Sprintf("/Auth/Keys/%s", url.PathEscape(accessToken))
@@ -267,26 +982,49 @@ func (c *Client) DeleteKey(ctx context.Context, accessToken string) error {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+		return newAPIError(resp)
 	}
 
 	return nil
 }
 
+// KeyNamePrefix returns the configured prefix marking managed API key names, or "".
+func (c *Client) KeyNamePrefix() string {
+	return c.keyNamePrefix
+}
+
+// RequireKeyImport reports whether API keys must be created by hand and imported
+// instead of being created through the client.
+func (c *Client) RequireKeyImport() bool {
+	return c.requireKeyImport
+}
+
+// IsManagedKeyName reports whether an API key name carries the configured prefix.
+// Without a prefix no key is considered managed.
+func (c *Client) IsManagedKeyName(appName string) bool {
+	return c.keyNamePrefix != "" && strings.HasPrefix(appName, c.keyNamePrefix)
+}
+
 // FindKeyByAppName finds an API key by its application name.
 // Since the Create API doesn't return the token, we need to find it by comparing before/after state.
 func (c *Client) FindKeyByAppName(ctx context.Context, appName string) (*APIKey, error) {
+	return c.findKey(ctx, func(key APIKey) bool { return key.AppName == appName })
+}
+
+// FindKeysByAppName returns every API key with the given application name, since
+// Jellyfin doesn't require names to be unique.
+func (c *Client) FindKeysByAppName(ctx context.Context, appName string) ([]APIKey, error) {
 	result, err := c.GetKeys(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	var keys []APIKey
 	for _, key := range result.Items {
 		if key.AppName == appName {
-			return &key, nil
+			keys = append(keys, key)
 		}
 	}
 
-	return nil, nil // Not found
+	return keys, nil
 }
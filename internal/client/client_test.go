@@ -6,9 +6,15 @@ package client
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestNewClient(t *testing.T) {
@@ -27,6 +33,8 @@ func TestNewClient(t *testing.T) {
 
 	if client.httpClient == nil {
 		t.Error("Expected httpClient to be initialized")
+	} else if client.httpClient.Timeout != DefaultTimeout {
+		t.Errorf("Expected default timeout %s, got %s", DefaultTimeout, client.httpClient.Timeout)
 	}
 }
 
@@ -277,6 +285,9 @@ func TestNewClientWithAuthAndConfig_nilConfig(t *testing.T) {
 	}
 
 	// Check that default values were used
+	if client.httpClient.Timeout != DefaultTimeout {
+		t.Errorf("Expected default timeout %s, got %s", DefaultTimeout, client.httpClient.Timeout)
+	}
 	if !contains(receivedAuth, DefaultClientName) {
 		t.Errorf("Expected auth header to contain default client name %s, got %s", DefaultClientName, receivedAuth)
 	}
@@ -324,6 +335,60 @@ func TestNewClientWithAuthAndConfig_partialConfig(t *testing.T) {
 	}
 }
 
+func TestNewClientWithAuthAndConfig_timeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	start := time.Now()
+	_, err := NewClientWithAuthAndConfig(context.Background(), server.URL, "user", "pass", &ClientConfig{Timeout: 50 * time.Millisecond})
+
+	if err == nil {
+		t.Fatal("Expected error for a request exceeding the timeout, got nil")
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected the request to be cut off by the timeout, took %s", elapsed)
+	}
+}
+
+func TestClient_requestTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/Users/AuthenticateByName" {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"AccessToken":"test-token"}`))
+			return
+		}
+
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	config := &ClientConfig{Timeout: 50 * time.Millisecond, RetryMax: -1}
+	client, err := NewClientWithAuthAndConfig(context.Background(), server.URL, "user", "pass", config)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if client.httpClient.Timeout != config.Timeout {
+		t.Errorf("Expected timeout %s, got %s", config.Timeout, client.httpClient.Timeout)
+	}
+
+	start := time.Now()
+	if _, err := client.GetKeys(context.Background()); err == nil {
+		t.Fatal("Expected error for a request exceeding the timeout, got nil")
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected the request to be cut off by the timeout, took %s", elapsed)
+	}
+}
+
 // Helper function for string contains.
 func contains(s, substr string) bool {
 	return len(s) >= len(substr) && (s == substr || len(s) > 0 && containsHelper(s, substr))
@@ -408,6 +473,143 @@ func TestGetKeys(t *testing.T) {
 	}
 }
 
+func TestGetKeys_multiplePages(t *testing.T) {
+	var starts []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		starts = append(starts, query.Get("startIndex"))
+
+		if limit := query.Get("limit"); limit != strconv.Itoa(keysPageSize) {
+			t.Errorf("Expected limit %d, got %q", keysPageSize, limit)
+		}
+
+		start, _ := strconv.Atoi(query.Get("startIndex"))
+		total := keysPageSize + 2
+
+		var items []APIKey
+		for i := start; i < total && i < start+keysPageSize; i++ {
+			items = append(items, APIKey{Id: int64(i + 1), AccessToken: fmt.Sprintf("token-%d", i+1), AppName: fmt.Sprintf("app-%d", i+1)})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(APIKeyQueryResult{Items: items, TotalRecordCount: total, StartIndex: start})
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	result, err := client.GetKeys(context.Background())
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(result.Items) != keysPageSize+2 {
+		t.Fatalf("Expected %d keys across both pages, got %d", keysPageSize+2, len(result.Items))
+	}
+
+	if last := result.Items[len(result.Items)-1]; last.AccessToken != fmt.Sprintf("token-%d", keysPageSize+2) {
+		t.Errorf("Expected the last key to come from the second page, got %s", last.AccessToken)
+	}
+
+	expectedStarts := []string{"0", strconv.Itoa(keysPageSize)}
+	if !slices.Equal(starts, expectedStarts) {
+		t.Errorf("Expected page requests starting at %v, got %v", expectedStarts, starts)
+	}
+
+	// Lookups go through the same listing, so keys on later pages are found.
+	key, err := client.FindKeyByAppName(context.Background(), fmt.Sprintf("app-%d", keysPageSize+1))
+	if err != nil || key == nil || key.AccessToken != fmt.Sprintf("token-%d", keysPageSize+1) {
+		t.Errorf("Expected a key on the second page to be found, got %v, %v", key, err)
+	}
+}
+
+func TestGetKeysPaged(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/Auth/Keys" {
+			t.Errorf("Expected path /Auth/Keys, got %s", r.URL.Path)
+		}
+		if start := r.URL.Query().Get("startIndex"); start != "20" {
+			t.Errorf("Expected startIndex 20, got %q", start)
+		}
+		if limit := r.URL.Query().Get("limit"); limit != "10" {
+			t.Errorf("Expected limit 10, got %q", limit)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"Items":[{"Id":21,"AccessToken":"token-21"}],"TotalRecordCount":21,"StartIndex":20}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	result, err := client.GetKeysPaged(context.Background(), 20, 10)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(result.Items) != 1 || result.StartIndex != 20 || result.TotalRecordCount != 21 {
+		t.Errorf("Expected one key at index 20 of 21, got %+v", result)
+	}
+}
+
+func TestGetKeys_decodesServerPayload(t *testing.T) {
+	// A literal /Auth/Keys response, so the JSON field names are checked against what
+	// Jellyfin sends rather than against the APIKey struct's own encoding.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+  "Items": [
+    {
+      "Id": 42,
+      "AccessToken": "0123456789abcdef0123456789abcdef",
+      "DeviceId": "",
+      "AppName": "terraform",
+      "AppVersion": "",
+      "DeviceName": "",
+      "UserId": "00000000000000000000000000000000",
+      "IsActive": true,
+      "DateCreated": "2024-01-01T00:00:00.0000000Z",
+      "DateRevoked": null,
+      "DateLastActivity": "0001-01-01T00:00:00.0000000Z",
+      "UserName": null
+    }
+  ],
+  "TotalRecordCount": 1,
+  "StartIndex": 0
+}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	result, err := client.GetKeys(context.Background())
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(result.Items) != 1 {
+		t.Fatalf("Expected 1 item, got %d", len(result.Items))
+	}
+
+	if result.Items[0].Id != 42 {
+		t.Errorf("Expected id 42, got %d", result.Items[0].Id)
+	}
+
+	if result.Items[0].AppName != "terraform" {
+		t.Errorf("Expected app name 'terraform', got %s", result.Items[0].AppName)
+	}
+
+	key, err := client.GetKeyByID(context.Background(), 42)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if key == nil || key.AccessToken != "0123456789abcdef0123456789abcdef" {
+		t.Errorf("Expected key 42 to be found by its decoded id, got %+v", key)
+	}
+}
+
 func TestGetKeyByID(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		result := APIKeyQueryResult{
@@ -615,6 +817,91 @@ func TestCreateKey_withSpecialCharacters(t *testing.T) {
 	}
 }
 
+func TestCreateKeyAndGet_duplicateAppName(t *testing.T) {
+	var mu sync.Mutex
+	// The existing key shares the app name, and neither key reports an Id, so only the
+	// access token can tell them apart.
+	keys := []APIKey{
+		{AccessToken: "token-existing", AppName: "terraform", DateCreated: "2024-01-01T00:00:00.0000000Z"},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch r.Method {
+		case http.MethodPost:
+			keys = append([]APIKey{{
+				AccessToken: "token-new",
+				AppName:     r.URL.Query().Get("app"),
+				DateCreated: "2024-06-01T00:00:00.0000000Z",
+			}}, keys...)
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(APIKeyQueryResult{Items: keys, TotalRecordCount: len(keys)})
+		default:
+			t.Errorf("Unexpected %s request", r.Method)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	key, err := client.CreateKeyAndGet(context.Background(), "terraform")
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if key.AccessToken != "token-new" {
+		t.Errorf("Expected access token 'token-new', got %s", key.AccessToken)
+	}
+}
+
+func TestCreateKeyAndGet_notFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(APIKeyQueryResult{})
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	if _, err := client.CreateKeyAndGet(context.Background(), "terraform"); err == nil {
+		t.Error("Expected error when the created key doesn't appear")
+	}
+}
+
+func TestFindCreatedKey(t *testing.T) {
+	before := []APIKey{
+		{AccessToken: "token-1", AppName: "terraform"},
+	}
+	after := []APIKey{
+		{AccessToken: "token-1", AppName: "terraform"},
+		{AccessToken: "token-2", AppName: "other"},
+		{AccessToken: "token-3", AppName: "terraform", DateCreated: "2024-06-01T00:00:00.5Z"},
+		{AccessToken: "token-4", AppName: "terraform", DateCreated: "2024-06-01T00:00:00Z"},
+	}
+
+	// Both new terraform keys are candidates; the later DateCreated wins even though it
+	// sorts first as a string.
+	if key := findCreatedKey(before, after, "terraform"); key == nil || key.AccessToken != "token-3" {
+		t.Errorf("Expected token-3, got %+v", key)
+	}
+
+	if key := findCreatedKey(before, after, "other"); key == nil || key.AccessToken != "token-2" {
+		t.Errorf("Expected token-2, got %+v", key)
+	}
+
+	if key := findCreatedKey(after, after, "terraform"); key != nil {
+		t.Errorf("Expected no new key, got %+v", key)
+	}
+}
+
 func TestDeleteKey(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodDelete {
@@ -744,6 +1031,44 @@ func TestFindKeyByAppName_notFound(t *testing.T) {
 	}
 }
 
+func TestFindKeysByAppName(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		result := APIKeyQueryResult{
+			Items: []APIKey{
+				{Id: 1, AccessToken: "token-1", AppName: "CI"},
+				{Id: 2, AccessToken: "token-2", AppName: "Other"},
+				{Id: 3, AccessToken: "token-3", AppName: "CI"},
+			},
+			TotalRecordCount: 3,
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(result)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	keys, err := client.FindKeysByAppName(context.Background(), "CI")
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(keys) != 2 || keys[0].AccessToken != "token-1" || keys[1].AccessToken != "token-3" {
+		t.Errorf("Expected keys token-1 and token-3, got %+v", keys)
+	}
+
+	keys, err = client.FindKeysByAppName(context.Background(), "ci")
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(keys) != 0 {
+		t.Errorf("Expected app names to match exactly, got %+v", keys)
+	}
+}
+
 func TestFindKeyByAppName_emptyList(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		result := APIKeyQueryResult{
@@ -812,6 +1137,134 @@ func TestClient_errorHandling_unauthorized(t *testing.T) {
 	}
 }
 
+// newReauthServer mocks password sign-in, issuing token-1, token-2 and so on, and a
+// GET /System/Info endpoint that accepts only the tokens in valid. signIns counts sign-ins
+// and requests counts calls to the endpoint. Sign-ins after the first fail if
+// rejectReauth is set.
+func newReauthServer(t *testing.T, valid []string, rejectReauth bool, signIns, requests *int) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/Users/AuthenticateByName":
+			*signIns++
+			if rejectReauth && *signIns > 1 {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = fmt.Fprintf(w, `{"AccessToken":"token-%d","User":{"Id":"user-1"}}`, *signIns)
+		case "/System/Info":
+			*requests++
+			if !slices.Contains(valid, r.Header.Get("Authorization")) {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"Version":"10.9.0"}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+}
+
+func TestClient_reauthenticatesOnUnauthorized(t *testing.T) {
+	var signIns, requests int
+	server := newReauthServer(t, []string{`MediaBrowser Token="token-2"`}, false, &signIns, &requests)
+	defer server.Close()
+
+	client, err := NewClientWithAuth(context.Background(), server.URL, "admin", "password")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	resp, err := client.doRequest(context.Background(), http.MethodGet, "/System/Info")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected the retried request to succeed, got status %d", resp.StatusCode)
+	}
+
+	if signIns != 2 || requests != 2 {
+		t.Errorf("Expected 2 sign-ins and 2 requests, got %d and %d", signIns, requests)
+	}
+
+	if client.token() != "token-2" {
+		t.Errorf("Expected the new token to be kept, got %q", client.token())
+	}
+}
+
+func TestClient_reauthenticationRejected(t *testing.T) {
+	var signIns, requests int
+	server := newReauthServer(t, nil, true, &signIns, &requests)
+	defer server.Close()
+
+	client, err := NewClientWithAuth(context.Background(), server.URL, "admin", "password")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	resp, err := client.doRequest(context.Background(), http.MethodGet, "/System/Info")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("Expected the original 401 to be returned, got status %d", resp.StatusCode)
+	}
+
+	if signIns != 2 || requests != 1 {
+		t.Errorf("Expected 2 sign-ins and 1 request, got %d and %d", signIns, requests)
+	}
+}
+
+func TestClient_reauthenticatesOnlyOnce(t *testing.T) {
+	var signIns, requests int
+	server := newReauthServer(t, nil, false, &signIns, &requests)
+	defer server.Close()
+
+	client, err := NewClientWithAuth(context.Background(), server.URL, "admin", "password")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	resp, err := client.doRequest(context.Background(), http.MethodGet, "/System/Info")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("Expected a 401 when the new token is rejected too, got status %d", resp.StatusCode)
+	}
+
+	if signIns != 2 || requests != 2 {
+		t.Errorf("Expected 2 sign-ins and 2 requests, got %d and %d", signIns, requests)
+	}
+}
+
+func TestClient_apiKeyDoesNotReauthenticate(t *testing.T) {
+	var signIns, requests int
+	server := newReauthServer(t, nil, false, &signIns, &requests)
+	defer server.Close()
+
+	client := NewClient(server.URL, "invalid-api-key")
+
+	resp, err := client.doRequest(context.Background(), http.MethodGet, "/System/Info")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	resp.Body.Close()
+
+	if signIns != 0 || requests != 1 {
+		t.Errorf("Expected no sign-in and 1 request, got %d and %d", signIns, requests)
+	}
+}
+
 func TestClient_errorHandling_forbidden(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusForbidden)
@@ -862,3 +1315,365 @@ func TestClient_contextCancellation(t *testing.T) {
 		t.Error("Expected error for cancelled context")
 	}
 }
+
+func TestClient_acceptHeader(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if accept := r.Header.Get("Accept"); accept != "application/json" {
+			t.Errorf("Expected Accept header %q, got %q", "application/json", accept)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/Users/AuthenticateByName" {
+			_, _ = w.Write([]byte(`{"AccessToken":"token"}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(APIKeyQueryResult{Items: []APIKey{}})
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithAuth(context.Background(), server.URL, "user", "pass")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if _, err := client.GetKeys(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+// roundTripperFunc adapts a function to http.RoundTripper.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestNewClientWithAuthAndConfig_middleware(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/Users/AuthenticateByName" {
+			_, _ = w.Write([]byte(`{"AccessToken":"test-token"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"Items":[]}`))
+	}))
+	defer server.Close()
+
+	var paths []string
+	config := &ClientConfig{
+		Middleware: func(next http.RoundTripper) http.RoundTripper {
+			return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				paths = append(paths, req.URL.Path)
+				return next.RoundTrip(req)
+			})
+		},
+	}
+
+	client, err := NewClientWithAuthAndConfig(context.Background(), server.URL, "user", "pass", config)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if _, err := client.GetKeys(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(paths) != 2 {
+		t.Fatalf("Expected middleware to see 2 requests, got %d: %v", len(paths), paths)
+	}
+
+	if paths[0] != "/Users/AuthenticateByName" || paths[1] != "/Auth/Keys" {
+		t.Errorf("Expected authentication then /Auth/Keys, got %v", paths)
+	}
+}
+
+func TestNewClientWithAuthAndConfig_httpClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/Users/AuthenticateByName" {
+			_, _ = w.Write([]byte(`{"AccessToken":"test-token"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"Items":[]}`))
+	}))
+	defer server.Close()
+
+	var paths []string
+	httpClient := &http.Client{
+		Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			paths = append(paths, req.URL.Path)
+			return http.DefaultTransport.RoundTrip(req)
+		}),
+	}
+
+	config := &ClientConfig{
+		HTTPClient: httpClient,
+		// Ignored in favor of the supplied client.
+		Middleware: func(next http.RoundTripper) http.RoundTripper {
+			t.Error("Expected Middleware to be ignored when HTTPClient is set")
+			return next
+		},
+	}
+
+	client, err := NewClientWithAuthAndConfig(context.Background(), server.URL, "user", "pass", config)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if client.httpClient != httpClient {
+		t.Error("Expected the supplied HTTP client to be used")
+	}
+
+	if _, err := client.GetKeys(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(paths) != 2 {
+		t.Fatalf("Expected transport to see 2 requests, got %d: %v", len(paths), paths)
+	}
+
+	if paths[0] != "/Users/AuthenticateByName" || paths[1] != "/Auth/Keys" {
+		t.Errorf("Expected authentication then /Auth/Keys, got %v", paths)
+	}
+}
+
+func TestClient_userAgent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ua := r.Header.Get("User-Agent"); ua != DefaultUserAgent {
+			t.Errorf("Expected User-Agent %q, got %q", DefaultUserAgent, ua)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"Items":[]}`))
+	}))
+	defer server.Close()
+
+	if _, err := NewClient(server.URL, "token").GetKeys(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestNewClientWithAuthAndConfig_userAgent(t *testing.T) {
+	var userAgents []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgents = append(userAgents, r.Header.Get("User-Agent"))
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/Users/AuthenticateByName" {
+			_, _ = w.Write([]byte(`{"AccessToken":"test-token"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"Items":[]}`))
+	}))
+	defer server.Close()
+
+	config := &ClientConfig{UserAgent: "terraform-provider-jellyfin/1.2.3"}
+
+	client, err := NewClientWithAuthAndConfig(context.Background(), server.URL, "user", "pass", config)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if _, err := client.GetKeys(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(userAgents) != 2 {
+		t.Fatalf("Expected 2 requests, got %d", len(userAgents))
+	}
+
+	for i, ua := range userAgents {
+		if ua != config.UserAgent {
+			t.Errorf("Expected request %d to send User-Agent %q, got %q", i, config.UserAgent, ua)
+		}
+	}
+}
+
+func TestNewClientWithAuthAndConfig_headers(t *testing.T) {
+	var requests []http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Header.Clone())
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/Users/AuthenticateByName" {
+			_, _ = w.Write([]byte(`{"AccessToken":"test-token"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"Items":[]}`))
+	}))
+	defer server.Close()
+
+	config := &ClientConfig{
+		Headers: map[string]string{
+			"X-Api-Gateway-Key": "gateway-key",
+			"authorization":     "Bearer overridden",
+		},
+	}
+
+	client, err := NewClientWithAuthAndConfig(context.Background(), server.URL, "user", "pass", config)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if _, err := client.GetKeys(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(requests) != 2 {
+		t.Fatalf("Expected 2 requests, got %d", len(requests))
+	}
+
+	for i, header := range requests {
+		if got := header.Get("X-Api-Gateway-Key"); got != "gateway-key" {
+			t.Errorf("Expected request %d to send X-Api-Gateway-Key, got %q", i, got)
+		}
+		if got := header.Get("Authorization"); !strings.HasPrefix(got, "MediaBrowser ") {
+			t.Errorf("Expected request %d to keep the MediaBrowser Authorization header, got %q", i, got)
+		}
+	}
+
+	if got := requests[1].Get("Authorization"); got != `MediaBrowser Token="test-token"` {
+		t.Errorf("Expected the session token to be sent, got %q", got)
+	}
+}
+
+func TestIsReservedHeader(t *testing.T) {
+	for name, expected := range map[string]bool{
+		"Authorization":     true,
+		"user-agent":        true,
+		"CONTENT-TYPE":      true,
+		"X-Api-Gateway-Key": false,
+	} {
+		if got := IsReservedHeader(name); got != expected {
+			t.Errorf("Expected IsReservedHeader(%q) to be %t, got %t", name, expected, got)
+		}
+	}
+}
+
+func TestFormatAuthorization(t *testing.T) {
+	expected := `MediaBrowser Client="Terraform", Device="CI", DeviceId="ci-1", Version="1.2.3"`
+	if got := FormatAuthorization("Terraform", "CI", "ci-1", "1.2.3", ""); got != expected {
+		t.Errorf("Expected %s, got %s", expected, got)
+	}
+
+	expected += `, Token="abc123"`
+	if got := FormatAuthorization("Terraform", "CI", "ci-1", "1.2.3", "abc123"); got != expected {
+		t.Errorf("Expected %s, got %s", expected, got)
+	}
+}
+
+func TestRequireKeyImport(t *testing.T) {
+	if NewClient("http://localhost:8096", "token").RequireKeyImport() {
+		t.Error("Expected key creation to be allowed by default")
+	}
+
+	client := newClient("http://localhost:8096", "token", &ClientConfig{RequireKeyImport: true})
+	if !client.RequireKeyImport() {
+		t.Error("Expected RequireKeyImport to be true when configured")
+	}
+}
+
+func TestIsManagedKeyName(t *testing.T) {
+	client := newClient("http://localhost:8096", "token", &ClientConfig{KeyNamePrefix: "tf-"})
+
+	if client.KeyNamePrefix() != "tf-" {
+		t.Errorf("Expected KeyNamePrefix 'tf-', got %s", client.KeyNamePrefix())
+	}
+
+	if !client.IsManagedKeyName("tf-sonarr") {
+		t.Error("Expected 'tf-sonarr' to be managed")
+	}
+
+	if client.IsManagedKeyName("sonarr") {
+		t.Error("Expected 'sonarr' not to be managed")
+	}
+
+	if NewClient("http://localhost:8096", "token").IsManagedKeyName("tf-sonarr") {
+		t.Error("Expected no key to be managed without a prefix")
+	}
+}
+
+func TestGetKeysExcludingSelf_apiKey(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(APIKeyQueryResult{Items: []APIKey{
+			{Id: 1, AccessToken: "self-key", AppName: "terraform"},
+			{Id: 2, AccessToken: "other-key", AppName: "sonarr"},
+		}})
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "self-key")
+	keys, err := client.GetKeysExcludingSelf(context.Background())
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(keys) != 1 || keys[0].AccessToken != "other-key" {
+		t.Errorf("Expected only 'other-key', got %v", keys)
+	}
+}
+
+func TestGetKeysExcludingSelf_passwordAuth(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/Users/AuthenticateByName" {
+			_, _ = w.Write([]byte(`{"AccessToken":"session-token"}`))
+			return
+		}
+		// A key that happens to share the session token's value is still listed,
+		// since a password-authenticated client isn't using any API key.
+		_ = json.NewEncoder(w).Encode(APIKeyQueryResult{Items: []APIKey{
+			{Id: 1, AccessToken: "session-token", AppName: "terraform"},
+			{Id: 2, AccessToken: "other-key", AppName: "sonarr"},
+		}})
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithAuth(context.Background(), server.URL, "user", "pass")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	keys, err := client.GetKeysExcludingSelf(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(keys) != 2 {
+		t.Errorf("Expected all 2 keys with password auth, got %d", len(keys))
+	}
+}
+
+func TestAuthInfo_password(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"AccessToken":"session-token","ServerId":"server-1","User":{"Id":"user-1","Name":"admin"}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithAuth(context.Background(), server.URL, "admin", "pass")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	info := client.AuthInfo()
+	if info.Method != AuthMethodPassword {
+		t.Errorf("Expected method %q, got %q", AuthMethodPassword, info.Method)
+	}
+	if info.UserID != "user-1" {
+		t.Errorf("Expected user ID 'user-1', got %q", info.UserID)
+	}
+	if info.ServerID != "server-1" {
+		t.Errorf("Expected server ID 'server-1', got %q", info.ServerID)
+	}
+}
+
+func TestAuthInfo_apiKey(t *testing.T) {
+	info := NewClient("http://localhost:8096", "test-api-key").AuthInfo()
+
+	if info.Method != AuthMethodAPIKey {
+		t.Errorf("Expected method %q, got %q", AuthMethodAPIKey, info.Method)
+	}
+	if info.UserID != "" || info.ServerID != "" {
+		t.Errorf("Expected no user or server ID for an API key, got %+v", info)
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// Keys of the reads coalesced by the client.
+const (
+	keysReadKey  = "/Auth/Keys"
+	usersReadKey = "/Users"
+)
+
+// forgetReads makes later calls start fresh requests instead of joining reads already
+// in flight, so a read made after a write observes it.
+func (c *Client) forgetReads() {
+	c.reads.Forget(keysReadKey)
+	c.reads.Forget(usersReadKey)
+}
+
+// canceledFetchError wraps the error of a shared read that ended because the context
+// of the call that started it ended, rather than because the read itself failed.
+type canceledFetchError struct {
+	err error
+}
+
+func (e *canceledFetchError) Error() string { return e.err.Error() }
+
+func (e *canceledFetchError) Unwrap() error { return e.err }
+
+// coalesce runs fetch, sharing its result with concurrent calls for the same key so
+// identical reads issued in parallel reach the server once. The shared result must not
+// be modified by callers. The request runs with the context of the call that started
+// it; the others stop waiting when their own context ends, and start a new request if
+// the first caller's context ends first.
+func coalesce[T any](ctx context.Context, group *singleflight.Group, key string, fetch func(context.Context) (T, error)) (T, error) {
+	var zero T
+
+	for {
+		ch := group.DoChan(key, func() (interface{}, error) {
+			value, err := fetch(ctx)
+			if err != nil && ctx.Err() != nil {
+				return nil, &canceledFetchError{err: err}
+			}
+			return value, err
+		})
+
+		select {
+		case <-ctx.Done():
+			return zero, ctx.Err()
+		case res := <-ch:
+			var canceled *canceledFetchError
+			if errors.As(res.Err, &canceled) {
+				if ctx.Err() != nil {
+					return zero, canceled.err
+				}
+				continue
+			}
+
+			if res.Err != nil {
+				return zero, res.Err
+			}
+
+			value, ok := res.Val.(T)
+			if !ok {
+				return zero, fmt.Errorf("unexpected shared result type %T", res.Val)
+			}
+
+			return value, nil
+		}
+	}
+}
@@ -0,0 +1,256 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestClient_concurrentReads issues many identical reads in parallel, as data sources
+// in a large plan do, while the token is reloaded. Run it with -race.
+func TestClient_concurrentReads(t *testing.T) {
+	var keyRequests, userRequests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Hold each response briefly so concurrent callers overlap.
+		time.Sleep(50 * time.Millisecond)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/Auth/Keys":
+			keyRequests.Add(1)
+			_, _ = w.Write([]byte(`{"Items":[{"Id":1,"AppName":"app-1"},{"Id":2,"AppName":"app-2"}],"TotalRecordCount":2}`))
+		case "/Users":
+			userRequests.Add(1)
+			_, _ = w.Write([]byte(`[{"Id":"user-1","Name":"admin"}]`))
+		default:
+			t.Errorf("Unexpected request to %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("test-api-key"), 0o600); err != nil {
+		t.Fatalf("Failed to write token file: %v", err)
+	}
+
+	client, err := NewClientWithTokenFile(server.URL, tokenFile, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	const readers = 50
+
+	var wg sync.WaitGroup
+	for i := 0; i < readers; i++ {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+
+			result, err := client.GetKeys(context.Background())
+			if err != nil {
+				t.Errorf("Expected no error, got %v", err)
+				return
+			}
+
+			// Callers may modify their copy; with -race a shared result would be reported.
+			result.Items[0].AppName = "changed"
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			users, err := client.GetUsers(context.Background())
+			if err != nil {
+				t.Errorf("Expected no error, got %v", err)
+				return
+			}
+			if len(users) != 1 || users[0].Id != "user-1" {
+				t.Errorf("Expected [user-1], got %v", users)
+			}
+		}()
+	}
+
+	// Reload the token while the reads are in flight.
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		if _, err := client.reloadToken(); err != nil {
+			t.Errorf("Expected no error reloading the token, got %v", err)
+		}
+	}()
+
+	wg.Wait()
+
+	if n := keyRequests.Load(); n >= readers {
+		t.Errorf("Expected concurrent GetKeys calls to share requests, got %d requests for %d calls", n, readers)
+	}
+
+	if n := userRequests.Load(); n >= readers {
+		t.Errorf("Expected concurrent GetUsers calls to share requests, got %d requests for %d calls", n, readers)
+	}
+}
+
+// TestClient_concurrentReadsFirstCallerCanceled cancels the call whose request the
+// others joined; they must still get a result. Run it with -race.
+func TestClient_concurrentReadsFirstCallerCanceled(t *testing.T) {
+	var requests atomic.Int32
+	started := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Hold the first request until its caller gives up.
+		if requests.Add(1) == 1 {
+			close(started)
+			<-r.Context().Done()
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"Id":"user-1","Name":"admin"}]`))
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL, "test-api-key", &ClientConfig{RetryMax: -1})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	first := make(chan error, 1)
+	go func() {
+		_, err := client.GetUsers(ctx)
+		first <- err
+	}()
+	<-started
+
+	const readers = 10
+
+	var wg sync.WaitGroup
+	for i := 0; i < readers; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			users, err := client.GetUsers(context.Background())
+			if err != nil {
+				t.Errorf("Expected no error after the first caller was cancelled, got %v", err)
+				return
+			}
+			if len(users) != 1 || users[0].Id != "user-1" {
+				t.Errorf("Expected [user-1], got %v", users)
+			}
+		}()
+	}
+
+	// Let the readers join the held request before cancelling it.
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+
+	wg.Wait()
+
+	if err := <-first; !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected the first caller to get context.Canceled, got %v", err)
+	}
+
+	if n := requests.Load(); n >= readers+1 {
+		t.Errorf("Expected the remaining readers to share a new request, got %d requests", n)
+	}
+}
+
+func TestCoalesce_waiterContextCanceled(t *testing.T) {
+	client := NewClient("http://localhost:8096", "test-api-key")
+	release := make(chan struct{})
+	defer close(release)
+
+	go func() {
+		_, _ = coalesce(context.Background(), &client.reads, "key", func(ctx context.Context) (int, error) {
+			<-release
+			return 1, nil
+		})
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := coalesce(ctx, &client.reads, "key", func(ctx context.Context) (int, error) {
+		<-release
+		return 1, nil
+	})
+
+	if err != context.DeadlineExceeded {
+		t.Errorf("Expected the waiter to stop when its context ends, got %v", err)
+	}
+}
+
+func TestClient_readAfterWriteNotCoalesced(t *testing.T) {
+	var created atomic.Bool
+	listing := make(chan struct{})
+	release := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			created.Store(true)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		// The first listing is held open until the key has been created.
+		if !created.Load() {
+			close(listing)
+			<-release
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"Items":[],"TotalRecordCount":0}`))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"Items":[{"Id":1,"AppName":"new-app"}],"TotalRecordCount":1}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = client.GetKeys(context.Background())
+	}()
+	<-listing
+
+	if err := client.CreateKey(context.Background(), "new-app"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// This read must not join the listing that started before the key existed.
+	result := make(chan *APIKeyQueryResult, 1)
+	go func() {
+		keys, err := client.GetKeys(context.Background())
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+		result <- keys
+	}()
+
+	var keys *APIKeyQueryResult
+	select {
+	case keys = <-result:
+		close(release)
+	case <-time.After(time.Second):
+		// The read joined the stale listing; let it finish to report what it saw.
+		close(release)
+		keys = <-result
+	}
+	<-done
+
+	if keys == nil || len(keys.Items) != 1 || keys.Items[0].AppName != "new-app" {
+		t.Errorf("Expected the listing after the write to include the new key, got %+v", keys)
+	}
+}
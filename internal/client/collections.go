@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// collectionCreationResult represents the response from creating a collection.
+type collectionCreationResult struct {
+	Id string `json:"Id"`
+}
+
+// CreateCollection creates a collection (box set) with the given items and returns its ID.
+func (c *Client) CreateCollection(ctx context.Context, name string, itemIDs []string) (string, error) {
+	params := url.Values{}
+	params.Set("name", name)
+	if len(itemIDs) > 0 {
+		params.Set("ids", strings.Join(itemIDs, ","))
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodPost, "/Collections?"+params.Encode())
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", newAPIError(resp)
+	}
+
+	var result collectionCreationResult
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if result.Id == "" {
+		return "", fmt.Errorf("collection created but no ID returned")
+	}
+
+	return result.Id, nil
+}
+
+// GetCollectionItemIDs returns the IDs of the items in a collection.
+func (c *Client) GetCollectionItemIDs(ctx context.Context, collectionID string) ([]string, error) {
+	result, err := c.getItems(ctx, itemsQuery{ParentID: collectionID})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(result.Items))
+	for _, item := range result.Items {
+		ids = append(ids, item.Id)
+	}
+
+	return ids, nil
+}
+
+// AddCollectionItems adds items to a collection.
+func (c *Client) AddCollectionItems(ctx context.Context, collectionID string, itemIDs []string) error {
+	return c.updateCollectionItems(ctx, http.MethodPost, collectionID, itemIDs)
+}
+
+// RemoveCollectionItems removes items from a collection.
+func (c *Client) RemoveCollectionItems(ctx context.Context, collectionID string, itemIDs []string) error {
+	return c.updateCollectionItems(ctx, http.MethodDelete, collectionID, itemIDs)
+}
+
+func (c *Client) updateCollectionItems(ctx context.Context, method, collectionID string, itemIDs []string) error {
+	if len(itemIDs) == 0 {
+		return nil
+	}
+
+	params := url.Values{}
+	params.Set("ids", strings.Join(itemIDs, ","))
+
+	resp, err := c.doRequest(ctx, method, "/Collections/"+url.PathEscape(collectionID)+"/Items?"+params.Encode())
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return newAPIError(resp)
+	}
+
+	return nil
+}
+
+// CollectionDisplayOrderDefault is the display order that lists a collection's items
+// in the order they were added, rather than by release date or name.
+const CollectionDisplayOrderDefault = "Default"
+
+// SetCollectionDisplayOrder changes how a collection orders its items. Jellyfin
+// expects the complete item object, so the collection is read first and written back
+// with only the display order changed.
+func (c *Client) SetCollectionDisplayOrder(ctx context.Context, collectionID, order string) error {
+	raw, err := c.GetItemRaw(ctx, collectionID)
+	if err != nil {
+		return err
+	}
+
+	var item map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(raw), &item); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	item["DisplayOrder"], err = json.Marshal(order)
+	if err != nil {
+		return fmt.Errorf("failed to marshal display order: %w", err)
+	}
+
+	body, err := json.Marshal(item)
+	if err != nil {
+		return fmt.Errorf("failed to marshal collection: %w", err)
+	}
+
+	resp, err := c.doRequestWithBody(ctx, http.MethodPost, "/Items/"+url.PathEscape(collectionID), body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return newAPIError(resp)
+	}
+
+	return nil
+}
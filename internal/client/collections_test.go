@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCreateCollection(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if r.URL.Path != "/Collections" {
+			t.Errorf("Expected path /Collections, got %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("name") != "Alien Collection" {
+			t.Errorf("Expected name 'Alien Collection', got %s", r.URL.Query().Get("name"))
+		}
+		if r.URL.Query().Get("ids") != "item-1,item-2" {
+			t.Errorf("Expected ids 'item-1,item-2', got %s", r.URL.Query().Get("ids"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"Id":"box-1"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	id, err := client.CreateCollection(context.Background(), "Alien Collection", []string{"item-1", "item-2"})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if id != "box-1" {
+		t.Errorf("Expected ID 'box-1', got %s", id)
+	}
+}
+
+func TestGetCollectionItemIDs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("parentId") != "box-1" {
+			t.Errorf("Expected parentId 'box-1', got %s", r.URL.Query().Get("parentId"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"Items":[{"Id":"item-1"},{"Id":"item-2"}],"TotalRecordCount":2}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	ids, err := client.GetCollectionItemIDs(context.Background(), "box-1")
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(ids) != 2 || ids[0] != "item-1" || ids[1] != "item-2" {
+		t.Errorf("Expected [item-1 item-2], got %v", ids)
+	}
+}
+
+func TestAddAndRemoveCollectionItems(t *testing.T) {
+	var methods []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/Collections/box-1/Items" {
+			t.Errorf("Expected path /Collections/box-1/Items, got %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("ids") != "item-3" {
+			t.Errorf("Expected ids 'item-3', got %s", r.URL.Query().Get("ids"))
+		}
+
+		methods = append(methods, r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+
+	if err := client.AddCollectionItems(context.Background(), "box-1", []string{"item-3"}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if err := client.RemoveCollectionItems(context.Background(), "box-1", []string{"item-3"}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if err := client.RemoveCollectionItems(context.Background(), "box-1", nil); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(methods) != 2 || methods[0] != http.MethodPost || methods[1] != http.MethodDelete {
+		t.Errorf("Expected POST then DELETE with no request for an empty list, got %v", methods)
+	}
+}
+
+func TestSetCollectionDisplayOrder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/Items/box-1" {
+			t.Errorf("Expected path /Items/box-1, got %s", r.URL.Path)
+		}
+
+		if r.Method == http.MethodGet {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"Id":"box-1","Name":"Alien Collection","DisplayOrder":"PremiereDate"}`))
+			return
+		}
+
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+
+		var item map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&item); err != nil {
+			t.Fatalf("Failed to decode request body: %v", err)
+		}
+		if item["DisplayOrder"] != "Default" {
+			t.Errorf("Expected DisplayOrder 'Default', got %s", item["DisplayOrder"])
+		}
+		if item["Name"] != "Alien Collection" {
+			t.Errorf("Expected Name to be preserved, got %s", item["Name"])
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+
+	if err := client.SetCollectionDisplayOrder(context.Background(), "box-1", CollectionDisplayOrderDefault); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
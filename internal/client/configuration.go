@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ServerConfiguration is a server configuration object keyed by its JSON field names.
+// Fields are kept as raw JSON so settings the provider doesn't model survive a write.
+type ServerConfiguration map[string]json.RawMessage
+
+// LocalizationOption represents a UI language offered by the server.
+type LocalizationOption struct {
+	Name  string `json:"Name"`
+	Value string `json:"Value"`
+}
+
+// configurationPath returns the API path for the main configuration ("") or a named section.
+func configurationPath(key string) string {
+	if key == "" {
+		return "/System/Configuration"
+	}
+	return "/System/Configuration/" + url.PathEscape(key)
+}
+
+// GetConfiguration retrieves the main server configuration (key "") or a named
+// configuration section such as "network" or "encoding".
+func (c *Client) GetConfiguration(ctx context.Context, key string) (ServerConfiguration, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, configurationPath(key))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var config ServerConfiguration
+	if err := json.NewDecoder(resp.Body).Decode(&config); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return config, nil
+}
+
+// UpdateConfiguration replaces the main server configuration (key "") or a named
+// configuration section. Jellyfin expects the complete object, not a partial one.
+func (c *Client) UpdateConfiguration(ctx context.Context, key string, config ServerConfiguration) error {
+	body, err := json.Marshal(config)
+	if err != nil {
+		return fmt.Errorf("failed to marshal configuration: %w", err)
+	}
+
+	resp, err := c.doRequestWithBody(ctx, http.MethodPost, configurationPath(key), body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return newAPIError(resp)
+	}
+
+	return nil
+}
+
+// PatchConfiguration performs a read-modify-write of a configuration section, setting
+// only the given fields and leaving every other setting untouched. A dotted name such
+// as "TrickplayOptions.Interval" sets a field of a nested object. It returns the
+// configuration as written. Concurrent patches of the same section run one at a time.
+func (c *Client) PatchConfiguration(ctx context.Context, key string, fields map[string]interface{}) (ServerConfiguration, error) {
+	unlock, err := c.patches.lock(ctx, "configuration:"+key)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	config, err := c.GetConfiguration(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if config == nil {
+		config = ServerConfiguration{}
+	}
+
+	for name, value := range fields {
+		if err := config.set(strings.Split(name, "."), value); err != nil {
+			return nil, fmt.Errorf("failed to set configuration field %s: %w", name, err)
+		}
+	}
+
+	if err := c.UpdateConfiguration(ctx, key, config); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// GetLocalizationOptions retrieves the UI languages supported by the server.
+func (c *Client) GetLocalizationOptions(ctx context.Context) ([]LocalizationOption, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/Localization/Options")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var options []LocalizationOption
+	if err := json.NewDecoder(resp.Body).Decode(&options); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return options, nil
+}
+
+// String decodes a string field from the configuration, returning "" if it is absent.
+func (sc ServerConfiguration) String(name string) string {
+	var value string
+	if raw, ok := sc[name]; ok {
+		_ = json.Unmarshal(raw, &value)
+	}
+	return value
+}
+
+// Int64 decodes an integer field from the configuration, returning 0 if it is absent.
+func (sc ServerConfiguration) Int64(name string) int64 {
+	var value int64
+	if raw, ok := sc[name]; ok {
+		_ = json.Unmarshal(raw, &value)
+	}
+	return value
+}
+
+// Bool decodes a boolean field from the configuration, returning false if it is absent.
+func (sc ServerConfiguration) Bool(name string) bool {
+	var value bool
+	if raw, ok := sc[name]; ok {
+		_ = json.Unmarshal(raw, &value)
+	}
+	return value
+}
+
+// Has reports whether the configuration contains the named field.
+func (sc ServerConfiguration) Has(name string) bool {
+	_, ok := sc[name]
+	return ok
+}
+
+// Strings decodes a string list field from the configuration, returning nil if it is absent.
+func (sc ServerConfiguration) Strings(name string) []string {
+	var value []string
+	if raw, ok := sc[name]; ok {
+		_ = json.Unmarshal(raw, &value)
+	}
+	return value
+}
+
+// Int64s decodes an integer list field from the configuration, returning nil if it is absent.
+func (sc ServerConfiguration) Int64s(name string) []int64 {
+	var value []int64
+	if raw, ok := sc[name]; ok {
+		_ = json.Unmarshal(raw, &value)
+	}
+	return value
+}
+
+// Section decodes a nested object field, returning nil if it is absent or not an object.
+func (sc ServerConfiguration) Section(name string) ServerConfiguration {
+	var value ServerConfiguration
+	if raw, ok := sc[name]; ok {
+		_ = json.Unmarshal(raw, &value)
+	}
+	return value
+}
+
+// set stores value at the field path, creating nested objects as needed while
+// preserving their other fields.
+func (sc ServerConfiguration) set(path []string, value interface{}) error {
+	name := path[0]
+
+	if len(path) > 1 {
+		section := sc.Section(name)
+		if section == nil {
+			section = ServerConfiguration{}
+		}
+		if err := section.set(path[1:], value); err != nil {
+			return err
+		}
+		value = section
+	}
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	sc[name] = raw
+
+	return nil
+}
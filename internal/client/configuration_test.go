@@ -0,0 +1,300 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestGetConfiguration(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if r.URL.Path != "/System/Configuration" {
+			t.Errorf("Expected path /System/Configuration, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"UICulture":"en-US","ServerName":"media"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	config, err := client.GetConfiguration(context.Background(), "")
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.String("UICulture") != "en-US" {
+		t.Errorf("Expected UICulture 'en-US', got %s", config.String("UICulture"))
+	}
+
+	if config.String("Missing") != "" {
+		t.Errorf("Expected empty string for missing field, got %s", config.String("Missing"))
+	}
+}
+
+func TestServerConfiguration_Int64(t *testing.T) {
+	config := ServerConfiguration{
+		"InternalHttpPort": json.RawMessage(`8096`),
+		"BaseUrl":          json.RawMessage(`""`),
+	}
+
+	if config.Int64("InternalHttpPort") != 8096 {
+		t.Errorf("Expected InternalHttpPort 8096, got %d", config.Int64("InternalHttpPort"))
+	}
+
+	if config.Int64("Missing") != 0 {
+		t.Errorf("Expected 0 for missing field, got %d", config.Int64("Missing"))
+	}
+
+	if !config.Has("BaseUrl") || config.Has("Missing") {
+		t.Error("Expected Has to report only present fields")
+	}
+}
+
+func TestGetConfiguration_namedSection(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/System/Configuration/network" {
+			t.Errorf("Expected path /System/Configuration/network, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"BaseUrl":""}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	if _, err := client.GetConfiguration(context.Background(), "network"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestPatchConfiguration_preservesUnmanagedFields(t *testing.T) {
+	var posted map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"UICulture":"en-US","ServerName":"media","MetadataOptions":[{"ItemType":"Movie"}]}`))
+		case http.MethodPost:
+			if r.Header.Get("Content-Type") != "application/json" {
+				t.Errorf("Expected Content-Type application/json, got %s", r.Header.Get("Content-Type"))
+			}
+			if err := json.NewDecoder(r.Body).Decode(&posted); err != nil {
+				t.Errorf("Failed to decode posted configuration: %v", err)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected %s request", r.Method)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	config, err := client.PatchConfiguration(context.Background(), "", map[string]interface{}{
+		"UICulture": "de",
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.String("UICulture") != "de" {
+		t.Errorf("Expected returned UICulture 'de', got %s", config.String("UICulture"))
+	}
+
+	if posted["UICulture"] != "de" {
+		t.Errorf("Expected posted UICulture 'de', got %v", posted["UICulture"])
+	}
+
+	if posted["ServerName"] != "media" {
+		t.Errorf("Expected ServerName to be preserved, got %v", posted["ServerName"])
+	}
+
+	if _, ok := posted["MetadataOptions"]; !ok {
+		t.Error("Expected MetadataOptions to be preserved")
+	}
+}
+
+// TestPatchConfiguration_concurrent patches different fields of the same section in
+// parallel, as resources sharing the network configuration do. Run it with -race.
+func TestPatchConfiguration_concurrent(t *testing.T) {
+	var mu sync.Mutex
+	stored := []byte(`{"EnableRemoteAccess":true}`)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			mu.Lock()
+			body := stored
+			mu.Unlock()
+
+			// Hold the read so an unserialized patch would overlap with another.
+			time.Sleep(10 * time.Millisecond)
+
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(body)
+		case http.MethodPost:
+			var config map[string]json.RawMessage
+			if err := json.NewDecoder(r.Body).Decode(&config); err != nil {
+				t.Errorf("Failed to decode posted configuration: %v", err)
+			}
+			body, _ := json.Marshal(config)
+
+			mu.Lock()
+			stored = body
+			mu.Unlock()
+
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected %s request", r.Method)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+
+	const patches = 10
+
+	var wg sync.WaitGroup
+	for i := 0; i < patches; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := client.PatchConfiguration(context.Background(), "network", map[string]interface{}{
+				"Field" + strconv.Itoa(i): i,
+			})
+			if err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	config, err := client.GetConfiguration(context.Background(), "network")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	for i := 0; i < patches; i++ {
+		name := "Field" + strconv.Itoa(i)
+		if config.Int64(name) != int64(i) {
+			t.Errorf("Expected %s to be %d, got %s", name, i, config[name])
+		}
+	}
+
+	if !config.Bool("EnableRemoteAccess") {
+		t.Error("Expected EnableRemoteAccess to be preserved")
+	}
+}
+
+func TestPatchConfiguration_nestedField(t *testing.T) {
+	var posted map[string]map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"TrickplayOptions":{"Interval":10000,"WidthResolutions":[320],"JpegQuality":90}}`))
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&posted)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	config, err := client.PatchConfiguration(context.Background(), "", map[string]interface{}{
+		"TrickplayOptions.Interval": 5000,
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if interval := config.Section("TrickplayOptions").Int64("Interval"); interval != 5000 {
+		t.Errorf("Expected returned Interval 5000, got %d", interval)
+	}
+
+	trickplay := posted["TrickplayOptions"]
+	if trickplay["Interval"] != float64(5000) {
+		t.Errorf("Expected posted Interval 5000, got %v", trickplay["Interval"])
+	}
+
+	if trickplay["JpegQuality"] != float64(90) {
+		t.Errorf("Expected JpegQuality to be preserved, got %v", trickplay["JpegQuality"])
+	}
+}
+
+func TestPatchConfiguration_readError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			t.Error("Expected no POST when the read fails")
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	_, err := client.PatchConfiguration(context.Background(), "", map[string]interface{}{"UICulture": "de"})
+
+	if err == nil {
+		t.Error("Expected error when the configuration cannot be read")
+	}
+}
+
+func TestGetLocalizationOptions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/Localization/Options" {
+			t.Errorf("Expected path /Localization/Options, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"Name":"English (United States)","Value":"en-US"},{"Name":"Deutsch","Value":"de"}]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	options, err := client.GetLocalizationOptions(context.Background())
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(options) != 2 {
+		t.Fatalf("Expected 2 options, got %d", len(options))
+	}
+
+	if options[1].Value != "de" {
+		t.Errorf("Expected second option value 'de', got %s", options[1].Value)
+	}
+}
+
+func TestServerConfiguration_Strings(t *testing.T) {
+	config := ServerConfiguration{
+		"KnownProxies": json.RawMessage(`["10.0.0.1","192.168.1.0/24"]`),
+		"Empty":        json.RawMessage(`null`),
+	}
+
+	proxies := config.Strings("KnownProxies")
+	if len(proxies) != 2 || proxies[0] != "10.0.0.1" || proxies[1] != "192.168.1.0/24" {
+		t.Errorf("Expected [10.0.0.1 192.168.1.0/24], got %v", proxies)
+	}
+
+	if config.Strings("Empty") != nil || config.Strings("Missing") != nil {
+		t.Error("Expected nil for null or missing fields")
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Device represents a device registered with the server.
+type Device struct {
+	Id               string `json:"Id"`
+	Name             string `json:"Name"`
+	AppName          string `json:"AppName"`
+	AppVersion       string `json:"AppVersion"`
+	LastUserId       string `json:"LastUserId"`
+	LastUserName     string `json:"LastUserName"`
+	DateLastActivity string `json:"DateLastActivity"`
+}
+
+// DevicesResult represents the response from the devices endpoint.
+type DevicesResult struct {
+	Items            []Device `json:"Items"`
+	TotalRecordCount int      `json:"TotalRecordCount"`
+}
+
+// GetDevices retrieves the devices registered with the server.
+func (c *Client) GetDevices(ctx context.Context) ([]Device, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/Devices")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var result DevicesResult
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return result.Items, nil
+}
+
+// GetDevice retrieves a single device by ID. If the device doesn't exist the
+// returned error satisfies IsNotFound.
+func (c *Client) GetDevice(ctx context.Context, deviceID string) (*Device, error) {
+	devices, err := c.GetDevices(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range devices {
+		if devices[i].Id == deviceID {
+			return &devices[i], nil
+		}
+	}
+
+	return nil, &APIError{StatusCode: http.StatusNotFound, Body: fmt.Sprintf("device %s not found", deviceID)}
+}
+
+// DeleteDevice deregisters a device, signing it out and revoking its access
+// token.
+func (c *Client) DeleteDevice(ctx context.Context, deviceID string) error {
+	params := url.Values{}
+	params.Set("id", deviceID)
+
+	resp, err := c.doRequest(ctx, http.MethodDelete, "/Devices?"+params.Encode())
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return newAPIError(resp)
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const devicesPayload = `{"Items":[
+	{"Id":"device-1","Name":"Firefox","AppName":"Jellyfin Web","AppVersion":"10.9.0","LastUserId":"user-1","LastUserName":"alice","DateLastActivity":"2024-06-01T10:00:00.0000000Z"},
+	{"Id":"device-2","Name":"Apple TV","AppName":"Infuse","AppVersion":"7.7","LastUserId":"user-2","LastUserName":"bob","DateLastActivity":"2024-05-01T10:00:00.0000000Z"}
+],"TotalRecordCount":2,"StartIndex":0}`
+
+func TestGetDevices(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/Devices" {
+			t.Errorf("Expected GET /Devices, got %s %s", r.Method, r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(devicesPayload))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	devices, err := client.GetDevices(context.Background())
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(devices) != 2 {
+		t.Fatalf("Expected 2 devices, got %d", len(devices))
+	}
+
+	if devices[1].AppName != "Infuse" || devices[1].LastUserName != "bob" {
+		t.Errorf("Expected second device to be Infuse used by bob, got %+v", devices[1])
+	}
+
+	device, err := client.GetDevice(context.Background(), "device-1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if device.Name != "Firefox" {
+		t.Errorf("Expected device name Firefox, got %q", device.Name)
+	}
+
+	if _, err := client.GetDevice(context.Background(), "device-9"); !IsNotFound(err) {
+		t.Errorf("Expected not found error for a missing device, got %v", err)
+	}
+}
+
+func TestDeleteDevice(t *testing.T) {
+	var deleted string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/Devices" {
+			t.Errorf("Expected DELETE /Devices, got %s %s", r.Method, r.URL.Path)
+		}
+
+		deleted = r.URL.Query().Get("id")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+
+	if err := client.DeleteDevice(context.Background(), "device 1"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if deleted != "device 1" {
+		t.Errorf("Expected device 1 to be deleted, got %q", deleted)
+	}
+}
+
+func TestDeleteDevice_notFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+
+	if err := client.DeleteDevice(context.Background(), "device-9"); !IsNotFound(err) {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+}
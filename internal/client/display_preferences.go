@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// customPrefsField is the display preferences field holding client-defined settings.
+const customPrefsField = "CustomPrefs"
+
+// DisplayPreferences is a user's display preferences for one client, keyed by JSON
+// field name. Fields are kept as raw JSON so the built-in settings survive a write
+// that only changes CustomPrefs.
+type DisplayPreferences map[string]json.RawMessage
+
+// displayPreferencesPath returns the endpoint for a user's display preferences.
+func displayPreferencesPath(id, userID, client string) string {
+	params := url.Values{}
+	params.Set("userId", userID)
+	params.Set("client", client)
+
+	return "/DisplayPreferences/" + url.PathEscape(id) + "?" + params.Encode()
+}
+
+// GetDisplayPreferences retrieves a user's display preferences for a client. Jellyfin
+// returns defaults for preferences that have never been saved.
+func (c *Client) GetDisplayPreferences(ctx context.Context, id, userID, client string) (DisplayPreferences, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, displayPreferencesPath(id, userID, client))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var prefs DisplayPreferences
+	if err := json.NewDecoder(resp.Body).Decode(&prefs); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if prefs == nil {
+		prefs = DisplayPreferences{}
+	}
+
+	return prefs, nil
+}
+
+// UpdateDisplayPreferences replaces a user's display preferences for a client.
+func (c *Client) UpdateDisplayPreferences(ctx context.Context, id, userID, client string, prefs DisplayPreferences) error {
+	body, err := json.Marshal(prefs)
+	if err != nil {
+		return fmt.Errorf("failed to marshal display preferences: %w", err)
+	}
+
+	resp, err := c.doRequestWithBody(ctx, http.MethodPost, displayPreferencesPath(id, userID, client), body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return newAPIError(resp)
+	}
+
+	return nil
+}
+
+// PatchCustomPrefs performs a read-modify-write of a user's display preferences,
+// setting the given custom preferences and removing those named in remove. Other
+// custom preferences and the built-in settings are left as they are. It returns the
+// preferences as written.
+func (c *Client) PatchCustomPrefs(ctx context.Context, id, userID, client string, set map[string]string, remove []string) (DisplayPreferences, error) {
+	prefs, err := c.GetDisplayPreferences(ctx, id, userID, client)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := prefs.MergeCustomPrefs(set, remove); err != nil {
+		return nil, err
+	}
+
+	if err := c.UpdateDisplayPreferences(ctx, id, userID, client, prefs); err != nil {
+		return nil, err
+	}
+
+	return prefs, nil
+}
+
+// CustomPrefs decodes the client-defined settings, returning an empty map if there
+// are none. Null values are returned as "".
+func (dp DisplayPreferences) CustomPrefs() map[string]string {
+	var prefs map[string]*string
+	if raw, ok := dp[customPrefsField]; ok {
+		_ = json.Unmarshal(raw, &prefs)
+	}
+
+	values := make(map[string]string, len(prefs))
+	for key, value := range prefs {
+		if value != nil {
+			values[key] = *value
+		} else {
+			values[key] = ""
+		}
+	}
+
+	return values
+}
+
+// MergeCustomPrefs sets the given custom preferences and removes those named in
+// remove, keeping every other field.
+func (dp DisplayPreferences) MergeCustomPrefs(set map[string]string, remove []string) error {
+	prefs := dp.CustomPrefs()
+
+	for _, key := range remove {
+		delete(prefs, key)
+	}
+
+	for key, value := range set {
+		prefs[key] = value
+	}
+
+	raw, err := json.Marshal(prefs)
+	if err != nil {
+		return fmt.Errorf("failed to marshal custom preferences: %w", err)
+	}
+
+	dp[customPrefsField] = raw
+
+	return nil
+}
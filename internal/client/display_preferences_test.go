@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+const testDisplayPreferences = `{
+  "Id": "3ce5b65d-e116-d731-65d1-efc4a30ec35c",
+  "SortBy": "SortName",
+  "RememberIndexing": false,
+  "PrimaryImageHeight": 250,
+  "ScrollDirection": "Horizontal",
+  "ShowBackdrop": true,
+  "SortOrder": "Ascending",
+  "ShowSidebar": false,
+  "Client": "emby",
+  "CustomPrefs": {"homesection0": "smalllibrarytiles", "chromecastVersion": "stable", "unset": null}
+}`
+
+func TestGetDisplayPreferences(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if r.URL.Path != "/DisplayPreferences/usersettings" {
+			t.Errorf("Expected path /DisplayPreferences/usersettings, got %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("userId") != "user-1" || r.URL.Query().Get("client") != "emby" {
+			t.Errorf("Expected userId user-1 and client emby, got %s", r.URL.RawQuery)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testDisplayPreferences))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	prefs, err := client.GetDisplayPreferences(context.Background(), "usersettings", "user-1", "emby")
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := map[string]string{
+		"homesection0":      "smalllibrarytiles",
+		"chromecastVersion": "stable",
+		"unset":             "",
+	}
+	if got := prefs.CustomPrefs(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected custom prefs %v, got %v", expected, got)
+	}
+}
+
+func TestDisplayPreferences_MergeCustomPrefs(t *testing.T) {
+	var prefs DisplayPreferences
+	if err := json.Unmarshal([]byte(testDisplayPreferences), &prefs); err != nil {
+		t.Fatalf("Failed to decode preferences: %v", err)
+	}
+
+	before := make(DisplayPreferences, len(prefs))
+	for key, value := range prefs {
+		before[key] = value
+	}
+
+	err := prefs.MergeCustomPrefs(
+		map[string]string{"homesection0": "resume", "enableNextVideoInfoOverlay": "false"},
+		[]string{"chromecastVersion", "missing"},
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := map[string]string{
+		"homesection0":               "resume",
+		"enableNextVideoInfoOverlay": "false",
+		"unset":                      "",
+	}
+	if got := prefs.CustomPrefs(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected custom prefs %v, got %v", expected, got)
+	}
+
+	// Every built-in field must be untouched.
+	for key, value := range before {
+		if key == customPrefsField {
+			continue
+		}
+		if string(prefs[key]) != string(value) {
+			t.Errorf("Expected %s to stay %s, got %s", key, value, prefs[key])
+		}
+	}
+
+	if len(prefs) != len(before) {
+		t.Errorf("Expected %d fields, got %d", len(before), len(prefs))
+	}
+}
+
+func TestDisplayPreferences_MergeCustomPrefs_noCustomPrefs(t *testing.T) {
+	prefs := DisplayPreferences{"SortBy": json.RawMessage(`"SortName"`)}
+
+	if err := prefs.MergeCustomPrefs(map[string]string{"homesection0": "resume"}, nil); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if string(prefs[customPrefsField]) != `{"homesection0":"resume"}` {
+		t.Errorf("Expected CustomPrefs to be created, got %s", prefs[customPrefsField])
+	}
+}
+
+func TestPatchCustomPrefs(t *testing.T) {
+	var written map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(testDisplayPreferences))
+		case http.MethodPost:
+			if r.URL.Path != "/DisplayPreferences/usersettings" || r.URL.Query().Get("userId") != "user-1" {
+				t.Errorf("Expected write to usersettings for user-1, got %s", r.URL)
+			}
+
+			body, _ := io.ReadAll(r.Body)
+			if err := json.Unmarshal(body, &written); err != nil {
+				t.Errorf("Failed to decode request body: %v", err)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected %s request", r.Method)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	_, err := client.PatchCustomPrefs(context.Background(), "usersettings", "user-1", "emby", map[string]string{"homesection0": "resume"}, nil)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if written["SortBy"] != "SortName" || written["PrimaryImageHeight"] != float64(250) || written["ShowBackdrop"] != true {
+		t.Errorf("Expected built-in fields to be written back unchanged, got %v", written)
+	}
+
+	custom, _ := written["CustomPrefs"].(map[string]interface{})
+	if custom["homesection0"] != "resume" || custom["chromecastVersion"] != "stable" {
+		t.Errorf("Expected merged custom prefs, got %v", written["CustomPrefs"])
+	}
+}
+
+func TestGetDisplayPreferences_error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	_, err := client.GetDisplayPreferences(context.Background(), "usersettings", "missing", "emby")
+
+	if !IsNotFound(err) {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+}
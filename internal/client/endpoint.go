@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// NormalizeEndpoint returns the canonical form of a server URL: the scheme, which must
+// be http or https, and the host are lowercased, and trailing slashes are trimmed while
+// any base path, such as a reverse proxy subpath, is kept.
+func NormalizeEndpoint(raw string) (string, error) {
+	endpoint := strings.TrimSpace(raw)
+	if endpoint == "" {
+		return "", fmt.Errorf("endpoint is empty")
+	}
+
+	// Require the scheme rather than assuming one, so credentials are never sent in
+	// cleartext by default.
+	if !strings.Contains(endpoint, "://") {
+		return "", fmt.Errorf("invalid endpoint %q: missing scheme, use http:// or https://", raw)
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", fmt.Errorf("invalid endpoint %q: %w", raw, err)
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", fmt.Errorf("invalid endpoint %q: scheme must be http or https", raw)
+	}
+
+	if u.Hostname() == "" {
+		return "", fmt.Errorf("invalid endpoint %q: missing host", raw)
+	}
+
+	if u.RawQuery != "" || u.Fragment != "" || u.User != nil {
+		return "", fmt.Errorf("invalid endpoint %q: must not include credentials, a query or a fragment", raw)
+	}
+
+	u.Host = strings.ToLower(u.Host)
+	u.Path = strings.TrimRight(u.Path, "/")
+	u.RawPath = strings.TrimRight(u.RawPath, "/")
+
+	return u.String(), nil
+}
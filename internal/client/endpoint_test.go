@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeEndpoint(t *testing.T) {
+	testCases := []struct {
+		raw      string
+		expected string
+	}{
+		{"http://localhost:8096", "http://localhost:8096"},
+		{"http://localhost:8096/", "http://localhost:8096"},
+		{"  HTTPS://Media.Example.COM//  ", "https://media.example.com"},
+		{"https://example.com/jellyfin/", "https://example.com/jellyfin"},
+		{"https://example.com/Media/Jellyfin", "https://example.com/Media/Jellyfin"},
+	}
+
+	for _, tc := range testCases {
+		got, err := NormalizeEndpoint(tc.raw)
+
+		if err != nil {
+			t.Errorf("Expected no error for %q, got %v", tc.raw, err)
+			continue
+		}
+
+		if got != tc.expected {
+			t.Errorf("Expected %q for %q, got %q", tc.expected, tc.raw, got)
+		}
+	}
+}
+
+func TestNormalizeEndpoint_invalid(t *testing.T) {
+	for _, raw := range []string{"", "   ", "ftp://example.com", "http://", "http://:8096", "https://example.com/?x=1", "https://example.com/#top", "https://user:pw@example.com"} {
+		if _, err := NormalizeEndpoint(raw); err == nil {
+			t.Errorf("Expected error for %q", raw)
+		}
+	}
+}
+
+func TestNewClientWithAuthAndConfig_invalidEndpoint(t *testing.T) {
+	testCases := map[string]string{
+		"ftp://localhost:8096": "scheme must be http or https",
+		"http://":              "missing host",
+		"https://:8096":        "missing host",
+		"localhost:8096":       "missing scheme",
+	}
+
+	for raw, expected := range testCases {
+		_, err := NewClientWithAuthAndConfig(context.Background(), raw, "user", "pass", nil)
+
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("Expected an error containing %q for %q, got %v", expected, raw, err)
+		}
+	}
+}
+
+func TestNewClientWithAuthAndConfig_endpointWithoutScheme(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"AccessToken":"test-token"}`))
+	}))
+	defer server.Close()
+
+	raw := strings.TrimPrefix(server.URL, "http://") + "/"
+
+	_, err := NewClientWithAuthAndConfig(context.Background(), raw, "user", "pass", nil)
+	if err == nil || !strings.Contains(err.Error(), "missing scheme") {
+		t.Fatalf("Expected a missing scheme error for %q, got %v", raw, err)
+	}
+
+	if requests != 0 {
+		t.Errorf("Expected credentials not to be sent, got %d requests", requests)
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// APIError is returned when the Jellyfin API responds with an unexpected status code.
+type APIError struct {
+	StatusCode int
+	Body       string
+
+	// Hint explains a known failure in terms the user can act on, or is empty.
+	Hint string
+}
+
+func (e *APIError) Error() string {
+	if e.Hint != "" {
+		return fmt.Sprintf("%s (API request failed with status %d: %s)", e.Hint, e.StatusCode, e.Body)
+	}
+	return fmt.Sprintf("API request failed with status %d: %s", e.StatusCode, e.Body)
+}
+
+// errorHint describes a failure that is better explained than reported as a raw status.
+type errorHint struct {
+	// path is a path segment identifying the endpoint, matched anywhere in the request
+	// path so servers behind a reverse proxy subpath are covered.
+	path   string
+	status int
+	hint   string
+}
+
+// errorHints are the known failures given a hint in APIError.
+var errorHints = []errorHint{
+	{
+		path:   "/Auth/Keys",
+		status: http.StatusForbidden,
+		hint:   "managing API keys requires an administrator token; authenticate as an administrator or use an administrator's API key",
+	},
+}
+
+// newAPIError builds an APIError from a response, consuming its body.
+func newAPIError(resp *http.Response) error {
+	body, _ := io.ReadAll(resp.Body)
+	return &APIError{
+		StatusCode: resp.StatusCode,
+		Body:       string(body),
+		Hint:       hintFor(resp),
+	}
+}
+
+// hintFor returns the hint for a failed response, or "" if there is none.
+func hintFor(resp *http.Response) string {
+	if resp.Request == nil || resp.Request.URL == nil {
+		return ""
+	}
+
+	for _, h := range errorHints {
+		if resp.StatusCode == h.status && strings.Contains(resp.Request.URL.Path, h.path) {
+			return h.hint
+		}
+	}
+
+	return ""
+}
+
+// IsNotFound reports whether err is an APIError for a 404 Not Found response.
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsUnauthorized reports whether err is an APIError for a 401 Unauthorized response.
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized)
+}
+
+// IsForbidden reports whether err is an APIError for a 403 Forbidden response.
+func IsForbidden(err error) bool {
+	return hasStatus(err, http.StatusForbidden)
+}
+
+func hasStatus(err error, status int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == status
+}
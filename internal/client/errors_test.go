@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAPIError_Error(t *testing.T) {
+	err := &APIError{StatusCode: http.StatusBadRequest, Body: "bad input"}
+
+	expected := "API request failed with status 400: bad input"
+	if err.Error() != expected {
+		t.Errorf("Expected error %q, got %q", expected, err.Error())
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{"nil", nil, false},
+		{"not found", &APIError{StatusCode: http.StatusNotFound}, true},
+		{"wrapped not found", fmt.Errorf("reading item: %w", &APIError{StatusCode: http.StatusNotFound}), true},
+		{"other status", &APIError{StatusCode: http.StatusInternalServerError}, false},
+		{"other error", errors.New("API request failed with status 404"), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := IsNotFound(tc.err); got != tc.expected {
+				t.Errorf("Expected IsNotFound %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestIsUnauthorized(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{"nil", nil, false},
+		{"unauthorized", &APIError{StatusCode: http.StatusUnauthorized}, true},
+		{"wrapped unauthorized", fmt.Errorf("authentication failed: %w", &APIError{StatusCode: http.StatusUnauthorized}), true},
+		{"forbidden", &APIError{StatusCode: http.StatusForbidden}, false},
+		{"other error", errors.New("unauthorized"), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := IsUnauthorized(tc.err); got != tc.expected {
+				t.Errorf("Expected IsUnauthorized %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestClient_errorHandling_apiErrorType(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte("Unauthorized"))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	_, err := client.GetKeys(context.Background())
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected *APIError, got %T", err)
+	}
+
+	if apiErr.Body != "Unauthorized" {
+		t.Errorf("Expected body 'Unauthorized', got %s", apiErr.Body)
+	}
+
+	if !IsUnauthorized(err) {
+		t.Error("Expected IsUnauthorized to be true")
+	}
+}
+
+func TestGetKeys_forbiddenForNonAdmin(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "user-token")
+	_, err := client.GetKeys(context.Background())
+
+	if !IsForbidden(err) {
+		t.Fatalf("Expected IsForbidden to be true, got %v", err)
+	}
+
+	if !strings.Contains(err.Error(), "managing API keys requires an administrator token") {
+		t.Errorf("Expected error to explain that an administrator token is required, got %q", err.Error())
+	}
+}
+
+func TestAPIError_noHintForOtherEndpoints(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte("Forbidden"))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "user-token")
+	_, err := client.GetUsers(context.Background())
+
+	expected := "API request failed with status 403: Forbidden"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected error %q, got %v", expected, err)
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// DefaultHealthCheckTimeout is how long each health check request may take when no
+// timeout is configured.
+const DefaultHealthCheckTimeout = 5 * time.Second
+
+// ErrServerNotReady is wrapped by the errors CheckHealth returns when the server isn't
+// ready to accept requests.
+var ErrServerNotReady = errors.New("server not ready")
+
+// HealthCheck describes the checks CheckHealth performs.
+type HealthCheck struct {
+	// Timeout limits each request. Zero uses DefaultHealthCheckTimeout.
+	Timeout time.Duration
+
+	// RequireStartupWizard also requires the server's initial setup to be finished.
+	RequireStartupWizard bool
+}
+
+// CheckHealth verifies that the server at endpoint is up without authenticating: it
+// must answer /System/Ping and, if required, report through /System/Info/Public that
+// the startup wizard has been completed. Requests aren't retried, so an unavailable
+// server is reported at once. Errors for an unhealthy server wrap ErrServerNotReady.
+func CheckHealth(ctx context.Context, endpoint string, config *ClientConfig, check HealthCheck) error {
+	endpoint, err := NormalizeEndpoint(endpoint)
+	if err != nil {
+		return err
+	}
+
+	httpClient, err := newHTTPClient(config)
+	if err != nil {
+		return err
+	}
+
+	// Copy the client so the health check timeout doesn't leak into a supplied one.
+	healthClient := *httpClient
+	healthClient.Timeout = DefaultHealthCheckTimeout
+	if check.Timeout > 0 {
+		healthClient.Timeout = check.Timeout
+	}
+
+	// Without a token the requests carry no credentials, but are still rate limited,
+	// logged and capped in size like any other.
+	c := newClient(endpoint, "", config)
+	c.httpClient = &healthClient
+
+	resp, err := c.send(ctx, http.MethodGet, "/System/Ping", nil, "")
+	if err != nil {
+		return fmt.Errorf("%w: ping failed: %w", ErrServerNotReady, err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: ping failed: %w", ErrServerNotReady, newAPIError(resp))
+	}
+
+	if !check.RequireStartupWizard {
+		return nil
+	}
+
+	resp, err = c.send(ctx, http.MethodGet, "/System/Info/Public", nil, "")
+	if err != nil {
+		return fmt.Errorf("%w: failed to read public system info: %w", ErrServerNotReady, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: failed to read public system info: %w", ErrServerNotReady, newAPIError(resp))
+	}
+
+	var info PublicSystemInfo
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if !info.StartupWizardCompleted {
+		return fmt.Errorf("%w: the startup wizard has not been completed", ErrServerNotReady)
+	}
+
+	return nil
+}
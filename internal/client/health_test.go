@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newHealthServer starts a server answering the health check endpoints, returning the
+// given ping status and startup wizard state.
+func newHealthServer(t *testing.T, pingStatus int, wizardCompleted bool) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if auth := r.Header.Get("Authorization"); auth != "" {
+			t.Errorf("Expected no Authorization header, got %q", auth)
+		}
+
+		switch r.URL.Path {
+		case "/System/Ping":
+			w.WriteHeader(pingStatus)
+			_, _ = w.Write([]byte(`"Jellyfin Server"`))
+		case "/System/Info/Public":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = fmt.Fprintf(w, `{"ServerName":"media","StartupWizardCompleted":%t}`, wizardCompleted)
+		default:
+			t.Errorf("Unexpected request to %s", r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestCheckHealth(t *testing.T) {
+	testCases := []struct {
+		name            string
+		pingStatus      int
+		wizardCompleted bool
+		check           HealthCheck
+		notReady        bool
+	}{
+		{"healthy", http.StatusOK, false, HealthCheck{}, false},
+		{"ping failing", http.StatusServiceUnavailable, true, HealthCheck{}, true},
+		{"wizard completed", http.StatusOK, true, HealthCheck{RequireStartupWizard: true}, false},
+		{"wizard incomplete", http.StatusOK, false, HealthCheck{RequireStartupWizard: true}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := newHealthServer(t, tc.pingStatus, tc.wizardCompleted)
+
+			err := CheckHealth(context.Background(), server.URL+"/", nil, tc.check)
+
+			if tc.notReady {
+				if !errors.Is(err, ErrServerNotReady) {
+					t.Errorf("Expected ErrServerNotReady, got %v", err)
+				}
+			} else if err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestCheckHealth_unreachable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	err := CheckHealth(context.Background(), server.URL, nil, HealthCheck{})
+
+	if !errors.Is(err, ErrServerNotReady) {
+		t.Errorf("Expected ErrServerNotReady for an unreachable server, got %v", err)
+	}
+}
+
+func TestCheckHealth_timeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	start := time.Now()
+	err := CheckHealth(context.Background(), server.URL, nil, HealthCheck{Timeout: 50 * time.Millisecond})
+
+	if !errors.Is(err, ErrServerNotReady) {
+		t.Errorf("Expected ErrServerNotReady when the ping times out, got %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected the health check to give up after its timeout, took %s", elapsed)
+	}
+}
+
+func TestCheckHealth_httpClientNotModified(t *testing.T) {
+	server := newHealthServer(t, http.StatusOK, true)
+
+	httpClient := &http.Client{Timeout: time.Minute}
+
+	if err := CheckHealth(context.Background(), server.URL, &ClientConfig{HTTPClient: httpClient}, HealthCheck{Timeout: time.Second}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if httpClient.Timeout != time.Minute {
+		t.Errorf("Expected the supplied client's timeout to be left at 1m, got %s", httpClient.Timeout)
+	}
+}
+
+func TestCheckHealth_maxResponseBytes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/System/Ping" {
+			_, _ = w.Write([]byte(`"Jellyfin Server"`))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"ServerName":%q,"StartupWizardCompleted":true}`, strings.Repeat("x", 1024))
+	}))
+	defer server.Close()
+
+	err := CheckHealth(context.Background(), server.URL, &ClientConfig{MaxResponseBytes: 100}, HealthCheck{RequireStartupWizard: true})
+
+	if !errors.Is(err, ErrResponseTooLarge) {
+		t.Errorf("Expected ErrResponseTooLarge, got %v", err)
+	}
+}
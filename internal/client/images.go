@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ImageTypePrimary is the image type used for posters and cover art.
+const ImageTypePrimary = "Primary"
+
+// UploadItemImage sets an item's image of the given type, e.g. ImageTypePrimary.
+// The content type is detected from the image data.
+func (c *Client) UploadItemImage(ctx context.Context, itemID, imageType string, data []byte) error {
+	contentType := http.DetectContentType(data)
+	if !strings.HasPrefix(contentType, "image/") {
+		return fmt.Errorf("unsupported image content type %q", contentType)
+	}
+
+	// Jellyfin expects the image as a base64-encoded request body.
+	body := []byte(base64.StdEncoding.EncodeToString(data))
+
+	resp, err := c.doRequestWithContent(ctx, http.MethodPost, itemImagePath(itemID, imageType), body, contentType)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return newAPIError(resp)
+	}
+
+	return nil
+}
+
+// DeleteItemImage removes an item's image of the given type.
+func (c *Client) DeleteItemImage(ctx context.Context, itemID, imageType string) error {
+	resp, err := c.doRequest(ctx, http.MethodDelete, itemImagePath(itemID, imageType))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return newAPIError(resp)
+	}
+
+	return nil
+}
+
+// DownloadImage fetches an image from an arbitrary URL for uploading to the server.
+// The request is sent without Jellyfin credentials.
+func (c *Client) DownloadImage(ctx context.Context, imageURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, imageURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download image: %w", err)
+	}
+	c.limitBody(resp)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download image: unexpected status %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image: %w", err)
+	}
+
+	return data, nil
+}
+
+func itemImagePath(itemID, imageType string) string {
+	return "/Items/" + url.PathEscape(itemID) + "/Images/" + url.PathEscape(imageType)
+}
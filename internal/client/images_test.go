@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// pngHeader is enough of a PNG file for content type detection.
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func TestUploadItemImage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if r.URL.Path != "/Items/box-1/Images/Primary" {
+			t.Errorf("Expected path /Items/box-1/Images/Primary, got %s", r.URL.Path)
+		}
+		if r.Header.Get("Content-Type") != "image/png" {
+			t.Errorf("Expected Content-Type image/png, got %s", r.Header.Get("Content-Type"))
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != base64.StdEncoding.EncodeToString(pngHeader) {
+			t.Errorf("Expected base64-encoded image body, got %s", body)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	if err := client.UploadItemImage(context.Background(), "box-1", ImageTypePrimary, pngHeader); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestUploadItemImage_expectContinue(t *testing.T) {
+	for _, enabled := range []bool{false, true} {
+		var uploadExpect, jsonExpect string
+
+		client := newClient("http://jellyfin.test", "test-api-key", &ClientConfig{ExpectContinue: enabled})
+		client.httpClient = &http.Client{Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get("Content-Type") == "application/json" {
+				jsonExpect = req.Header.Get("Expect")
+			} else {
+				uploadExpect = req.Header.Get("Expect")
+			}
+			return &http.Response{StatusCode: http.StatusNoContent, Body: http.NoBody, Header: http.Header{}}, nil
+		})}
+
+		if err := client.UploadItemImage(context.Background(), "box-1", ImageTypePrimary, pngHeader); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if err := client.UpdateUserPolicy(context.Background(), "user-1", UserPolicy{}); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		expected := ""
+		if enabled {
+			expected = "100-continue"
+		}
+
+		if uploadExpect != expected {
+			t.Errorf("With ExpectContinue %t, expected upload Expect header %q, got %q", enabled, expected, uploadExpect)
+		}
+
+		if jsonExpect != "" {
+			t.Errorf("With ExpectContinue %t, expected no Expect header on JSON requests, got %q", enabled, jsonExpect)
+		}
+	}
+}
+
+func TestUploadItemImage_notAnImage(t *testing.T) {
+	client := NewClient("http://localhost:8096", "test-api-key")
+
+	if err := client.UploadItemImage(context.Background(), "box-1", ImageTypePrimary, []byte("hello")); err == nil {
+		t.Error("Expected error for non-image data")
+	}
+}
+
+func TestDeleteItemImage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE request, got %s", r.Method)
+		}
+		if r.URL.Path != "/Items/box-1/Images/Primary" {
+			t.Errorf("Expected path /Items/box-1/Images/Primary, got %s", r.URL.Path)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	if err := client.DeleteItemImage(context.Background(), "box-1", ImageTypePrimary); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestDownloadImage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "" {
+			t.Errorf("Expected no Authorization header, got %s", r.Header.Get("Authorization"))
+		}
+
+		if r.URL.Path == "/missing.png" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_, _ = w.Write(pngHeader)
+	}))
+	defer server.Close()
+
+	client := NewClient("http://localhost:8096", "test-api-key")
+
+	data, err := client.DownloadImage(context.Background(), server.URL+"/poster.png")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if string(data) != string(pngHeader) {
+		t.Errorf("Expected downloaded image data, got %q", data)
+	}
+
+	if _, err := client.DownloadImage(context.Background(), server.URL+"/missing.png"); err == nil {
+		t.Error("Expected error for missing image")
+	}
+}
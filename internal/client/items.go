@@ -0,0 +1,269 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Item represents a Jellyfin media item.
+type Item struct {
+	Id             string            `json:"Id"`
+	Name           string            `json:"Name"`
+	Type           string            `json:"Type"`
+	ParentId       string            `json:"ParentId"`
+	Path           string            `json:"Path"`
+	Overview       string            `json:"Overview"`
+	ProductionYear int64             `json:"ProductionYear"`
+	ImageTags      map[string]string `json:"ImageTags"`
+	MediaSources   []MediaSource     `json:"MediaSources"`
+	DisplayOrder   string            `json:"DisplayOrder"`
+}
+
+// MediaSource represents one playable version of an item.
+type MediaSource struct {
+	Id           string        `json:"Id"`
+	Path         string        `json:"Path"`
+	Size         int64         `json:"Size"`
+	MediaStreams []MediaStream `json:"MediaStreams"`
+}
+
+// MediaStream represents a video, audio or subtitle track within a media source.
+type MediaStream struct {
+	// Type is "Video", "Audio", "Subtitle", "EmbeddedImage" or "Data".
+	Type     string `json:"Type"`
+	Codec    string `json:"Codec"`
+	Language string `json:"Language"`
+
+	// Width and Height are zero for streams without a picture.
+	Width  int64 `json:"Width"`
+	Height int64 `json:"Height"`
+}
+
+// ItemQueryResult represents the response from the /Items endpoint.
+type ItemQueryResult struct {
+	Items            []Item `json:"Items"`
+	TotalRecordCount int    `json:"TotalRecordCount"`
+	StartIndex       int    `json:"StartIndex"`
+}
+
+// itemsQuery holds the query parameters for the /Items endpoint.
+type itemsQuery struct {
+	ParentID         string
+	SearchTerm       string
+	Recursive        bool
+	IsFolder         *bool
+	IncludeItemTypes []string
+	Fields           []string
+	Start            int
+	Limit            int
+}
+
+// values encodes the query as URL parameters.
+func (q itemsQuery) values() url.Values {
+	params := url.Values{}
+
+	if q.ParentID != "" {
+		params.Set("parentId", q.ParentID)
+	}
+	if q.SearchTerm != "" {
+		params.Set("searchTerm", q.SearchTerm)
+	}
+	if q.Recursive {
+		params.Set("recursive", "true")
+	}
+	if q.IsFolder != nil {
+		params.Set("isFolder", strconv.FormatBool(*q.IsFolder))
+	}
+	if len(q.IncludeItemTypes) > 0 {
+		params.Set("includeItemTypes", strings.Join(q.IncludeItemTypes, ","))
+	}
+	if len(q.Fields) > 0 {
+		params.Set("fields", strings.Join(q.Fields, ","))
+	}
+	params.Set("startIndex", strconv.Itoa(q.Start))
+	if q.Limit > 0 {
+		params.Set("limit", strconv.Itoa(q.Limit))
+	}
+
+	return params
+}
+
+// getItems queries a single page of items.
+func (c *Client) getItems(ctx context.Context, query itemsQuery) (*ItemQueryResult, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/Items?"+query.values().Encode())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var result ItemQueryResult
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// ItemSearch filters the items returned by SearchItems. An empty ParentID searches
+// the whole server, and an empty SearchTerm matches items of any name.
+type ItemSearch struct {
+	ParentID         string
+	SearchTerm       string
+	IncludeItemTypes []string
+	Recursive        bool
+}
+
+// SearchItems returns the items matching search, paging through the results. At most
+// maxItems items are returned; truncated reports whether more matched.
+func (c *Client) SearchItems(ctx context.Context, search ItemSearch, maxItems int) (items []Item, truncated bool, err error) {
+	const pageSize = 200
+
+	for start := 0; start < maxItems; start += pageSize {
+		limit := pageSize
+		if remaining := maxItems - start; remaining < limit {
+			limit = remaining
+		}
+
+		result, err := c.getItems(ctx, itemsQuery{
+			ParentID:         search.ParentID,
+			SearchTerm:       search.SearchTerm,
+			Recursive:        search.Recursive,
+			IncludeItemTypes: search.IncludeItemTypes,
+			Fields:           []string{"ParentId"},
+			Start:            start,
+			Limit:            limit,
+		})
+		if err != nil {
+			return nil, false, err
+		}
+
+		items = append(items, result.Items...)
+
+		if start+len(result.Items) >= result.TotalRecordCount || len(result.Items) == 0 {
+			return items, false, nil
+		}
+	}
+
+	return items, true, nil
+}
+
+// GetItem retrieves a single item. If the item doesn't exist the returned error
+// satisfies IsNotFound.
+func (c *Client) GetItem(ctx context.Context, id string) (*Item, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/Items/"+url.PathEscape(id))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var item Item
+	if err := json.NewDecoder(resp.Body).Decode(&item); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &item, nil
+}
+
+// DeleteItem deletes an item, such as a collection, from the library.
+func (c *Client) DeleteItem(ctx context.Context, id string) error {
+	resp, err := c.doRequest(ctx, http.MethodDelete, "/Items/"+url.PathEscape(id))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return newAPIError(resp)
+	}
+
+	return nil
+}
+
+// GetItemMediaStreams retrieves the streams of an item's default (first) media source.
+// Items that aren't playable, such as folders, have none. If the item doesn't exist
+// the returned error satisfies IsNotFound.
+func (c *Client) GetItemMediaStreams(ctx context.Context, id string) ([]MediaStream, error) {
+	params := url.Values{}
+	params.Set("fields", "MediaSources")
+
+	resp, err := c.doRequest(ctx, http.MethodGet, "/Items/"+url.PathEscape(id)+"?"+params.Encode())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var item Item
+	if err := json.NewDecoder(resp.Body).Decode(&item); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if len(item.MediaSources) == 0 {
+		return nil, nil
+	}
+
+	return item.MediaSources[0].MediaStreams, nil
+}
+
+// GetItemRaw retrieves a single item and returns the response body unmodified, so
+// fields the Item type doesn't model are available to callers. If the item doesn't
+// exist the returned error satisfies IsNotFound.
+func (c *Client) GetItemRaw(ctx context.Context, id string) (string, error) {
+	return c.getItemRaw(ctx, "/Items/"+url.PathEscape(id))
+}
+
+// GetUserItemRaw is GetItemRaw in the context of a user, for items and fields that are
+// only visible to that user, such as their playback state. If the item doesn't exist
+// or the user can't see it, the returned error satisfies IsNotFound.
+func (c *Client) GetUserItemRaw(ctx context.Context, userID, id string) (string, error) {
+	return c.getItemRaw(ctx, "/Users/"+url.PathEscape(userID)+"/Items/"+url.PathEscape(id))
+}
+
+// getItemRaw retrieves the item at path and returns the response body unmodified.
+func (c *Client) getItemRaw(ctx context.Context, path string) (string, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, path)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", newAPIError(resp)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if !json.Valid(body) {
+		return "", fmt.Errorf("failed to decode response: invalid JSON")
+	}
+
+	return string(body), nil
+}
+
+// boolPtr returns a pointer to b, for optional query parameters.
+func boolPtr(b bool) *bool {
+	return &b
+}
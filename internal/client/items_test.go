@@ -0,0 +1,363 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func TestGetItemRaw(t *testing.T) {
+	payload := `{"Id":"item-1","Name":"Alien","Type":"Movie","ProductionYear":1979}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if r.URL.Path != "/Items/item-1" {
+			t.Errorf("Expected path /Items/item-1, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(payload))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	raw, err := client.GetItemRaw(context.Background(), "item-1")
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if raw != payload {
+		t.Errorf("Expected raw response %s, got %s", payload, raw)
+	}
+}
+
+func TestGetUserItemRaw(t *testing.T) {
+	payload := `{"Id":"item-1","Name":"Alien","UserData":{"Played":true}}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != "/Users/user%201/Items/item-1" {
+			t.Errorf("Expected path /Users/user%%201/Items/item-1, got %s", r.URL.EscapedPath())
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(payload))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	raw, err := client.GetUserItemRaw(context.Background(), "user 1", "item-1")
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if raw != payload {
+		t.Errorf("Expected raw response %s, got %s", payload, raw)
+	}
+}
+
+func TestGetItemRaw_notFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	_, err := client.GetItemRaw(context.Background(), "missing")
+
+	if !IsNotFound(err) {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+}
+
+func TestGetItemRaw_invalidJSON(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("<Item/>"))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	if _, err := client.GetItemRaw(context.Background(), "item-1"); err == nil {
+		t.Error("Expected error for non-JSON response")
+	}
+}
+
+func TestGetItem(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/Items/box-1" {
+			t.Errorf("Expected path /Items/box-1, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"Id":"box-1","Name":"Alien Collection","Type":"BoxSet","ImageTags":{"Primary":"tag-1"}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	item, err := client.GetItem(context.Background(), "box-1")
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if item.Name != "Alien Collection" {
+		t.Errorf("Expected name 'Alien Collection', got %s", item.Name)
+	}
+
+	if item.ImageTags[ImageTypePrimary] != "tag-1" {
+		t.Errorf("Expected primary image tag 'tag-1', got %s", item.ImageTags[ImageTypePrimary])
+	}
+}
+
+func TestGetItemMediaStreams(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/Items/movie-1" {
+			t.Errorf("Expected path /Items/movie-1, got %s", r.URL.Path)
+		}
+		if fields := r.URL.Query().Get("fields"); fields != "MediaSources" {
+			t.Errorf("Expected fields=MediaSources, got %q", fields)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+  "Id": "movie-1",
+  "Name": "Alien",
+  "MediaSources": [
+    {
+      "Id": "source-1",
+      "MediaStreams": [
+        {"Type": "Video", "Codec": "hevc", "Width": 3840, "Height": 2160},
+        {"Type": "Audio", "Codec": "eac3", "Language": "eng"}
+      ]
+    },
+    {"Id": "source-2", "MediaStreams": [{"Type": "Video", "Codec": "h264", "Width": 1920, "Height": 1080}]}
+  ]
+}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	streams, err := client.GetItemMediaStreams(context.Background(), "movie-1")
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(streams) != 2 {
+		t.Fatalf("Expected 2 streams from the first media source, got %d", len(streams))
+	}
+
+	if streams[0].Codec != "hevc" || streams[0].Width != 3840 || streams[0].Height != 2160 {
+		t.Errorf("Expected a 3840x2160 hevc video stream, got %+v", streams[0])
+	}
+
+	if streams[1].Type != "Audio" || streams[1].Language != "eng" {
+		t.Errorf("Expected an English audio stream, got %+v", streams[1])
+	}
+}
+
+func TestGetItemMediaStreams_noMediaSources(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"Id":"box-1","Name":"Alien Collection","Type":"BoxSet"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	streams, err := client.GetItemMediaStreams(context.Background(), "box-1")
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(streams) != 0 {
+		t.Errorf("Expected no streams, got %+v", streams)
+	}
+}
+
+func TestDeleteItem(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE request, got %s", r.Method)
+		}
+		if r.URL.Path != "/Items/box-1" {
+			t.Errorf("Expected path /Items/box-1, got %s", r.URL.Path)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	if err := client.DeleteItem(context.Background(), "box-1"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestSearchItems_serverWide(t *testing.T) {
+	const total = 250
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		query := r.URL.Query()
+		if query.Has("parentId") {
+			t.Errorf("Expected no parentId for a server-wide search, got %s", query.Get("parentId"))
+		}
+		if query.Get("includeItemTypes") != "Movie" {
+			t.Errorf("Expected includeItemTypes Movie, got %s", query.Get("includeItemTypes"))
+		}
+		if query.Get("recursive") != "true" {
+			t.Errorf("Expected recursive=true, got %s", query.Get("recursive"))
+		}
+
+		start, _ := strconv.Atoi(query.Get("startIndex"))
+		limit, _ := strconv.Atoi(query.Get("limit"))
+
+		items := []Item{}
+		for i := start; i < start+limit && i < total; i++ {
+			items = append(items, Item{Id: fmt.Sprintf("item-%d", i), ParentId: "lib-1"})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(ItemQueryResult{Items: items, TotalRecordCount: total, StartIndex: start})
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	items, truncated, err := client.SearchItems(context.Background(), ItemSearch{
+		IncludeItemTypes: []string{"Movie"},
+		Recursive:        true,
+	}, 1000)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(items) != total {
+		t.Errorf("Expected %d items, got %d", total, len(items))
+	}
+
+	if truncated {
+		t.Error("Expected result not to be truncated")
+	}
+
+	if requests != 2 {
+		t.Errorf("Expected 2 page requests, got %d", requests)
+	}
+}
+
+func TestSearchItems_maxItems(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		items := make([]Item, limit)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(ItemQueryResult{Items: items, TotalRecordCount: 5000})
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	items, truncated, err := client.SearchItems(context.Background(), ItemSearch{Recursive: true}, 300)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(items) != 300 {
+		t.Errorf("Expected 300 items, got %d", len(items))
+	}
+
+	if !truncated {
+		t.Error("Expected result to be truncated")
+	}
+}
+
+func TestSearchItems_searchTermAndTypes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("searchTerm") != "alien" {
+			t.Errorf("Expected searchTerm alien, got %s", query.Get("searchTerm"))
+		}
+		if query.Get("includeItemTypes") != "Movie,BoxSet" {
+			t.Errorf("Expected includeItemTypes Movie,BoxSet, got %s", query.Get("includeItemTypes"))
+		}
+		if query.Get("parentId") != "lib-1" {
+			t.Errorf("Expected parentId lib-1, got %s", query.Get("parentId"))
+		}
+
+		items := []Item{
+			{Id: "movie-1", Name: "Alien", Type: "Movie"},
+			{Id: "box-1", Name: "Alien Collection", Type: "BoxSet"},
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(ItemQueryResult{Items: items, TotalRecordCount: len(items)})
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	items, truncated, err := client.SearchItems(context.Background(), ItemSearch{
+		ParentID:         "lib-1",
+		SearchTerm:       "alien",
+		IncludeItemTypes: []string{"Movie", "BoxSet"},
+		Recursive:        true,
+	}, 100)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(items) != 2 || items[0].Type != "Movie" || items[1].Type != "BoxSet" {
+		t.Errorf("Expected a Movie and a BoxSet, got %+v", items)
+	}
+
+	if truncated {
+		t.Error("Expected result not to be truncated")
+	}
+}
+
+func TestSearchItems_empty(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		if r.URL.Query().Has("searchTerm") {
+			t.Errorf("Expected no searchTerm, got %s", r.URL.Query().Get("searchTerm"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"Items":[],"TotalRecordCount":0,"StartIndex":0}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	items, truncated, err := client.SearchItems(context.Background(), ItemSearch{IncludeItemTypes: []string{"Movie"}}, 1000)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(items) != 0 {
+		t.Errorf("Expected no items, got %d", len(items))
+	}
+
+	if truncated {
+		t.Error("Expected result not to be truncated")
+	}
+
+	if requests != 1 {
+		t.Errorf("Expected 1 page request, got %d", requests)
+	}
+}
@@ -0,0 +1,275 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// VirtualFolder represents a Jellyfin library (a "virtual folder" in the API).
+type VirtualFolder struct {
+	Name           string   `json:"Name"`
+	CollectionType string   `json:"CollectionType"`
+	ItemId         string   `json:"ItemId"`
+	Locations      []string `json:"Locations"`
+	RefreshStatus  string   `json:"RefreshStatus"`
+
+	// RefreshProgress is the scan progress (0-100) while the library is refreshing.
+	RefreshProgress *float64 `json:"RefreshProgress"`
+
+	// LibraryOptions holds the library's settings, kept as raw JSON so settings the
+	// provider doesn't model survive a write.
+	LibraryOptions ServerConfiguration `json:"LibraryOptions"`
+}
+
+// RefreshStatus describes the metadata refresh state of an item.
+type RefreshStatus struct {
+	// Status is the refresh state reported by the server (e.g., "Idle" or "Active").
+	Status string
+
+	// Progress is the refresh progress (0-100), or nil when no refresh is running.
+	Progress *float64
+}
+
+// GetVirtualFolders retrieves all libraries configured on the server.
+func (c *Client) GetVirtualFolders(ctx context.Context) ([]VirtualFolder, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/Library/VirtualFolders")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var folders []VirtualFolder
+	if err := json.NewDecoder(resp.Body).Decode(&folders); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return folders, nil
+}
+
+// RefreshLibrary starts a scan of all libraries. It returns once the scan is queued,
+// not when it finishes.
+func (c *Client) RefreshLibrary(ctx context.Context) error {
+	resp, err := c.doRequest(ctx, http.MethodPost, "/Library/Refresh")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		return newAPIError(resp)
+	}
+
+	return nil
+}
+
+// FindVirtualFolderByName finds a library by its name.
+func (c *Client) FindVirtualFolderByName(ctx context.Context, name string) (*VirtualFolder, error) {
+	folders, err := c.GetVirtualFolders(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, folder := range folders {
+		if folder.Name == name {
+			return &folder, nil
+		}
+	}
+
+	return nil, nil // Not found
+}
+
+// DeleteVirtualFolderByID deletes the library with the given item ID. The API only
+// deletes libraries by name, so the ID is resolved to a name first, and the deletion is
+// refused if another library shares that name, since the server can't tell them apart.
+// If no library has the ID the returned error satisfies IsNotFound.
+func (c *Client) DeleteVirtualFolderByID(ctx context.Context, itemID string) error {
+	folders, err := c.GetVirtualFolders(ctx)
+	if err != nil {
+		return err
+	}
+
+	target, err := findVirtualFolderByID(folders, itemID)
+	if err != nil {
+		return err
+	}
+
+	for _, folder := range folders {
+		if folder.ItemId != target.ItemId && strings.EqualFold(folder.Name, target.Name) {
+			return fmt.Errorf("refusing to delete library %s: library %s has the same name %q", target.ItemId, folder.ItemId, target.Name)
+		}
+	}
+
+	params := url.Values{}
+	params.Set("name", target.Name)
+
+	resp, err := c.doRequest(ctx, http.MethodDelete, "/Library/VirtualFolders?"+params.Encode())
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return newAPIError(resp)
+	}
+
+	return nil
+}
+
+// GetLibraryOptions retrieves the settings of the library with the given item ID. If
+// no library has the ID the returned error satisfies IsNotFound.
+func (c *Client) GetLibraryOptions(ctx context.Context, libraryID string) (ServerConfiguration, error) {
+	folders, err := c.GetVirtualFolders(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	folder, err := findVirtualFolderByID(folders, libraryID)
+	if err != nil {
+		return nil, err
+	}
+
+	return folder.LibraryOptions, nil
+}
+
+// PatchLibraryOptions performs a read-modify-write of a library's settings, setting
+// only the given fields and leaving every other setting untouched. It returns the
+// settings as written. If no library has the ID the returned error satisfies IsNotFound.
+func (c *Client) PatchLibraryOptions(ctx context.Context, libraryID string, fields map[string]interface{}) (ServerConfiguration, error) {
+	folders, err := c.GetVirtualFolders(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	folder, err := findVirtualFolderByID(folders, libraryID)
+	if err != nil {
+		return nil, err
+	}
+
+	options := folder.LibraryOptions
+	if options == nil {
+		options = ServerConfiguration{}
+	}
+
+	for name, value := range fields {
+		if err := options.set(strings.Split(name, "."), value); err != nil {
+			return nil, fmt.Errorf("failed to set library option %s: %w", name, err)
+		}
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"Id":             folder.ItemId,
+		"LibraryOptions": options,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal library options: %w", err)
+	}
+
+	resp, err := c.doRequestWithBody(ctx, http.MethodPost, "/Library/VirtualFolders/LibraryOptions", body)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	return options, nil
+}
+
+// findVirtualFolderByID returns the library with the given item ID, comparing IDs in
+// normalized form. If there is none the returned error satisfies IsNotFound.
+func findVirtualFolderByID(folders []VirtualFolder, itemID string) (*VirtualFolder, error) {
+	for i := range folders {
+		if NormalizeGuid(folders[i].ItemId) == NormalizeGuid(itemID) {
+			return &folders[i], nil
+		}
+	}
+
+	return nil, &APIError{StatusCode: http.StatusNotFound, Body: fmt.Sprintf("library %s not found", itemID)}
+}
+
+// GetItemRefreshStatus retrieves the refresh state of an item. The RefreshStatus and
+// RefreshProgress fields are read from /Items/{id} when the server includes them;
+// otherwise, as on stock Jellyfin, they come from the matching library in
+// /Library/VirtualFolders. If the item doesn't exist the returned error satisfies IsNotFound.
+func (c *Client) GetItemRefreshStatus(ctx context.Context, itemID string) (*RefreshStatus, error) {
+	raw, err := c.GetItemRaw(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	var item struct {
+		RefreshStatus   string   `json:"RefreshStatus"`
+		RefreshProgress *float64 `json:"RefreshProgress"`
+	}
+	if err := json.Unmarshal([]byte(raw), &item); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if item.RefreshStatus != "" || item.RefreshProgress != nil {
+		return &RefreshStatus{Status: item.RefreshStatus, Progress: item.RefreshProgress}, nil
+	}
+
+	folders, err := c.GetVirtualFolders(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, folder := range folders {
+		if folder.ItemId == itemID {
+			return &RefreshStatus{Status: folder.RefreshStatus, Progress: folder.RefreshProgress}, nil
+		}
+	}
+
+	// The item exists but isn't a library and reports no refresh state.
+	return &RefreshStatus{}, nil
+}
+
+// GetLibrarySize sums the media source sizes of the items in a library. At most
+// maxItems items are inspected; truncated reports whether the library holds more.
+func (c *Client) GetLibrarySize(ctx context.Context, libraryID string, maxItems int) (size int64, truncated bool, err error) {
+	const pageSize = 200
+
+	for start := 0; start < maxItems; start += pageSize {
+		limit := pageSize
+		if remaining := maxItems - start; remaining < limit {
+			limit = remaining
+		}
+
+		result, err := c.getItems(ctx, itemsQuery{
+			ParentID:  libraryID,
+			Recursive: true,
+			IsFolder:  boolPtr(false),
+			Fields:    []string{"MediaSources"},
+			Start:     start,
+			Limit:     limit,
+		})
+		if err != nil {
+			return 0, false, err
+		}
+
+		for _, item := range result.Items {
+			for _, source := range item.MediaSources {
+				size += source.Size
+			}
+		}
+
+		if start+len(result.Items) >= result.TotalRecordCount || len(result.Items) == 0 {
+			return size, false, nil
+		}
+	}
+
+	return size, true, nil
+}
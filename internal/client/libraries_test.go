@@ -0,0 +1,468 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+const testVirtualFoldersPayload = `[
+  {
+    "Name": "Movies",
+    "Locations": ["/media/movies"],
+    "CollectionType": "movies",
+    "LibraryOptions": {"EnablePhotos": true},
+    "ItemId": "f137a2dd21bbc1b99aa5c0f6bf02a805",
+    "PrimaryImageItemId": "f137a2dd21bbc1b99aa5c0f6bf02a805",
+    "RefreshStatus": "Idle"
+  },
+  {
+    "Name": "Shows",
+    "Locations": ["/media/tv", "/mnt/tv"],
+    "CollectionType": "tvshows",
+    "ItemId": "a656b907eb3a73532e40e44b968d0225",
+    "RefreshStatus": "Idle"
+  }
+]`
+
+func TestGetVirtualFolders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if r.URL.Path != "/Library/VirtualFolders" {
+			t.Errorf("Expected path /Library/VirtualFolders, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testVirtualFoldersPayload))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	folders, err := client.GetVirtualFolders(context.Background())
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(folders) != 2 {
+		t.Fatalf("Expected 2 folders, got %d", len(folders))
+	}
+
+	if folders[0].ItemId != "f137a2dd21bbc1b99aa5c0f6bf02a805" {
+		t.Errorf("Expected item id 'f137a2dd21bbc1b99aa5c0f6bf02a805', got %s", folders[0].ItemId)
+	}
+
+	if folders[1].CollectionType != "tvshows" {
+		t.Errorf("Expected collection type 'tvshows', got %s", folders[1].CollectionType)
+	}
+
+	if len(folders[1].Locations) != 2 {
+		t.Errorf("Expected 2 locations, got %d", len(folders[1].Locations))
+	}
+}
+
+func TestRefreshLibrary(t *testing.T) {
+	var called bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if r.URL.Path != "/Library/Refresh" {
+			t.Errorf("Expected path /Library/Refresh, got %s", r.URL.Path)
+		}
+
+		called = true
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+
+	if err := client.RefreshLibrary(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if !called {
+		t.Error("Expected the refresh endpoint to be called")
+	}
+}
+
+func TestRefreshLibrary_forbidden(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+
+	if err := client.RefreshLibrary(context.Background()); err == nil {
+		t.Error("Expected an error for a forbidden response")
+	}
+}
+
+func TestFindVirtualFolderByName(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testVirtualFoldersPayload))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+
+	folder, err := client.FindVirtualFolderByName(context.Background(), "Shows")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if folder == nil {
+		t.Fatal("Expected folder to be returned")
+	}
+
+	if folder.ItemId != "a656b907eb3a73532e40e44b968d0225" {
+		t.Errorf("Expected item id 'a656b907eb3a73532e40e44b968d0225', got %s", folder.ItemId)
+	}
+
+	folder, err = client.FindVirtualFolderByName(context.Background(), "Music")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if folder != nil {
+		t.Error("Expected nil folder for unknown name")
+	}
+}
+
+// newLibraryItemsServer serves total items, each with one media source of itemSize bytes.
+func newLibraryItemsServer(t *testing.T, total int, itemSize int64) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/Items" {
+			t.Errorf("Expected path /Items, got %s", r.URL.Path)
+		}
+
+		query := r.URL.Query()
+		if query.Get("parentId") != "lib-1" {
+			t.Errorf("Expected parentId 'lib-1', got %s", query.Get("parentId"))
+		}
+		if query.Get("fields") != "MediaSources" {
+			t.Errorf("Expected fields 'MediaSources', got %s", query.Get("fields"))
+		}
+
+		start, _ := strconv.Atoi(query.Get("startIndex"))
+		limit, _ := strconv.Atoi(query.Get("limit"))
+
+		result := ItemQueryResult{TotalRecordCount: total, StartIndex: start}
+		for i := start; i < total && i < start+limit; i++ {
+			result.Items = append(result.Items, Item{
+				Id:           strconv.Itoa(i),
+				MediaSources: []MediaSource{{Size: itemSize}},
+			})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(result)
+	}))
+}
+
+func TestGetLibrarySize(t *testing.T) {
+	server := newLibraryItemsServer(t, 450, 1000)
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	size, truncated, err := client.GetLibrarySize(context.Background(), "lib-1", 1000)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if size != 450000 {
+		t.Errorf("Expected size 450000, got %d", size)
+	}
+
+	if truncated {
+		t.Error("Expected result not to be truncated")
+	}
+}
+
+func TestGetLibrarySize_truncated(t *testing.T) {
+	server := newLibraryItemsServer(t, 450, 1000)
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	size, truncated, err := client.GetLibrarySize(context.Background(), "lib-1", 300)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if size != 300000 {
+		t.Errorf("Expected size 300000, got %d", size)
+	}
+
+	if !truncated {
+		t.Error("Expected result to be truncated")
+	}
+}
+
+func TestGetLibrarySize_exactLimit(t *testing.T) {
+	server := newLibraryItemsServer(t, 200, 10)
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	size, truncated, err := client.GetLibrarySize(context.Background(), "lib-1", 200)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if size != 2000 {
+		t.Errorf("Expected size 2000, got %d", size)
+	}
+
+	if truncated {
+		t.Error("Expected result not to be truncated when the limit equals the item count")
+	}
+}
+
+func TestGetItemRefreshStatus_fromItem(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/Items/item-1" {
+			t.Errorf("Expected only the item to be requested, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"Id":"item-1","RefreshStatus":"Active","RefreshProgress":42.5}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	status, err := client.GetItemRefreshStatus(context.Background(), "item-1")
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if status.Status != "Active" {
+		t.Errorf("Expected status 'Active', got %s", status.Status)
+	}
+
+	if status.Progress == nil || *status.Progress != 42.5 {
+		t.Errorf("Expected progress 42.5, got %v", status.Progress)
+	}
+}
+
+func TestGetItemRefreshStatus_fromVirtualFolder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/Items/a656b907eb3a73532e40e44b968d0225":
+			_, _ = w.Write([]byte(`{"Id":"a656b907eb3a73532e40e44b968d0225","Type":"CollectionFolder"}`))
+		case "/Library/VirtualFolders":
+			_, _ = w.Write([]byte(testVirtualFoldersPayload))
+		default:
+			t.Errorf("Unexpected request to %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	status, err := client.GetItemRefreshStatus(context.Background(), "a656b907eb3a73532e40e44b968d0225")
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if status.Status != "Idle" {
+		t.Errorf("Expected status 'Idle', got %s", status.Status)
+	}
+
+	if status.Progress != nil {
+		t.Errorf("Expected no progress while idle, got %v", *status.Progress)
+	}
+}
+
+func TestGetItemRefreshStatus_notFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	_, err := client.GetItemRefreshStatus(context.Background(), "missing")
+
+	if !IsNotFound(err) {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+}
+
+func TestDeleteVirtualFolderByID(t *testing.T) {
+	var deleted string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(testVirtualFoldersPayload))
+			return
+		}
+
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE request, got %s", r.Method)
+		}
+		if r.URL.Path != "/Library/VirtualFolders" {
+			t.Errorf("Expected path /Library/VirtualFolders, got %s", r.URL.Path)
+		}
+
+		deleted = r.URL.Query().Get("name")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+
+	// The ID is matched regardless of GUID formatting.
+	if err := client.DeleteVirtualFolderByID(context.Background(), "a656b907-eb3a-7353-2e40-e44b968d0225"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if deleted != "Shows" {
+		t.Errorf("Expected library 'Shows' to be deleted, got %q", deleted)
+	}
+
+	err := client.DeleteVirtualFolderByID(context.Background(), "00000000000000000000000000000000")
+	if !IsNotFound(err) {
+		t.Errorf("Expected not found error for an unknown library, got %v", err)
+	}
+}
+
+func TestDeleteVirtualFolderByID_duplicateName(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected no %s request for an ambiguous library name", r.Method)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[
+  {"Name": "Movies", "ItemId": "f137a2dd21bbc1b99aa5c0f6bf02a805"},
+  {"Name": "movies", "ItemId": "b2c3d4e5f6a1b2c3d4e5f6a1b2c3d4e5"}
+]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	err := client.DeleteVirtualFolderByID(context.Background(), "f137a2dd21bbc1b99aa5c0f6bf02a805")
+
+	if err == nil {
+		t.Fatal("Expected error when another library shares the name, got nil")
+	}
+}
+
+func TestGetLibraryOptions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testVirtualFoldersPayload))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	options, err := client.GetLibraryOptions(context.Background(), "f137a2dd-21bb-c1b9-9aa5-c0f6bf02a805")
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if !options.Bool("EnablePhotos") {
+		t.Error("Expected EnablePhotos to be true")
+	}
+
+	_, err = client.GetLibraryOptions(context.Background(), "00000000000000000000000000000000")
+	if !IsNotFound(err) {
+		t.Errorf("Expected not found error for an unknown library, got %v", err)
+	}
+}
+
+func TestPatchLibraryOptions_preservesUnmanagedFields(t *testing.T) {
+	var posted struct {
+		Id             string
+		LibraryOptions map[string]interface{}
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`[{
+  "Name": "Movies",
+  "ItemId": "f137a2dd21bbc1b99aa5c0f6bf02a805",
+  "LibraryOptions": {"EnablePhotos": true, "EnableRealtimeMonitor": true, "PathInfos": [{"Path": "/media/movies"}]}
+}]`))
+		case http.MethodPost:
+			if r.URL.Path != "/Library/VirtualFolders/LibraryOptions" {
+				t.Errorf("Expected path /Library/VirtualFolders/LibraryOptions, got %s", r.URL.Path)
+			}
+			if err := json.NewDecoder(r.Body).Decode(&posted); err != nil {
+				t.Errorf("Failed to decode posted library options: %v", err)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected %s request", r.Method)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	options, err := client.PatchLibraryOptions(context.Background(), "f137a2dd21bbc1b99aa5c0f6bf02a805", map[string]interface{}{
+		"EnablePhotos": false,
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if options.Bool("EnablePhotos") {
+		t.Error("Expected returned EnablePhotos to be false")
+	}
+
+	if posted.Id != "f137a2dd21bbc1b99aa5c0f6bf02a805" {
+		t.Errorf("Expected posted Id to be the library's item ID, got %q", posted.Id)
+	}
+
+	if posted.LibraryOptions["EnablePhotos"] != false {
+		t.Errorf("Expected posted EnablePhotos false, got %v", posted.LibraryOptions["EnablePhotos"])
+	}
+
+	if posted.LibraryOptions["EnableRealtimeMonitor"] != true {
+		t.Errorf("Expected EnableRealtimeMonitor to be preserved, got %v", posted.LibraryOptions["EnableRealtimeMonitor"])
+	}
+
+	if _, ok := posted.LibraryOptions["PathInfos"]; !ok {
+		t.Error("Expected PathInfos to be preserved")
+	}
+}
+
+func TestPatchLibraryOptions_notFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected no %s request for an unknown library", r.Method)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testVirtualFoldersPayload))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	_, err := client.PatchLibraryOptions(context.Background(), "00000000000000000000000000000000", map[string]interface{}{
+		"EnablePhotos": false,
+	})
+
+	if !IsNotFound(err) {
+		t.Errorf("Expected not found error for an unknown library, got %v", err)
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// DefaultMaxResponseBytes caps the size of a response body read from the server.
+const DefaultMaxResponseBytes = 64 << 20
+
+// ErrResponseTooLarge is returned when reading a response body larger than the
+// configured maximum.
+var ErrResponseTooLarge = errors.New("response body too large")
+
+// limitedBody wraps a response body, failing reads once more than limit bytes are read
+// so an oversized response can't exhaust memory while being decoded.
+type limitedBody struct {
+	body      io.ReadCloser
+	limit     int64
+	remaining int64
+}
+
+func newLimitedBody(body io.ReadCloser, limit int64) io.ReadCloser {
+	return &limitedBody{body: body, limit: limit, remaining: limit}
+}
+
+func (l *limitedBody) Read(p []byte) (int, error) {
+	// Read one byte past the limit so a body of exactly limit bytes still succeeds.
+	if int64(len(p)) > l.remaining+1 {
+		p = p[:l.remaining+1]
+	}
+
+	n, err := l.body.Read(p)
+	if int64(n) > l.remaining {
+		n = int(l.remaining)
+		l.remaining = 0
+		return n, fmt.Errorf("%w: exceeds the maximum of %d bytes", ErrResponseTooLarge, l.limit)
+	}
+
+	l.remaining -= int64(n)
+	return n, err
+}
+
+func (l *limitedBody) Close() error {
+	return l.body.Close()
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestNewClient_maxResponseBytes(t *testing.T) {
+	if client := NewClient("http://localhost:8096", "token"); client.maxResponseBytes != DefaultMaxResponseBytes {
+		t.Errorf("Expected maxResponseBytes %d, got %d", DefaultMaxResponseBytes, client.maxResponseBytes)
+	}
+
+	if client := newClient("http://localhost:8096", "token", &ClientConfig{MaxResponseBytes: -1}); client.maxResponseBytes != 0 {
+		t.Errorf("Expected negative MaxResponseBytes to remove the limit, got %d", client.maxResponseBytes)
+	}
+}
+
+func TestLimitedBody(t *testing.T) {
+	tests := map[string]struct {
+		body    string
+		limit   int64
+		tooLong bool
+	}{
+		"under limit":    {body: "hello", limit: 10},
+		"exactly limit":  {body: "hello", limit: 5},
+		"over limit":     {body: "hello world", limit: 5, tooLong: true},
+		"one over limit": {body: "hello!", limit: 5, tooLong: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			body := newLimitedBody(io.NopCloser(strings.NewReader(tt.body)), tt.limit)
+			data, err := io.ReadAll(body)
+
+			if tt.tooLong {
+				if !errors.Is(err, ErrResponseTooLarge) {
+					t.Errorf("Expected ErrResponseTooLarge, got %v", err)
+				}
+				if int64(len(data)) > tt.limit {
+					t.Errorf("Expected at most %d bytes read, got %d", tt.limit, len(data))
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if string(data) != tt.body {
+				t.Errorf("Expected body %q, got %q", tt.body, data)
+			}
+		})
+	}
+}
+
+func TestDoRequest_responseTooLarge(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"Items":[` + strings.Repeat(`{"AppName":"app"},`, 100) + `{}]}`))
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL, "token", &ClientConfig{MaxResponseBytes: 256})
+
+	_, err := client.GetKeys(context.Background())
+	if !errors.Is(err, ErrResponseTooLarge) {
+		t.Errorf("Expected ErrResponseTooLarge, got %v", err)
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestKeyedMutex(t *testing.T) {
+	var m keyedMutex
+
+	unlock, err := m.lock(context.Background(), "a")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// A different key is not blocked.
+	unlockB, err := m.lock(context.Background(), "b")
+	if err != nil {
+		t.Fatalf("Expected no error locking another key, got %v", err)
+	}
+	unlockB()
+
+	// The same key waits until released, or the context ends.
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if _, err := m.lock(ctx, "a"); err != context.DeadlineExceeded {
+		t.Errorf("Expected the lock to wait for the context, got %v", err)
+	}
+
+	unlock()
+
+	unlock, err = m.lock(context.Background(), "a")
+	if err != nil {
+		t.Fatalf("Expected no error after the key was released, got %v", err)
+	}
+	unlock()
+}
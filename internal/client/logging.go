@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// sensitiveQueryParams are query parameters, compared case-insensitively, whose values
+// are credentials.
+var sensitiveQueryParams = []string{"api_key", "apikey", "secret", "token"}
+
+// logContext returns ctx set up to mask the credentials of a request to path in log
+// entries: the Authorization header, the access token and the values of sensitive
+// query parameters, which also appear in URLs quoted by transport errors.
+func (c *Client) logContext(ctx context.Context, path string) context.Context {
+	secrets := sensitiveQueryValues(path)
+	if token := c.token(); token != "" {
+		secrets = append(secrets, token)
+	}
+
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "Authorization")
+	ctx = tflog.MaskAllFieldValuesStrings(ctx, secrets...)
+	return tflog.MaskMessageStrings(ctx, secrets...)
+}
+
+// sensitiveQueryValues returns the non-empty values of sensitive query parameters in
+// path, both as written and decoded, since either form can end up in a log entry.
+func sensitiveQueryValues(path string) []string {
+	_, rawQuery, ok := strings.Cut(path, "?")
+	if !ok {
+		return nil
+	}
+
+	var values []string
+	for _, pair := range strings.Split(rawQuery, "&") {
+		name, raw, _ := strings.Cut(pair, "=")
+		if raw == "" {
+			continue
+		}
+
+		if decoded, err := url.QueryUnescape(name); err == nil {
+			name = decoded
+		}
+		if !isSensitiveQueryParam(name) {
+			continue
+		}
+
+		values = append(values, raw)
+		if decoded, err := url.QueryUnescape(raw); err == nil && decoded != raw {
+			values = append(values, decoded)
+		}
+	}
+
+	return values
+}
+
+// isSensitiveQueryParam reports whether a query parameter holds a credential.
+func isSensitiveQueryParam(name string) bool {
+	for _, sensitive := range sensitiveQueryParams {
+		if strings.EqualFold(name, sensitive) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestDoRequest_logging(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"AccessToken":"token-in-response-body"}`))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	client := NewClient(server.URL, "secret-access-token")
+	resp, err := client.doRequest(ctx, http.MethodGet, "/Auth/Keys?api_key=secret-query-key&name=ci")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	resp.Body.Close()
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("Failed to decode log output: %v", err)
+	}
+
+	if len(entries) != 1 {
+		t.Fatalf("Expected 1 log entry, got %d: %s", len(entries), output.String())
+	}
+
+	entry := entries[0]
+	if entry["@level"] != "debug" {
+		t.Errorf("Expected debug level, got %v", entry["@level"])
+	}
+	if entry["method"] != "GET" {
+		t.Errorf("Expected method GET, got %v", entry["method"])
+	}
+	if entry["path"] != "/Auth/Keys?api_key=***&name=ci" {
+		t.Errorf("Expected path with the API key masked, got %v", entry["path"])
+	}
+	if entry["status_code"] != float64(http.StatusOK) {
+		t.Errorf("Expected status_code 200, got %v", entry["status_code"])
+	}
+	if _, ok := entry["duration_ms"]; !ok {
+		t.Error("Expected duration_ms field")
+	}
+
+	logged := output.String()
+	for _, secret := range []string{"secret-access-token", "secret-query-key", "token-in-response-body", "MediaBrowser"} {
+		if strings.Contains(logged, secret) {
+			t.Errorf("Expected %q not to be logged, got %s", secret, logged)
+		}
+	}
+}
+
+func TestDoRequest_loggingTransportError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	endpoint := server.URL
+	server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	client := NewClient(endpoint, "secret-access-token")
+	client.retryMax = 0
+
+	if _, err := client.doRequest(ctx, http.MethodGet, "/QuickConnect/Connect?secret=quick+connect/secret"); err == nil {
+		t.Fatal("Expected error for a closed server")
+	}
+
+	logged := output.String()
+	if !strings.Contains(logged, "Jellyfin API request failed") {
+		t.Errorf("Expected the failure to be logged, got %s", logged)
+	}
+
+	for _, secret := range []string{"secret-access-token", "quick+connect/secret", "quick connect/secret", "quick+connect%2Fsecret", "quick%20connect%2Fsecret"} {
+		if strings.Contains(logged, secret) {
+			t.Errorf("Expected %q not to be logged, got %s", secret, logged)
+		}
+	}
+}
+
+func TestSensitiveQueryValues(t *testing.T) {
+	got := sensitiveQueryValues("/Items?ApiKey=a%2Fb&Secret=s&token=&parentId=lib-1")
+	sort.Strings(got)
+
+	expected := []string{"a%2Fb", "a/b", "s"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+
+	if got := sensitiveQueryValues("/Items/item-1"); got != nil {
+		t.Errorf("Expected no values for a path without a query, got %v", got)
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Package represents a plugin package offered by the server's configured repositories.
+type Package struct {
+	Name        string           `json:"name"`
+	Guid        string           `json:"guid"`
+	Description string           `json:"description"`
+	Category    string           `json:"category"`
+	Versions    []PackageVersion `json:"versions"`
+}
+
+// PackageVersion represents a single published version of a package.
+type PackageVersion struct {
+	Version        string `json:"version"`
+	TargetAbi      string `json:"targetAbi"`
+	RepositoryName string `json:"repositoryName"`
+}
+
+// GetPackages retrieves the packages available from the configured plugin repositories.
+func (c *Client) GetPackages(ctx context.Context) ([]Package, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/Packages")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var packages []Package
+	if err := json.NewDecoder(resp.Body).Decode(&packages); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return packages, nil
+}
+
+// LatestVersion returns the highest version published for the package, or "" if it has none.
+func (p Package) LatestVersion() string {
+	latest := ""
+	for _, v := range p.Versions {
+		if latest == "" || CompareVersions(v.Version, latest) > 0 {
+			latest = v.Version
+		}
+	}
+	return latest
+}
+
+// CompareVersions compares two dotted version strings such as "10.9.1.0" numerically,
+// returning -1, 0 or 1. Missing components count as zero and non-numeric components
+// are compared as strings.
+func CompareVersions(a, b string) int {
+	as := strings.Split(a, ".")
+	bs := strings.Split(b, ".")
+
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var x, y string
+		if i < len(as) {
+			x = as[i]
+		}
+		if i < len(bs) {
+			y = bs[i]
+		}
+
+		xn, xerr := strconv.Atoi(defaultString(x, "0"))
+		yn, yerr := strconv.Atoi(defaultString(y, "0"))
+
+		if xerr == nil && yerr == nil {
+			if xn != yn {
+				if xn < yn {
+					return -1
+				}
+				return 1
+			}
+			continue
+		}
+
+		if c := strings.Compare(x, y); c != 0 {
+			return c
+		}
+	}
+
+	return 0
+}
+
+// NormalizeGuid returns a GUID in a form comparable across endpoints, which differ in
+// whether they include dashes.
+func NormalizeGuid(guid string) string {
+	return strings.ToLower(strings.ReplaceAll(guid, "-", ""))
+}
+
+func defaultString(s, fallback string) string {
+	if s == "" {
+		return fallback
+	}
+	return s
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetPackages(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/Packages" {
+			t.Errorf("Expected path /Packages, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"name":"Webhook","guid":"71552a5a-5c5c-4350-a2ae-ebe451a30173","versions":[{"version":"14.0.0.0"},{"version":"15.0.0.0"}]}]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	packages, err := client.GetPackages(context.Background())
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(packages) != 1 {
+		t.Fatalf("Expected 1 package, got %d", len(packages))
+	}
+
+	if packages[0].Name != "Webhook" {
+		t.Errorf("Expected name 'Webhook', got %s", packages[0].Name)
+	}
+
+	if packages[0].LatestVersion() != "15.0.0.0" {
+		t.Errorf("Expected latest version '15.0.0.0', got %s", packages[0].LatestVersion())
+	}
+}
+
+func TestGetPackages_error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	if _, err := client.GetPackages(context.Background()); err == nil {
+		t.Error("Expected error for server error response")
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"1.0.0.0", "1.0.0.0", 0},
+		{"10.0.0.0", "9.0.0.0", 1},
+		{"1.2", "1.2.0.0", 0},
+		{"1.2.0.1", "1.2", 1},
+		{"2.0.0.0", "10.0.0.0", -1},
+	}
+
+	for _, tt := range tests {
+		if got := CompareVersions(tt.a, tt.b); got != tt.expected {
+			t.Errorf("CompareVersions(%q, %q): expected %d, got %d", tt.a, tt.b, tt.expected, got)
+		}
+	}
+}
+
+func TestNormalizeGuid(t *testing.T) {
+	if NormalizeGuid("71552A5A-5C5C-4350-A2AE-EBE451A30173") != NormalizeGuid("71552a5a5c5c4350a2aeebe451a30173") {
+		t.Error("Expected dashed and undashed GUIDs to normalize equally")
+	}
+}
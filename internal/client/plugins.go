@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Plugin represents a plugin installed on the Jellyfin server.
+type Plugin struct {
+	Id           string `json:"Id"`
+	Name         string `json:"Name"`
+	Version      string `json:"Version"`
+	Description  string `json:"Description"`
+	Status       string `json:"Status"`
+	CanUninstall bool   `json:"CanUninstall"`
+}
+
+// GetPlugins retrieves all installed plugins.
+func (c *Client) GetPlugins(ctx context.Context) ([]Plugin, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/Plugins")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var plugins []Plugin
+	if err := json.NewDecoder(resp.Body).Decode(&plugins); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return plugins, nil
+}
+
+// FindPluginByName finds an installed plugin by its name.
+func (c *Client) FindPluginByName(ctx context.Context, name string) (*Plugin, error) {
+	plugins, err := c.GetPlugins(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, plugin := range plugins {
+		if plugin.Name == name {
+			return &plugin, nil
+		}
+	}
+
+	return nil, nil // Not found
+}
+
+// FindPluginByID finds an installed plugin by its GUID, with or without dashes. When
+// several versions are installed it returns the newest one that isn't being removed
+// or replaced. It returns nil if the plugin isn't installed.
+func (c *Client) FindPluginByID(ctx context.Context, pluginID string) (*Plugin, error) {
+	plugins, err := c.GetPlugins(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *Plugin
+	for i := range plugins {
+		plugin := &plugins[i]
+
+		if NormalizeGuid(plugin.Id) != NormalizeGuid(pluginID) || plugin.Status == "Deleted" || plugin.Status == "Superceded" {
+			continue
+		}
+
+		if found == nil || CompareVersions(plugin.Version, found.Version) > 0 {
+			found = plugin
+		}
+	}
+
+	return found, nil
+}
+
+// SetPluginEnabled enables or disables an installed plugin version. The change takes
+// effect after the server restarts.
+func (c *Client) SetPluginEnabled(ctx context.Context, pluginID, version string, enabled bool) error {
+	action := "Disable"
+	if enabled {
+		action = "Enable"
+	}
+
+	path := fmt.Sprintf("/Plugins/%s/%s/%s", url.PathEscape(pluginID), url.PathEscape(version), action)
+
+	resp, err := c.doRequest(ctx, http.MethodPost, path)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return newAPIError(resp)
+	}
+
+	return nil
+}
+
+// InstallPackage asks the server to install a package from its configured repositories.
+// An empty version installs the latest version compatible with the server. Jellyfin
+// installs in the background, and the plugin only loads after a server restart.
+func (c *Client) InstallPackage(ctx context.Context, name, version string) error {
+	path := "/Packages/Installed/" + url.PathEscape(name)
+
+	if version != "" {
+		params := url.Values{}
+		params.Set("version", version)
+		path += "?" + params.Encode()
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodPost, path)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return newAPIError(resp)
+	}
+
+	return nil
+}
+
+// WaitForPlugin polls the installed plugins every interval until one with the given
+// name (case-insensitive), and version if not empty, appears. It returns
+// ErrPollTimeout if none appears within timeout.
+func (c *Client) WaitForPlugin(ctx context.Context, name, version string, interval, timeout time.Duration) (*Plugin, error) {
+	var found *Plugin
+
+	err := PollUntil(ctx, interval, timeout, func(ctx context.Context) (bool, error) {
+		plugins, err := c.GetPlugins(ctx)
+		if err != nil {
+			return false, err
+		}
+
+		for i := range plugins {
+			if strings.EqualFold(plugins[i].Name, name) && (version == "" || CompareVersions(plugins[i].Version, version) == 0) {
+				found = &plugins[i]
+				return true, nil
+			}
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return found, nil
+}
+
+// UninstallPlugin removes an installed plugin. The server finishes removing it on the
+// next restart. If the plugin doesn't exist the returned error satisfies IsNotFound.
+func (c *Client) UninstallPlugin(ctx context.Context, pluginID string) error {
+	resp, err := c.doRequest(ctx, http.MethodDelete, "/Plugins/"+url.PathEscape(pluginID))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return newAPIError(resp)
+	}
+
+	return nil
+}
+
+// GetPluginConfiguration retrieves a plugin's configuration as raw JSON.
+func (c *Client) GetPluginConfiguration(ctx context.Context, pluginID string) (json.RawMessage, error) {
+	path := fmt.Sprintf("/Plugins/%s/Configuration", url.PathEscape(pluginID))
+
+	resp, err := c.doRequest(ctx, http.MethodGet, path)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil // Not found
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if !json.Valid(body) {
+		return nil, fmt.Errorf("failed to decode response: invalid JSON")
+	}
+
+	return json.RawMessage(body), nil
+}
+
+// PatchPluginConfiguration performs a read-modify-write of a plugin's configuration.
+// patch receives the current configuration, or nil if the plugin has none, and returns
+// the configuration to write, or nil to leave it unchanged. Concurrent patches of the
+// same plugin's configuration run one at a time. It returns the configuration as
+// written, or nil if nothing was written.
+func (c *Client) PatchPluginConfiguration(ctx context.Context, pluginID string, patch func(json.RawMessage) (json.RawMessage, error)) (json.RawMessage, error) {
+	unlock, err := c.patches.lock(ctx, "plugin-configuration:"+pluginID)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	config, err := c.GetPluginConfiguration(ctx, pluginID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := patch(config)
+	if err != nil || updated == nil {
+		return nil, err
+	}
+
+	if err := c.UpdatePluginConfiguration(ctx, pluginID, updated); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// UpdatePluginConfiguration replaces a plugin's configuration with the given JSON.
+func (c *Client) UpdatePluginConfiguration(ctx context.Context, pluginID string, config json.RawMessage) error {
+	path := fmt.Sprintf("/Plugins/%s/Configuration", url.PathEscape(pluginID))
+
+	resp, err := c.doRequestWithBody(ctx, http.MethodPost, path, config)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return newAPIError(resp)
+	}
+
+	return nil
+}
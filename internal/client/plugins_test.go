@@ -0,0 +1,404 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const testPluginsPayload = `[
+  {
+    "Name": "Webhook",
+    "Version": "15.0.0.0",
+    "ConfigurationFileName": "Jellyfin.Plugin.Webhook.xml",
+    "Description": "Sends notifications to various services.",
+    "Id": "71552a5a5c5c4350a2aeebe451a30173",
+    "CanUninstall": true,
+    "HasImage": true,
+    "Status": "Active"
+  },
+  {
+    "Name": "TMDb",
+    "Version": "10.9.0.0",
+    "Id": "b8715ed16c4745289ad3f72deb539cd4",
+    "CanUninstall": false,
+    "Status": "Active"
+  }
+]`
+
+func TestGetPlugins(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if r.URL.Path != "/Plugins" {
+			t.Errorf("Expected path /Plugins, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testPluginsPayload))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	plugins, err := client.GetPlugins(context.Background())
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(plugins) != 2 {
+		t.Fatalf("Expected 2 plugins, got %d", len(plugins))
+	}
+
+	if plugins[0].Id != "71552a5a5c5c4350a2aeebe451a30173" {
+		t.Errorf("Expected id '71552a5a5c5c4350a2aeebe451a30173', got %s", plugins[0].Id)
+	}
+
+	if !plugins[0].CanUninstall {
+		t.Error("Expected CanUninstall to be true")
+	}
+
+	if plugins[1].Version != "10.9.0.0" {
+		t.Errorf("Expected version '10.9.0.0', got %s", plugins[1].Version)
+	}
+}
+
+func TestFindPluginByName(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testPluginsPayload))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+
+	plugin, err := client.FindPluginByName(context.Background(), "TMDb")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if plugin == nil || plugin.Id != "b8715ed16c4745289ad3f72deb539cd4" {
+		t.Errorf("Expected TMDb plugin to be found, got %+v", plugin)
+	}
+
+	plugin, err = client.FindPluginByName(context.Background(), "LDAP-Auth")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if plugin != nil {
+		t.Error("Expected nil plugin for unknown name")
+	}
+}
+
+func TestGetPluginConfiguration(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/Plugins/plugin-1/Configuration" {
+			t.Errorf("Expected path /Plugins/plugin-1/Configuration, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ServerUrl":"http://jellyfin","DiscordOptions":[]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	config, err := client.GetPluginConfiguration(context.Background(), "plugin-1")
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if string(config) != `{"ServerUrl":"http://jellyfin","DiscordOptions":[]}` {
+		t.Errorf("Expected configuration to be returned verbatim, got %s", string(config))
+	}
+}
+
+func TestGetPluginConfiguration_notFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	config, err := client.GetPluginConfiguration(context.Background(), "missing")
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config != nil {
+		t.Error("Expected nil configuration for missing plugin")
+	}
+}
+
+func TestUpdatePluginConfiguration(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if r.URL.Path != "/Plugins/plugin-1/Configuration" {
+			t.Errorf("Expected path /Plugins/plugin-1/Configuration, got %s", r.URL.Path)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"Enabled":true}` {
+			t.Errorf("Expected body to be sent verbatim, got %s", string(body))
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	if err := client.UpdatePluginConfiguration(context.Background(), "plugin-1", []byte(`{"Enabled":true}`)); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestPatchPluginConfiguration(t *testing.T) {
+	var posts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"Enabled":false}`))
+		case http.MethodPost:
+			posts.Add(1)
+			body, _ := io.ReadAll(r.Body)
+			if string(body) != `{"Enabled":true}` {
+				t.Errorf("Expected the patched configuration to be posted, got %s", string(body))
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+
+	config, err := client.PatchPluginConfiguration(context.Background(), "plugin-1", func(current json.RawMessage) (json.RawMessage, error) {
+		if string(current) != `{"Enabled":false}` {
+			t.Errorf("Expected the current configuration, got %s", string(current))
+		}
+		return json.RawMessage(`{"Enabled":true}`), nil
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if string(config) != `{"Enabled":true}` {
+		t.Errorf("Expected the written configuration to be returned, got %s", string(config))
+	}
+
+	// A nil result leaves the configuration unchanged.
+	config, err = client.PatchPluginConfiguration(context.Background(), "plugin-1", func(json.RawMessage) (json.RawMessage, error) {
+		return nil, nil
+	})
+	if err != nil || config != nil {
+		t.Errorf("Expected nothing to be written, got %s and %v", string(config), err)
+	}
+
+	if posts.Load() != 1 {
+		t.Errorf("Expected 1 write, got %d", posts.Load())
+	}
+}
+
+func TestInstallPackage(t *testing.T) {
+	testCases := []struct {
+		name          string
+		pkg           string
+		version       string
+		expectedPath  string
+		expectedQuery string
+	}{
+		{
+			name:          "specific version",
+			pkg:           "Webhook",
+			version:       "15.0.0.0",
+			expectedPath:  "/Packages/Installed/Webhook",
+			expectedQuery: "version=15.0.0.0",
+		},
+		{
+			name:         "latest version",
+			pkg:          "Open Subtitles",
+			expectedPath: "/Packages/Installed/Open%20Subtitles",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost {
+					t.Errorf("Expected POST, got %s", r.Method)
+				}
+
+				if r.URL.EscapedPath() != tc.expectedPath {
+					t.Errorf("Expected path %s, got %s", tc.expectedPath, r.URL.EscapedPath())
+				}
+
+				if r.URL.RawQuery != tc.expectedQuery {
+					t.Errorf("Expected query %q, got %q", tc.expectedQuery, r.URL.RawQuery)
+				}
+
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer server.Close()
+
+			client := NewClient(server.URL, "test-api-key")
+
+			if err := client.InstallPackage(context.Background(), tc.pkg, tc.version); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestWaitForPlugin(t *testing.T) {
+	var polls int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		// The plugin only shows up once the background install finishes.
+		if atomic.AddInt32(&polls, 1) < 3 {
+			_, _ = w.Write([]byte(`[]`))
+			return
+		}
+
+		_, _ = w.Write([]byte(testPluginsPayload))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	plugin, err := client.WaitForPlugin(context.Background(), "Webhook", "15.0.0.0", time.Millisecond, time.Second)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if plugin.Id != "71552a5a5c5c4350a2aeebe451a30173" {
+		t.Errorf("Expected Webhook plugin, got %+v", plugin)
+	}
+
+	if polls != 3 {
+		t.Errorf("Expected 3 polls, got %d", polls)
+	}
+}
+
+func TestWaitForPlugin_timeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testPluginsPayload))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+
+	// Webhook is installed, but not at the requested version.
+	_, err := client.WaitForPlugin(context.Background(), "Webhook", "16.0.0.0", time.Millisecond, 10*time.Millisecond)
+
+	if !errors.Is(err, ErrPollTimeout) {
+		t.Errorf("Expected ErrPollTimeout, got %v", err)
+	}
+}
+
+func TestUninstallPlugin(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE, got %s", r.Method)
+		}
+
+		if r.URL.Path == "/Plugins/71552a5a5c5c4350a2aeebe451a30173" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+
+	if err := client.UninstallPlugin(context.Background(), "71552a5a5c5c4350a2aeebe451a30173"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// A plugin that was already removed reports not found.
+	if err := client.UninstallPlugin(context.Background(), "00000000000000000000000000000000"); !IsNotFound(err) {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+}
+
+func TestFindPluginByID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[
+			{"Id":"71552a5a5c5c4350a2aeebe451a30173","Name":"Webhook","Version":"14.0.0.0","Status":"Superceded"},
+			{"Id":"71552a5a5c5c4350a2aeebe451a30173","Name":"Webhook","Version":"15.0.0.0","Status":"Disabled"},
+			{"Id":"b8715ed16c4745289ad3f72deb539cd4","Name":"TMDb","Version":"10.9.0.0","Status":"Deleted"}
+		]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+
+	plugin, err := client.FindPluginByID(context.Background(), "71552A5A-5C5C-4350-A2AE-EBE451A30173")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if plugin == nil || plugin.Version != "15.0.0.0" {
+		t.Errorf("Expected Webhook 15.0.0.0, got %+v", plugin)
+	}
+
+	plugin, err = client.FindPluginByID(context.Background(), "b8715ed16c4745289ad3f72deb539cd4")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if plugin != nil {
+		t.Errorf("Expected a plugin being removed not to be found, got %+v", plugin)
+	}
+}
+
+func TestSetPluginEnabled(t *testing.T) {
+	testCases := []struct {
+		enabled      bool
+		expectedPath string
+	}{
+		{enabled: true, expectedPath: "/Plugins/71552a5a5c5c4350a2aeebe451a30173/15.0.0.0/Enable"},
+		{enabled: false, expectedPath: "/Plugins/71552a5a5c5c4350a2aeebe451a30173/15.0.0.0/Disable"},
+	}
+
+	for _, tc := range testCases {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost {
+				t.Errorf("Expected POST, got %s", r.Method)
+			}
+
+			if r.URL.Path != tc.expectedPath {
+				t.Errorf("Expected path %s, got %s", tc.expectedPath, r.URL.Path)
+			}
+
+			w.WriteHeader(http.StatusNoContent)
+		}))
+
+		client := NewClient(server.URL, "test-api-key")
+
+		if err := client.SetPluginEnabled(context.Background(), "71552a5a5c5c4350a2aeebe451a30173", "15.0.0.0", tc.enabled); err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		server.Close()
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrPollTimeout is returned by PollUntil when the timeout elapses before the
+// condition is met.
+var ErrPollTimeout = errors.New("timed out waiting for condition")
+
+// PollUntil calls cond every interval until it reports done, returns an error, or
+// timeout elapses. The condition is checked once more when the timeout is reached
+// before ErrPollTimeout is returned. If ctx ends first, its error is returned.
+func PollUntil(ctx context.Context, interval, timeout time.Duration, cond func(context.Context) (bool, error)) error {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		done, err := cond(ctx)
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return ErrPollTimeout
+		}
+
+		wait := interval
+		if remaining < wait {
+			wait = remaining
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
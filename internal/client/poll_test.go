@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestPollUntil_done(t *testing.T) {
+	calls := 0
+	err := PollUntil(context.Background(), time.Millisecond, time.Second, func(ctx context.Context) (bool, error) {
+		calls++
+		return calls == 3, nil
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if calls != 3 {
+		t.Errorf("Expected 3 calls, got %d", calls)
+	}
+}
+
+func TestPollUntil_timeout(t *testing.T) {
+	calls := 0
+	err := PollUntil(context.Background(), 5*time.Millisecond, 20*time.Millisecond, func(ctx context.Context) (bool, error) {
+		calls++
+		return false, nil
+	})
+
+	if !errors.Is(err, ErrPollTimeout) {
+		t.Fatalf("Expected ErrPollTimeout, got %v", err)
+	}
+
+	if calls < 2 {
+		t.Errorf("Expected the condition to be checked repeatedly, got %d calls", calls)
+	}
+}
+
+func TestPollUntil_conditionError(t *testing.T) {
+	want := errors.New("boom")
+	err := PollUntil(context.Background(), time.Millisecond, time.Second, func(ctx context.Context) (bool, error) {
+		return false, want
+	})
+
+	if !errors.Is(err, want) {
+		t.Errorf("Expected the condition's error, got %v", err)
+	}
+}
+
+func TestPollUntil_cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := PollUntil(ctx, time.Second, time.Minute, func(ctx context.Context) (bool, error) {
+		return false, nil
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	// DefaultQuickConnectTimeout is how long NewClientWithQuickConnect waits for the
+	// code to be approved when no timeout is configured.
+	DefaultQuickConnectTimeout = 5 * time.Minute
+
+	// DefaultQuickConnectPollInterval is how often the approval state is checked.
+	DefaultQuickConnectPollInterval = 5 * time.Second
+)
+
+// ErrQuickConnectExpired is returned when a Quick Connect code expires or isn't
+// approved in time.
+var ErrQuickConnectExpired = errors.New("quick connect code expired before it was approved")
+
+// QuickConnectResult is the state of a Quick Connect request.
+type QuickConnectResult struct {
+	Authenticated bool   `json:"Authenticated"`
+	Secret        string `json:"Secret"`
+	Code          string `json:"Code"`
+}
+
+// NewClientWithQuickConnect creates a new Jellyfin API client by signing in with Quick
+// Connect. It starts a request, reports the code through config.OnQuickConnectCode so a
+// signed-in user can approve it, then waits for approval, checking every
+// config.QuickConnectPollInterval for up to config.QuickConnectTimeout. It returns the
+// client and the code that was approved. The wait ends early if ctx is done. If the
+// code expires or isn't approved in time the returned error wraps ErrQuickConnectExpired.
+func NewClientWithQuickConnect(ctx context.Context, endpoint string, config *ClientConfig) (*Client, string, error) {
+	endpoint, err := NormalizeEndpoint(endpoint)
+	if err != nil {
+		return nil, "", err
+	}
+
+	httpClient, err := newHTTPClient(config)
+	if err != nil {
+		return nil, "", err
+	}
+
+	timeout := DefaultQuickConnectTimeout
+	interval := DefaultQuickConnectPollInterval
+	var onCode func(string)
+
+	if config != nil {
+		if config.QuickConnectTimeout > 0 {
+			timeout = config.QuickConnectTimeout
+		}
+		if config.QuickConnectPollInterval > 0 {
+			interval = config.QuickConnectPollInterval
+		}
+		onCode = config.OnQuickConnectCode
+	}
+
+	initiated, err := quickConnectRequest(ctx, httpClient, http.MethodPost, endpoint+"/QuickConnect/Initiate", config)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to start quick connect: %w", err)
+	}
+
+	if initiated.Secret == "" || initiated.Code == "" {
+		return nil, "", fmt.Errorf("failed to start quick connect: the server returned no code")
+	}
+
+	code := initiated.Code
+
+	if onCode != nil {
+		onCode(code)
+	}
+
+	params := url.Values{}
+	params.Set("secret", initiated.Secret)
+	connectURL := endpoint + "/QuickConnect/Connect?" + params.Encode()
+
+	err = PollUntil(ctx, interval, timeout, func(ctx context.Context) (bool, error) {
+		state, err := quickConnectRequest(ctx, httpClient, http.MethodGet, connectURL, config)
+		if IsNotFound(err) {
+			return false, fmt.Errorf("%w: code %s", ErrQuickConnectExpired, code)
+		}
+		if err != nil {
+			return false, fmt.Errorf("failed to check quick connect state: %w", err)
+		}
+
+		return state.Authenticated, nil
+	})
+
+	if errors.Is(err, ErrPollTimeout) {
+		return nil, code, fmt.Errorf("%w: code %s was not approved within %s", ErrQuickConnectExpired, code, timeout)
+	}
+	if err != nil {
+		return nil, code, err
+	}
+
+	body, err := json.Marshal(map[string]string{"Secret": initiated.Secret})
+	if err != nil {
+		return nil, code, fmt.Errorf("failed to marshal auth request: %w", err)
+	}
+
+	c, err := authenticate(ctx, httpClient, endpoint, "/Users/AuthenticateWithQuickConnect", body, config, AuthMethodQuickConnect)
+	if err != nil {
+		return nil, code, err
+	}
+
+	return c, code, nil
+}
+
+// quickConnectRequest sends an unauthenticated Quick Connect request and decodes the
+// resulting state.
+func quickConnectRequest(ctx context.Context, httpClient *http.Client, method, url string, config *ClientConfig) (*QuickConnectResult, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header = requestHeader(config)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", clientAuthorization(config))
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var result QuickConnectResult
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newQuickConnectServer mocks the Quick Connect endpoints. The request is approved on
+// the approveAfter-th state check, or never if approveAfter is zero.
+func newQuickConnectServer(t *testing.T, approveAfter int32) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var checks atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "MediaBrowser Client=") {
+			t.Errorf("Expected a client Authorization header, got %q", r.Header.Get("Authorization"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/QuickConnect/Initiate":
+			_, _ = w.Write([]byte(`{"Authenticated":false,"Secret":"qc-secret","Code":"123456"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/QuickConnect/Connect":
+			if r.URL.Query().Get("secret") != "qc-secret" {
+				t.Errorf("Expected secret qc-secret, got %q", r.URL.Query().Get("secret"))
+			}
+			n := checks.Add(1)
+			if approveAfter > 0 && n >= approveAfter {
+				_, _ = w.Write([]byte(`{"Authenticated":true,"Secret":"qc-secret","Code":"123456"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"Authenticated":false,"Secret":"qc-secret","Code":"123456"}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/Users/AuthenticateWithQuickConnect":
+			var body map[string]string
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if body["Secret"] != "qc-secret" {
+				t.Errorf("Expected secret qc-secret in body, got %v", body)
+			}
+			_, _ = w.Write([]byte(`{"AccessToken":"qc-token","ServerId":"server-1","User":{"Id":"user-1","Name":"alice"}}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+
+	return server, &checks
+}
+
+func TestNewClientWithQuickConnect(t *testing.T) {
+	server, checks := newQuickConnectServer(t, 2)
+	defer server.Close()
+
+	var shown string
+	config := &ClientConfig{
+		QuickConnectPollInterval: time.Millisecond,
+		OnQuickConnectCode:       func(code string) { shown = code },
+	}
+
+	c, code, err := NewClientWithQuickConnect(context.Background(), server.URL, config)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if code != "123456" || shown != "123456" {
+		t.Errorf("Expected code 123456 to be returned and shown, got %q and %q", code, shown)
+	}
+
+	if checks.Load() != 2 {
+		t.Errorf("Expected 2 state checks, got %d", checks.Load())
+	}
+
+	if c.token() != "qc-token" {
+		t.Errorf("Expected token qc-token, got %q", c.token())
+	}
+
+	info := c.AuthInfo()
+	if info.Method != AuthMethodQuickConnect || info.UserID != "user-1" || info.ServerID != "server-1" {
+		t.Errorf("Expected quick connect auth info for user-1 on server-1, got %+v", info)
+	}
+}
+
+func TestNewClientWithQuickConnect_notApproved(t *testing.T) {
+	server, _ := newQuickConnectServer(t, 0)
+	defer server.Close()
+
+	config := &ClientConfig{
+		QuickConnectPollInterval: time.Millisecond,
+		QuickConnectTimeout:      20 * time.Millisecond,
+	}
+
+	_, code, err := NewClientWithQuickConnect(context.Background(), server.URL, config)
+
+	if !errors.Is(err, ErrQuickConnectExpired) {
+		t.Fatalf("Expected ErrQuickConnectExpired, got %v", err)
+	}
+
+	if code != "123456" {
+		t.Errorf("Expected the unapproved code to be returned, got %q", code)
+	}
+}
+
+func TestNewClientWithQuickConnect_expired(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/QuickConnect/Initiate" {
+			_, _ = w.Write([]byte(`{"Secret":"qc-secret","Code":"123456"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	config := &ClientConfig{QuickConnectPollInterval: time.Millisecond}
+
+	_, _, err := NewClientWithQuickConnect(context.Background(), server.URL, config)
+
+	if !errors.Is(err, ErrQuickConnectExpired) {
+		t.Errorf("Expected ErrQuickConnectExpired, got %v", err)
+	}
+}
+
+func TestNewClientWithQuickConnect_disabled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte("Quick connect is disabled"))
+	}))
+	defer server.Close()
+
+	_, _, err := NewClientWithQuickConnect(context.Background(), server.URL, nil)
+
+	if err == nil || !strings.Contains(err.Error(), "Quick connect is disabled") {
+		t.Errorf("Expected the server's error to be reported, got %v", err)
+	}
+}
+
+func TestNewClientWithQuickConnect_canceled(t *testing.T) {
+	server, _ := newQuickConnectServer(t, 0)
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	config := &ClientConfig{
+		QuickConnectPollInterval: time.Hour,
+		OnQuickConnectCode:       func(string) { cancel() },
+	}
+
+	_, _, err := NewClientWithQuickConnect(ctx, server.URL, config)
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+func TestNewClient_rateLimitDefault(t *testing.T) {
+	client := NewClient("http://localhost:8096", "token")
+
+	if client.limiter.Limit() != rate.Inf {
+		t.Errorf("Expected no rate limit by default, got %v", client.limiter.Limit())
+	}
+}
+
+func TestDoRequest_rateLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL, "token", &ClientConfig{RequestsPerSecond: 1})
+
+	start := time.Now()
+	for i := 0; i < 2; i++ {
+		resp, err := client.doRequest(context.Background(), http.MethodGet, "/System/Ping")
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	// Allow for timer granularity; without the limit both calls take milliseconds.
+	if elapsed := time.Since(start); elapsed < 900*time.Millisecond {
+		t.Errorf("Expected two requests at 1 rps to take about 1s, took %s", elapsed)
+	}
+}
+
+func TestDoRequest_rateLimitUnlimited(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "token")
+
+	start := time.Now()
+	for i := 0; i < 20; i++ {
+		resp, err := client.doRequest(context.Background(), http.MethodGet, "/System/Ping")
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("Expected unlimited requests not to be paced, took %s", elapsed)
+	}
+}
+
+func TestDoRequest_rateLimitCancelled(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL, "token", &ClientConfig{RequestsPerSecond: 0.1})
+
+	resp, err := client.doRequest(context.Background(), http.MethodGet, "/System/Ping")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	resp.Body.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err = client.doRequest(ctx, http.MethodGet, "/System/Ping")
+
+	if err == nil {
+		t.Fatal("Expected an error when the context ends while waiting")
+	}
+
+	// The next request is 10s away, so waiting must give up with the context.
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected the wait to end with the context, took %s", elapsed)
+	}
+
+	if requests != 1 {
+		t.Errorf("Expected the second request not to be sent, got %d requests", requests)
+	}
+}
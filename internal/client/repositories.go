@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Repository represents a plugin repository the server installs packages from.
+type Repository struct {
+	Name    string `json:"Name"`
+	Url     string `json:"Url"`
+	Enabled bool   `json:"Enabled"`
+}
+
+// GetRepositories retrieves the server's plugin repositories.
+func (c *Client) GetRepositories(ctx context.Context) ([]Repository, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/Repositories")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var repositories []Repository
+	if err := json.NewDecoder(resp.Body).Decode(&repositories); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return repositories, nil
+}
+
+// SetRepositories replaces the server's plugin repositories. Jellyfin expects the
+// complete list; repositories left out are removed.
+func (c *Client) SetRepositories(ctx context.Context, repositories []Repository) error {
+	if repositories == nil {
+		repositories = []Repository{}
+	}
+
+	body, err := json.Marshal(repositories)
+	if err != nil {
+		return fmt.Errorf("failed to marshal repositories: %w", err)
+	}
+
+	resp, err := c.doRequestWithBody(ctx, http.MethodPost, "/Repositories", body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return newAPIError(resp)
+	}
+
+	return nil
+}
+
+// PatchRepositories performs a read-modify-write of the server's plugin repositories.
+// patch receives the current list and returns the list to write, and whether it
+// differs; nothing is written when it doesn't. Concurrent patches run one at a time.
+func (c *Client) PatchRepositories(ctx context.Context, patch func([]Repository) ([]Repository, bool)) error {
+	unlock, err := c.patches.lock(ctx, "repositories")
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	repositories, err := c.GetRepositories(ctx)
+	if err != nil {
+		return err
+	}
+
+	updated, changed := patch(repositories)
+	if !changed {
+		return nil
+	}
+
+	return c.SetRepositories(ctx, updated)
+}
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGetRepositories(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/Repositories" {
+			t.Errorf("Expected GET /Repositories, got %s %s", r.Method, r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"Name":"Jellyfin Stable","Url":"https://repo.jellyfin.org/files/plugin/manifest.json","Enabled":true}]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	repositories, err := client.GetRepositories(context.Background())
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(repositories) != 1 {
+		t.Fatalf("Expected 1 repository, got %d", len(repositories))
+	}
+
+	if repositories[0].Name != "Jellyfin Stable" || !repositories[0].Enabled {
+		t.Errorf("Expected enabled Jellyfin Stable repository, got %+v", repositories[0])
+	}
+}
+
+func TestSetRepositories(t *testing.T) {
+	testCases := []struct {
+		name         string
+		repositories []Repository
+		expectedBody string
+	}{
+		{
+			name:         "full list",
+			repositories: []Repository{{Name: "Stable", Url: "https://example.com/manifest.json", Enabled: true}},
+			expectedBody: `[{"Name":"Stable","Url":"https://example.com/manifest.json","Enabled":true}]`,
+		},
+		{
+			name:         "empty list",
+			expectedBody: `[]`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/Repositories" {
+					t.Errorf("Expected POST /Repositories, got %s %s", r.Method, r.URL.Path)
+				}
+
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != tc.expectedBody {
+					t.Errorf("Expected body %s, got %s", tc.expectedBody, body)
+				}
+
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer server.Close()
+
+			client := NewClient(server.URL, "test-api-key")
+
+			if err := client.SetRepositories(context.Background(), tc.repositories); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+// TestPatchRepositories_concurrent adds repositories in parallel, as several
+// jellyfin_plugin_repository resources in one apply do. Run it with -race.
+func TestPatchRepositories_concurrent(t *testing.T) {
+	var mu sync.Mutex
+	stored := []byte(`[{"Name":"Stable","Url":"https://repo.jellyfin.org/manifest.json","Enabled":true}]`)
+	var posts atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			mu.Lock()
+			body := stored
+			mu.Unlock()
+
+			// Hold the read so an unserialized patch would overlap with another.
+			time.Sleep(10 * time.Millisecond)
+
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(body)
+		case http.MethodPost:
+			posts.Add(1)
+			body, _ := io.ReadAll(r.Body)
+
+			mu.Lock()
+			stored = body
+			mu.Unlock()
+
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+
+	const patches = 10
+
+	var wg sync.WaitGroup
+	for i := 0; i < patches; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			err := client.PatchRepositories(context.Background(), func(repositories []Repository) ([]Repository, bool) {
+				return append(repositories, Repository{Name: "Repo " + strconv.Itoa(i), Url: "https://example.com/" + strconv.Itoa(i)}), true
+			})
+			if err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// An unchanged list isn't written.
+	err := client.PatchRepositories(context.Background(), func(repositories []Repository) ([]Repository, bool) {
+		return nil, false
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if n := posts.Load(); n != patches {
+		t.Errorf("Expected %d writes, got %d", patches, n)
+	}
+
+	repositories, err := client.GetRepositories(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(repositories) != patches+1 {
+		t.Errorf("Expected every repository to be kept, got %+v", repositories)
+	}
+}
@@ -0,0 +1,435 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewClient_retryDefaults(t *testing.T) {
+	client := NewClient("http://localhost:8096", "token")
+
+	if client.retryMax != DefaultRetryMax {
+		t.Errorf("Expected retryMax %d, got %d", DefaultRetryMax, client.retryMax)
+	}
+
+	if client.retryWaitMin != DefaultRetryWaitMin {
+		t.Errorf("Expected retryWaitMin %s, got %s", DefaultRetryWaitMin, client.retryWaitMin)
+	}
+
+	if client.retryWaitMax != DefaultRetryWaitMax {
+		t.Errorf("Expected retryWaitMax %s, got %s", DefaultRetryWaitMax, client.retryWaitMax)
+	}
+}
+
+func TestNewClient_retryConfig(t *testing.T) {
+	client := newClient("http://localhost:8096", "token", &ClientConfig{
+		RetryMax:     -1,
+		RetryWaitMin: 5 * time.Second,
+		RetryWaitMax: 2 * time.Second,
+	})
+
+	if client.retryMax != 0 {
+		t.Errorf("Expected negative RetryMax to disable retries, got %d", client.retryMax)
+	}
+
+	if client.retryWaitMin != 5*time.Second {
+		t.Errorf("Expected retryWaitMin 5s, got %s", client.retryWaitMin)
+	}
+
+	// A maximum below the minimum is raised to the minimum.
+	if client.retryWaitMax != 5*time.Second {
+		t.Errorf("Expected retryWaitMax 5s, got %s", client.retryWaitMax)
+	}
+}
+
+func TestBackoff_withinBounds(t *testing.T) {
+	client := newClient("http://localhost:8096", "token", &ClientConfig{
+		RetryWaitMin: 100 * time.Millisecond,
+		RetryWaitMax: 2 * time.Second,
+	})
+
+	for attempt := 0; attempt < 64; attempt++ {
+		for i := 0; i < 20; i++ {
+			wait := client.backoff(attempt)
+			if wait < client.retryWaitMin || wait > client.retryWaitMax {
+				t.Fatalf("Expected backoff for attempt %d within [%s, %s], got %s", attempt, client.retryWaitMin, client.retryWaitMax, wait)
+			}
+		}
+	}
+
+	// Later attempts should reach the cap.
+	if wait := client.backoff(10); wait != client.retryWaitMax {
+		t.Errorf("Expected backoff for attempt 10 to be capped at %s, got %s", client.retryWaitMax, wait)
+	}
+}
+
+func TestDoRequest_retriesTransientStatus(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL, "token", &ClientConfig{
+		RetryWaitMin: time.Millisecond,
+		RetryWaitMax: 5 * time.Millisecond,
+	})
+
+	resp, err := client.doRequest(context.Background(), http.MethodGet, "/System/Info")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected status 200, got %d", resp.StatusCode)
+	}
+
+	if requests != 3 {
+		t.Errorf("Expected 3 requests, got %d", requests)
+	}
+}
+
+func TestDoRequest_givesUpAfterRetryMax(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusBadGateway)
+		_, _ = w.Write([]byte("upstream down"))
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL, "token", &ClientConfig{
+		RetryMax:     2,
+		RetryWaitMin: time.Millisecond,
+		RetryWaitMax: time.Millisecond,
+	})
+
+	_, err := client.doRequest(context.Background(), http.MethodGet, "/System/Info")
+	if err == nil {
+		t.Fatal("Expected error after exhausting retries")
+	}
+
+	if !strings.Contains(err.Error(), "after 3 attempts") {
+		t.Errorf("Expected error to report the attempt count, got %v", err)
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadGateway || apiErr.Body != "upstream down" {
+		t.Errorf("Expected error to wrap the last 502 response, got %v", err)
+	}
+
+	if requests != 3 {
+		t.Errorf("Expected 3 requests, got %d", requests)
+	}
+}
+
+func TestDoRequest_retriesDisabled(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL, "token", &ClientConfig{RetryMax: -1})
+
+	resp, err := client.doRequest(context.Background(), http.MethodGet, "/System/Info")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Expected status 503, got %d", resp.StatusCode)
+	}
+
+	if requests != 1 {
+		t.Errorf("Expected 1 request, got %d", requests)
+	}
+}
+
+func TestDoRequest_deadlineDuringRetries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL, "token", &ClientConfig{
+		RetryMax:     10,
+		RetryWaitMin: 20 * time.Millisecond,
+		RetryWaitMax: 20 * time.Millisecond,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := client.doRequest(ctx, http.MethodGet, "/System/Info")
+	if err == nil {
+		t.Fatal("Expected error when the deadline passes during retries")
+	}
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected errors.Is(err, context.DeadlineExceeded), got %v", err)
+	}
+
+	if !strings.Contains(err.Error(), "cancelled after") {
+		t.Errorf("Expected error to state the request was cancelled, got %v", err)
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Expected error to also wrap the last 503 response, got %v", err)
+	}
+}
+
+func TestDoRequest_cancelledDuringRetries(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cancel()
+		w.WriteHeader(http.StatusGatewayTimeout)
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL, "token", &ClientConfig{
+		RetryWaitMin: time.Second,
+		RetryWaitMax: time.Second,
+	})
+
+	_, err := client.doRequest(ctx, http.MethodGet, "/System/Info")
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected errors.Is(err, context.Canceled), got %v", err)
+	}
+}
+
+func TestDoRequest_noRetryOnServerError(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL, "token", &ClientConfig{
+		RetryWaitMin: time.Millisecond,
+		RetryWaitMax: time.Millisecond,
+	})
+
+	resp, err := client.doRequest(context.Background(), http.MethodGet, "/System/Info")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	defer resp.Body.Close()
+
+	if requests != 1 {
+		t.Errorf("Expected 1 request, got %d", requests)
+	}
+}
+
+func TestDoRequest_noRetryOnGatewayErrorForPost(t *testing.T) {
+	for _, status := range []int{http.StatusBadGateway, http.StatusGatewayTimeout} {
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			w.WriteHeader(status)
+		}))
+
+		client := newClient(server.URL, "token", &ClientConfig{
+			RetryWaitMin: time.Millisecond,
+			RetryWaitMax: time.Millisecond,
+		})
+
+		resp, err := client.doRequestWithBody(context.Background(), http.MethodPost, "/Users/New", []byte(`{"Name":"alice"}`))
+		if err != nil {
+			t.Fatalf("Expected no error for status %d, got %v", status, err)
+		}
+		resp.Body.Close()
+		server.Close()
+
+		if resp.StatusCode != status {
+			t.Errorf("Expected status %d, got %d", status, resp.StatusCode)
+		}
+
+		if requests != 1 {
+			t.Errorf("Expected a POST answered with %d to be sent once, got %d requests", status, requests)
+		}
+	}
+}
+
+func TestDoRequest_retriesUnavailableForPost(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests < 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL, "token", &ClientConfig{
+		RetryWaitMin: time.Millisecond,
+		RetryWaitMax: time.Millisecond,
+	})
+
+	resp, err := client.doRequestWithBody(context.Background(), http.MethodPost, "/Users/New", []byte(`{"Name":"alice"}`))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	defer resp.Body.Close()
+
+	if requests != 2 {
+		t.Errorf("Expected 2 requests, got %d", requests)
+	}
+}
+
+func TestDoRequest_honorsRetryAfterSeconds(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL, "token", &ClientConfig{
+		RetryWaitMin: time.Millisecond,
+		RetryWaitMax: 5 * time.Second,
+	})
+
+	start := time.Now()
+	resp, err := client.doRequest(context.Background(), http.MethodGet, "/System/Info")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected status 200, got %d", resp.StatusCode)
+	}
+
+	if requests != 2 {
+		t.Errorf("Expected 2 requests, got %d", requests)
+	}
+
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("Expected the client to wait the requested 1s before retrying, waited %s", elapsed)
+	}
+}
+
+func TestDoRequest_honorsRetryAfterDate(t *testing.T) {
+	requests := 0
+	var retryAt time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			// HTTP dates have one-second precision, so ask for a retry two seconds out.
+			retryAt = time.Now().Add(2 * time.Second).Truncate(time.Second)
+			w.Header().Set("Retry-After", retryAt.UTC().Format(http.TimeFormat))
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL, "token", &ClientConfig{
+		RetryWaitMin: time.Millisecond,
+		RetryWaitMax: 5 * time.Second,
+	})
+
+	resp, err := client.doRequest(context.Background(), http.MethodGet, "/System/Info")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	defer resp.Body.Close()
+
+	if requests != 2 {
+		t.Errorf("Expected 2 requests, got %d", requests)
+	}
+
+	if now := time.Now(); now.Before(retryAt) {
+		t.Errorf("Expected the client to wait until %s before retrying, retried at %s", retryAt, now)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		header   string
+		expected time.Duration
+		ok       bool
+	}{
+		{"", 0, false},
+		{"30", 30 * time.Second, true},
+		{"0", 0, true},
+		{"-5", 0, false},
+		{"soon", 0, false},
+		{"Wed, 01 May 2024 12:00:45 GMT", 45 * time.Second, true},
+		{"Wed, 01 May 2024 11:59:00 GMT", 0, true},
+	}
+
+	for _, tc := range testCases {
+		resp := &http.Response{Header: http.Header{}}
+		if tc.header != "" {
+			resp.Header.Set("Retry-After", tc.header)
+		}
+
+		got, ok := retryAfter(resp, now)
+		if ok != tc.ok || got != tc.expected {
+			t.Errorf("Expected (%s, %v) for %q, got (%s, %v)", tc.expected, tc.ok, tc.header, got, ok)
+		}
+	}
+}
+
+func TestDoRequest_retryAfterCappedByRetryWaitMax(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.Header().Set("Retry-After", "3600")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL, "token", &ClientConfig{
+		RetryWaitMin: time.Millisecond,
+		RetryWaitMax: 10 * time.Millisecond,
+	})
+
+	start := time.Now()
+	resp, err := client.doRequest(context.Background(), http.MethodGet, "/System/Info")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	defer resp.Body.Close()
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected the wait to be capped at retry_wait_max, waited %s", elapsed)
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// ScheduledTask represents a server maintenance or library task from /ScheduledTasks.
+type ScheduledTask struct {
+	Id    string `json:"Id"`
+	Name  string `json:"Name"`
+	Key   string `json:"Key"`
+	State string `json:"State"`
+
+	// Category groups related tasks, e.g. "Library" or "Maintenance".
+	Category string `json:"Category"`
+
+	// LastExecutionResult is nil if the task hasn't run since the server started
+	// keeping history.
+	LastExecutionResult *TaskResult `json:"LastExecutionResult"`
+
+	// Triggers are the schedules that start the task.
+	Triggers []TaskTrigger `json:"Triggers"`
+}
+
+// TaskTrigger describes when a scheduled task runs. Durations and times of day are in
+// ticks of 100 nanoseconds.
+type TaskTrigger struct {
+	// Type is "DailyTrigger", "WeeklyTrigger", "IntervalTrigger" or "StartupTrigger".
+	Type string `json:"Type"`
+
+	// IntervalTicks is the time between runs of an interval trigger.
+	IntervalTicks *int64 `json:"IntervalTicks,omitempty"`
+
+	// TimeOfDayTicks is the time after midnight a daily or weekly trigger fires.
+	TimeOfDayTicks *int64 `json:"TimeOfDayTicks,omitempty"`
+
+	// DayOfWeek is the day a weekly trigger fires, e.g. "Sunday".
+	DayOfWeek string `json:"DayOfWeek,omitempty"`
+
+	// MaxRuntimeTicks, when set, limits how long a run started by the trigger may take.
+	MaxRuntimeTicks *int64 `json:"MaxRuntimeTicks,omitempty"`
+}
+
+// TaskResult describes the outcome of a scheduled task's most recent run.
+type TaskResult struct {
+	StartTimeUtc string `json:"StartTimeUtc"`
+	EndTimeUtc   string `json:"EndTimeUtc"`
+
+	// Status is "Completed", "Failed", "Cancelled" or "Aborted".
+	Status       string `json:"Status"`
+	ErrorMessage string `json:"ErrorMessage"`
+}
+
+// GetScheduledTasks retrieves the server's scheduled tasks, including hidden ones.
+func (c *Client) GetScheduledTasks(ctx context.Context) ([]ScheduledTask, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/ScheduledTasks")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var tasks []ScheduledTask
+	if err := json.NewDecoder(resp.Body).Decode(&tasks); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return tasks, nil
+}
+
+// GetScheduledTask retrieves a scheduled task by ID. If the task doesn't exist the
+// returned error satisfies IsNotFound.
+func (c *Client) GetScheduledTask(ctx context.Context, taskID string) (*ScheduledTask, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/ScheduledTasks/"+url.PathEscape(taskID))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var task ScheduledTask
+	if err := json.NewDecoder(resp.Body).Decode(&task); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &task, nil
+}
+
+// UpdateTaskTriggers replaces all triggers of a scheduled task. An empty list leaves
+// the task to be run only on demand.
+func (c *Client) UpdateTaskTriggers(ctx context.Context, taskID string, triggers []TaskTrigger) error {
+	if triggers == nil {
+		triggers = []TaskTrigger{}
+	}
+
+	body, err := json.Marshal(triggers)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task triggers: %w", err)
+	}
+
+	path := fmt.Sprintf("/ScheduledTasks/%s/Triggers", url.PathEscape(taskID))
+
+	resp, err := c.doRequestWithBody(ctx, http.MethodPost, path, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return newAPIError(resp)
+	}
+
+	return nil
+}
@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetScheduledTasks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if r.URL.Path != "/ScheduledTasks" {
+			t.Errorf("Expected path /ScheduledTasks, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[
+  {
+    "Name": "Scan Media Library",
+    "State": "Idle",
+    "Id": "7738148ffcd07979c7ceb148e06b3aed",
+    "Key": "RefreshLibrary",
+    "Category": "Library",
+    "LastExecutionResult": {
+      "StartTimeUtc": "2024-05-01T02:00:00.0000000Z",
+      "EndTimeUtc": "2024-05-01T02:03:10.1234567Z",
+      "Status": "Failed",
+      "ErrorMessage": "Access to the path '/media/movies' is denied."
+    }
+  },
+  {"Name": "Clean Cache Directory", "State": "Idle", "Id": "0ed5a7d9e7c9a3f7e4a9b5c8d6e1f2a3", "Key": "DeleteCacheFiles"}
+]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	tasks, err := client.GetScheduledTasks(context.Background())
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(tasks) != 2 {
+		t.Fatalf("Expected 2 tasks, got %d", len(tasks))
+	}
+
+	if tasks[0].Category != "Library" {
+		t.Errorf("Expected category 'Library', got %s", tasks[0].Category)
+	}
+
+	result := tasks[0].LastExecutionResult
+	if result == nil {
+		t.Fatal("Expected the first task to have a last execution result")
+	}
+	if result.Status != "Failed" {
+		t.Errorf("Expected status 'Failed', got %s", result.Status)
+	}
+	if result.ErrorMessage != "Access to the path '/media/movies' is denied." {
+		t.Errorf("Expected the error message to be decoded, got %q", result.ErrorMessage)
+	}
+
+	if tasks[1].LastExecutionResult != nil {
+		t.Errorf("Expected no last execution result for a task that never ran, got %+v", tasks[1].LastExecutionResult)
+	}
+}
+
+func TestGetScheduledTask(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ScheduledTasks/7738148ffcd07979c7ceb148e06b3aed" {
+			t.Errorf("Expected path /ScheduledTasks/7738148ffcd07979c7ceb148e06b3aed, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+  "Name": "Scan Media Library",
+  "State": "Idle",
+  "Id": "7738148ffcd07979c7ceb148e06b3aed",
+  "Key": "RefreshLibrary",
+  "Triggers": [
+    {"Type": "IntervalTrigger", "IntervalTicks": 432000000000},
+    {"Type": "WeeklyTrigger", "TimeOfDayTicks": 36000000000, "DayOfWeek": "Sunday"}
+  ]
+}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	task, err := client.GetScheduledTask(context.Background(), "7738148ffcd07979c7ceb148e06b3aed")
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(task.Triggers) != 2 {
+		t.Fatalf("Expected 2 triggers, got %d", len(task.Triggers))
+	}
+
+	interval := task.Triggers[0]
+	if interval.IntervalTicks == nil || *interval.IntervalTicks != 432000000000 {
+		t.Errorf("Expected interval of 432000000000 ticks, got %v", interval.IntervalTicks)
+	}
+	if interval.TimeOfDayTicks != nil {
+		t.Errorf("Expected no time of day for an interval trigger, got %v", *interval.TimeOfDayTicks)
+	}
+
+	if weekly := task.Triggers[1]; weekly.DayOfWeek != "Sunday" {
+		t.Errorf("Expected weekly trigger on Sunday, got %q", weekly.DayOfWeek)
+	}
+}
+
+func TestGetScheduledTask_notFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	_, err := client.GetScheduledTask(context.Background(), "missing")
+
+	if !IsNotFound(err) {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+}
+
+func TestUpdateTaskTriggers(t *testing.T) {
+	var body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if r.URL.Path != "/ScheduledTasks/task-1/Triggers" {
+			t.Errorf("Expected path /ScheduledTasks/task-1/Triggers, got %s", r.URL.Path)
+		}
+
+		raw, _ := io.ReadAll(r.Body)
+		body = string(raw)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	timeOfDay := int64(36000000000)
+	err := client.UpdateTaskTriggers(context.Background(), "task-1", []TaskTrigger{
+		{Type: "DailyTrigger", TimeOfDayTicks: &timeOfDay},
+		{Type: "StartupTrigger"},
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := `[{"Type":"DailyTrigger","TimeOfDayTicks":36000000000},{"Type":"StartupTrigger"}]`
+	if body != expected {
+		t.Errorf("Expected body %s, got %s", expected, body)
+	}
+}
+
+func TestUpdateTaskTriggers_empty(t *testing.T) {
+	var triggers []TaskTrigger
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&triggers); err != nil {
+			t.Errorf("Expected a JSON array, got error %v", err)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	if err := client.UpdateTaskTriggers(context.Background(), "task-1", nil); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if triggers == nil || len(triggers) != 0 {
+		t.Errorf("Expected an empty array, got %v", triggers)
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Session represents a client session on the server.
+type Session struct {
+	Id               string `json:"Id"`
+	UserId           string `json:"UserId"`
+	UserName         string `json:"UserName"`
+	Client           string `json:"Client"`
+	DeviceId         string `json:"DeviceId"`
+	DeviceName       string `json:"DeviceName"`
+	LastActivityDate string `json:"LastActivityDate"`
+}
+
+// GetSessions retrieves the server's sessions.
+func (c *Client) GetSessions(ctx context.Context) ([]Session, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/Sessions")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var sessions []Session
+	if err := json.NewDecoder(resp.Body).Decode(&sessions); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return sessions, nil
+}
+
+// TerminateSession ends a session by signing out its device, which revokes the
+// device's access token. Jellyfin has no endpoint to end another client's session
+// directly. If the session doesn't exist the returned error satisfies IsNotFound.
+func (c *Client) TerminateSession(ctx context.Context, sessionID string) error {
+	sessions, err := c.GetSessions(ctx)
+	if err != nil {
+		return err
+	}
+
+	var session *Session
+	for i := range sessions {
+		if sessions[i].Id == sessionID {
+			session = &sessions[i]
+			break
+		}
+	}
+
+	if session == nil {
+		return &APIError{StatusCode: http.StatusNotFound, Body: fmt.Sprintf("session %s not found", sessionID)}
+	}
+
+	return c.DeleteDevice(ctx, session.DeviceId)
+}
+
+// SessionMessage is a message displayed on a session's client.
+type SessionMessage struct {
+	Header string `json:"Header,omitempty"`
+	Text   string `json:"Text"`
+	// TimeoutMs is how long the client shows the message. When nil the client
+	// keeps it on screen until dismissed.
+	TimeoutMs *int64 `json:"TimeoutMs,omitempty"`
+}
+
+// SendSessionMessage displays a message on a session's client. If the session
+// doesn't exist the returned error satisfies IsNotFound.
+func (c *Client) SendSessionMessage(ctx context.Context, sessionID string, message SessionMessage) error {
+	body, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("failed to marshal message: %w", err)
+	}
+
+	resp, err := c.doRequestWithBody(ctx, http.MethodPost, "/Sessions/"+url.PathEscape(sessionID)+"/Message", body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return newAPIError(resp)
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const sessionsPayload = `[
+	{"Id":"session-1","UserId":"user-1","UserName":"alice","Client":"Jellyfin Web","DeviceId":"device-1","DeviceName":"Firefox"},
+	{"Id":"session-2","UserId":"user-2","UserName":"bob","Client":"Infuse","DeviceId":"device-2","DeviceName":"Apple TV"}
+]`
+
+func TestGetSessions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/Sessions" {
+			t.Errorf("Expected path /Sessions, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(sessionsPayload))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	sessions, err := client.GetSessions(context.Background())
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(sessions) != 2 {
+		t.Fatalf("Expected 2 sessions, got %d", len(sessions))
+	}
+
+	if sessions[1].DeviceId != "device-2" || sessions[1].UserName != "bob" {
+		t.Errorf("Expected second session for bob on device-2, got %+v", sessions[1])
+	}
+}
+
+func TestTerminateSession(t *testing.T) {
+	var deleted string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/Sessions":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(sessionsPayload))
+		case r.Method == http.MethodDelete && r.URL.Path == "/Devices":
+			deleted = r.URL.Query().Get("id")
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+
+	if err := client.TerminateSession(context.Background(), "session-2"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if deleted != "device-2" {
+		t.Errorf("Expected device-2 to be signed out, got %q", deleted)
+	}
+
+	if err := client.TerminateSession(context.Background(), "session-9"); !IsNotFound(err) {
+		t.Errorf("Expected not found error for a missing session, got %v", err)
+	}
+}
+
+func TestSendSessionMessage(t *testing.T) {
+	timeout := int64(10000)
+
+	testCases := []struct {
+		name         string
+		sessionID    string
+		message      SessionMessage
+		expectedPath string
+		expectedBody string
+	}{
+		{
+			name:         "full message",
+			sessionID:    "session-1",
+			message:      SessionMessage{Header: "Maintenance", Text: "Restarting in 10 minutes", TimeoutMs: &timeout},
+			expectedPath: "/Sessions/session-1/Message",
+			expectedBody: `{"Header":"Maintenance","Text":"Restarting in 10 minutes","TimeoutMs":10000}`,
+		},
+		{
+			name:         "text only",
+			sessionID:    "session-1",
+			message:      SessionMessage{Text: "Hello"},
+			expectedPath: "/Sessions/session-1/Message",
+			expectedBody: `{"Text":"Hello"}`,
+		},
+		{
+			name:         "escaped session id",
+			sessionID:    "a/b c",
+			message:      SessionMessage{Text: "Hello"},
+			expectedPath: "/Sessions/a%2Fb%20c/Message",
+			expectedBody: `{"Text":"Hello"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost {
+					t.Errorf("Expected POST, got %s", r.Method)
+				}
+
+				if r.URL.EscapedPath() != tc.expectedPath {
+					t.Errorf("Expected path %s, got %s", tc.expectedPath, r.URL.EscapedPath())
+				}
+
+				var body json.RawMessage
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Errorf("Failed to decode body: %v", err)
+				}
+
+				if string(body) != tc.expectedBody {
+					t.Errorf("Expected body %s, got %s", tc.expectedBody, body)
+				}
+
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer server.Close()
+
+			client := NewClient(server.URL, "test-api-key")
+
+			if err := client.SendSessionMessage(context.Background(), tc.sessionID, tc.message); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestSendSessionMessage_notFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+
+	if err := client.SendSessionMessage(context.Background(), "session-9", SessionMessage{Text: "Hello"}); !IsNotFound(err) {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+}
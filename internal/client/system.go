@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// startTimeFields are the /System/Info fields that may carry the server start time.
+// Stock Jellyfin doesn't report one, but some builds and forks do.
+var startTimeFields = []string{"StartTime", "ServerStartTime", "StartupTime"}
+
+// ErrNotJellyfin is returned by Ping when the server doesn't answer like a Jellyfin server.
+var ErrNotJellyfin = errors.New("the server does not appear to be a Jellyfin server")
+
+// PublicSystemInfo represents the server information returned by /System/Info/Public,
+// which is available without authenticating.
+type PublicSystemInfo struct {
+	Id                     string `json:"Id"`
+	ServerName             string `json:"ServerName"`
+	Version                string `json:"Version"`
+	ProductName            string `json:"ProductName"`
+	LocalAddress           string `json:"LocalAddress"`
+	StartupWizardCompleted bool   `json:"StartupWizardCompleted"`
+}
+
+// SystemInfo represents the server information returned by /System/Info.
+type SystemInfo struct {
+	Id                     string `json:"Id"`
+	ServerName             string `json:"ServerName"`
+	Version                string `json:"Version"`
+	ProductName            string `json:"ProductName"`
+	OperatingSystem        string `json:"OperatingSystem"`
+	LocalAddress           string `json:"LocalAddress"`
+	StartupWizardCompleted bool   `json:"StartupWizardCompleted"`
+	HasPendingRestart      bool   `json:"HasPendingRestart"`
+
+	// Storage paths, nil if the server version doesn't report them.
+	InternalMetadataPath *string `json:"InternalMetadataPath"`
+	CachePath            *string `json:"CachePath"`
+	TranscodingTempPath  *string `json:"TranscodingTempPath"`
+
+	// StartTime is when the server process started, or nil if the server doesn't report it.
+	StartTime *time.Time `json:"-"`
+}
+
+// GetSystemInfo retrieves information about the server.
+func (c *Client) GetSystemInfo(ctx context.Context) (*SystemInfo, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/System/Info")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	var info SystemInfo
+	if err := json.Unmarshal(body, &info); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	info.StartTime = parseStartTime(fields)
+
+	return &info, nil
+}
+
+// GetPublicSystemInfo retrieves the information the server reports without authentication.
+func (c *Client) GetPublicSystemInfo(ctx context.Context) (*PublicSystemInfo, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/System/Info/Public")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return decodePublicSystemInfo(resp)
+}
+
+// Ping checks that the endpoint is a Jellyfin server: it must answer
+// /System/Info/Public with its ID and version. Otherwise the returned error wraps
+// ErrNotJellyfin, unless the server couldn't be reached or failed with a server error.
+func (c *Client) Ping(ctx context.Context) error {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/System/Info/Public")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return newAPIError(resp)
+	}
+
+	info, err := decodePublicSystemInfo(resp)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrNotJellyfin, err)
+	}
+
+	if info.Id == "" || info.Version == "" {
+		return fmt.Errorf("%w: /System/Info/Public did not report a server ID and version", ErrNotJellyfin)
+	}
+
+	return nil
+}
+
+// decodePublicSystemInfo decodes a /System/Info/Public response.
+func decodePublicSystemInfo(resp *http.Response) (*PublicSystemInfo, error) {
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var info PublicSystemInfo
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &info, nil
+}
+
+// parseStartTime returns the first start time field that parses as a timestamp.
+func parseStartTime(fields map[string]json.RawMessage) *time.Time {
+	for _, name := range startTimeFields {
+		raw, ok := fields[name]
+		if !ok {
+			continue
+		}
+
+		var value string
+		if err := json.Unmarshal(raw, &value); err != nil || value == "" {
+			continue
+		}
+
+		if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+			return &t
+		}
+	}
+
+	return nil
+}
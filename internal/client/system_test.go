@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGetSystemInfo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/System/Info" {
+			t.Errorf("Expected path /System/Info, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"Id":"abc","ServerName":"media","Version":"10.9.11","ProductName":"Jellyfin Server","HasPendingRestart":true}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	info, err := client.GetSystemInfo(context.Background())
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if info.ServerName != "media" {
+		t.Errorf("Expected server name 'media', got %s", info.ServerName)
+	}
+
+	if info.Version != "10.9.11" {
+		t.Errorf("Expected version '10.9.11', got %s", info.Version)
+	}
+
+	if !info.HasPendingRestart {
+		t.Error("Expected HasPendingRestart to be true")
+	}
+
+	if info.StartTime != nil {
+		t.Errorf("Expected no start time, got %s", info.StartTime)
+	}
+
+	if info.CachePath != nil || info.InternalMetadataPath != nil || info.TranscodingTempPath != nil {
+		t.Error("Expected storage paths to be nil when not reported")
+	}
+}
+
+func TestGetSystemInfo_paths(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"Id":"abc","InternalMetadataPath":"/config/metadata","CachePath":"/cache","TranscodingTempPath":"/cache/transcodes"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	info, err := client.GetSystemInfo(context.Background())
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if info.InternalMetadataPath == nil || *info.InternalMetadataPath != "/config/metadata" {
+		t.Errorf("Expected metadata path '/config/metadata', got %v", info.InternalMetadataPath)
+	}
+
+	if info.CachePath == nil || *info.CachePath != "/cache" {
+		t.Errorf("Expected cache path '/cache', got %v", info.CachePath)
+	}
+
+	if info.TranscodingTempPath == nil || *info.TranscodingTempPath != "/cache/transcodes" {
+		t.Errorf("Expected transcoding temp path '/cache/transcodes', got %v", info.TranscodingTempPath)
+	}
+}
+
+func TestGetSystemInfo_startTime(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"Id":"abc","StartTime":"not a time","ServerStartTime":"2024-05-01T12:00:00.0000000Z"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	info, err := client.GetSystemInfo(context.Background())
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	if info.StartTime == nil || !info.StartTime.Equal(expected) {
+		t.Errorf("Expected start time %s, got %v", expected, info.StartTime)
+	}
+}
+
+func TestGetSystemInfo_error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	if _, err := client.GetSystemInfo(context.Background()); err == nil {
+		t.Error("Expected error for unauthorized response")
+	}
+}
+
+func TestGetPublicSystemInfo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/System/Info/Public" {
+			t.Errorf("Expected path /System/Info/Public, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"Id":"abc","ServerName":"media","Version":"10.9.11","ProductName":"Jellyfin Server","StartupWizardCompleted":true}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	info, err := client.GetPublicSystemInfo(context.Background())
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if info.Id != "abc" || info.Version != "10.9.11" {
+		t.Errorf("Expected id 'abc' and version '10.9.11', got %q and %q", info.Id, info.Version)
+	}
+
+	if !info.StartupWizardCompleted {
+		t.Error("Expected StartupWizardCompleted to be true")
+	}
+
+	if err := client.Ping(context.Background()); err != nil {
+		t.Errorf("Expected Ping to succeed, got %v", err)
+	}
+}
+
+func TestPing_notJellyfin(t *testing.T) {
+	testCases := []struct {
+		name        string
+		status      int
+		contentType string
+		body        string
+	}{
+		{"html page", http.StatusOK, "text/html", "<!DOCTYPE html><html><body>Welcome to nginx!</body></html>"},
+		{"unrelated json", http.StatusOK, "application/json", `{"status":"ok"}`},
+		{"not found", http.StatusNotFound, "text/plain", "404 page not found"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", tc.contentType)
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer server.Close()
+
+			err := NewClient(server.URL, "test-api-key").Ping(context.Background())
+
+			if !errors.Is(err, ErrNotJellyfin) {
+				t.Errorf("Expected ErrNotJellyfin, got %v", err)
+			}
+		})
+	}
+}
+
+func TestPing_serverError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := newClient(server.URL, "test-api-key", &ClientConfig{RetryMax: -1})
+	err := client.Ping(context.Background())
+
+	if err == nil || errors.Is(err, ErrNotJellyfin) {
+		t.Errorf("Expected a server error other than ErrNotJellyfin, got %v", err)
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// NewClientWithTokenFile creates a new Jellyfin API client that reads its access token
+// from a file. The file is re-read whenever the server rejects the current token, so a
+// token rotated by an external process is picked up without reconfiguring the client.
+func NewClientWithTokenFile(endpoint, tokenFile string, config *ClientConfig) (*Client, error) {
+	if _, err := NormalizeEndpoint(endpoint); err != nil {
+		return nil, err
+	}
+
+	token, err := readTokenFile(tokenFile)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := NewClientWithConfig(endpoint, token, config)
+	if err != nil {
+		return nil, err
+	}
+
+	c.tokenFile = tokenFile
+
+	return c, nil
+}
+
+// readTokenFile reads an access token from a file, ignoring surrounding whitespace.
+func readTokenFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read token file: %w", err)
+	}
+
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("token file %s is empty", path)
+	}
+
+	return token, nil
+}
+
+// token returns the current access token.
+func (c *Client) token() string {
+	c.tokenMu.RLock()
+	defer c.tokenMu.RUnlock()
+	return c.accessToken
+}
+
+// reloadToken re-reads the token file and reports whether the token changed.
+func (c *Client) reloadToken() (bool, error) {
+	token, err := readTokenFile(c.tokenFile)
+	if err != nil {
+		return false, err
+	}
+
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	if token == c.accessToken {
+		return false, nil
+	}
+
+	c.accessToken = token
+	return true, nil
+}
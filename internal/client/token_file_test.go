@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeTokenFile(t *testing.T, path, token string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(token), 0o600); err != nil {
+		t.Fatalf("Failed to write token file: %v", err)
+	}
+}
+
+func TestNewClientWithTokenFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	writeTokenFile(t, path, "  file-token\n")
+
+	client, err := NewClientWithTokenFile("http://localhost:8096", path, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if client.accessToken != "file-token" {
+		t.Errorf("Expected accessToken 'file-token', got %s", client.accessToken)
+	}
+}
+
+func TestNewClientWithTokenFile_invalid(t *testing.T) {
+	dir := t.TempDir()
+	empty := filepath.Join(dir, "empty")
+	writeTokenFile(t, empty, "\n")
+
+	if _, err := NewClientWithTokenFile("http://localhost:8096", filepath.Join(dir, "missing"), nil); err == nil {
+		t.Error("Expected error for missing token file")
+	}
+
+	if _, err := NewClientWithTokenFile("http://localhost:8096", empty, nil); err == nil {
+		t.Error("Expected error for empty token file")
+	}
+}
+
+func TestClient_tokenFileRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	writeTokenFile(t, path, "old-token")
+
+	var seen []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		seen = append(seen, auth)
+
+		if auth != `MediaBrowser Token="new-token"` {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"Items":[],"TotalRecordCount":0}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithTokenFile(server.URL, path, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// Rotate the token after the client has been configured.
+	writeTokenFile(t, path, "new-token")
+
+	if _, err := client.GetKeys(context.Background()); err != nil {
+		t.Fatalf("Expected no error after token rotation, got %v", err)
+	}
+
+	if len(seen) != 2 {
+		t.Errorf("Expected 2 requests, got %d", len(seen))
+	}
+}
+
+func TestClient_tokenFileUnchanged(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	writeTokenFile(t, path, "stale-token")
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithTokenFile(server.URL, path, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	_, err = client.GetKeys(context.Background())
+	if !IsUnauthorized(err) {
+		t.Errorf("Expected unauthorized error, got %v", err)
+	}
+
+	if requests != 1 {
+		t.Errorf("Expected no retry when the token is unchanged, got %d requests", requests)
+	}
+}
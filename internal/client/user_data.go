@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// UserItemData represents the per-user state of an item (favorite, played, etc.).
+type UserItemData struct {
+	IsFavorite     bool   `json:"IsFavorite"`
+	Played         bool   `json:"Played"`
+	PlayCount      int    `json:"PlayCount"`
+	LastPlayedDate string `json:"LastPlayedDate"`
+}
+
+// GetItemUserData retrieves the user data for an item as seen by the given user. If the
+// user or item doesn't exist the returned error satisfies IsNotFound.
+func (c *Client) GetItemUserData(ctx context.Context, userID, itemID string) (*UserItemData, error) {
+	path := fmt.Sprintf("/Users/%s/Items/%s", url.PathEscape(userID), url.PathEscape(itemID))
+
+	resp, err := c.doRequest(ctx, http.MethodGet, path)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var item struct {
+		UserData UserItemData `json:"UserData"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&item); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &item.UserData, nil
+}
+
+// SetFavorite marks or unmarks an item as a favorite for the given user.
+func (c *Client) SetFavorite(ctx context.Context, userID, itemID string, favorite bool) error {
+	path := fmt.Sprintf("/Users/%s/FavoriteItems/%s", url.PathEscape(userID), url.PathEscape(itemID))
+	return c.setUserItemFlag(ctx, path, favorite)
+}
+
+// SetPlayed marks an item as played or unplayed for the given user.
+func (c *Client) SetPlayed(ctx context.Context, userID, itemID string, played bool) error {
+	path := fmt.Sprintf("/Users/%s/PlayedItems/%s", url.PathEscape(userID), url.PathEscape(itemID))
+	return c.setUserItemFlag(ctx, path, played)
+}
+
+// setUserItemFlag sets (POST) or clears (DELETE) a boolean user data flag.
+func (c *Client) setUserItemFlag(ctx context.Context, path string, value bool) error {
+	method := http.MethodDelete
+	if value {
+		method = http.MethodPost
+	}
+
+	resp, err := c.doRequest(ctx, method, path)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return newAPIError(resp)
+	}
+
+	return nil
+}
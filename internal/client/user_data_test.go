@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetItemUserData(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if r.URL.Path != "/Users/user-1/Items/item-1" {
+			t.Errorf("Expected path /Users/user-1/Items/item-1, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"Id":"item-1","Name":"Movie","UserData":{"IsFavorite":true,"Played":false,"PlayCount":3}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	data, err := client.GetItemUserData(context.Background(), "user-1", "item-1")
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if data == nil {
+		t.Fatal("Expected user data to be returned")
+	}
+
+	if !data.IsFavorite {
+		t.Error("Expected IsFavorite to be true")
+	}
+
+	if data.Played {
+		t.Error("Expected Played to be false")
+	}
+
+	if data.PlayCount != 3 {
+		t.Errorf("Expected play count 3, got %d", data.PlayCount)
+	}
+}
+
+func TestGetItemUserData_notFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	data, err := client.GetItemUserData(context.Background(), "user-1", "missing")
+
+	if !IsNotFound(err) {
+		t.Fatalf("Expected not found error, got %v", err)
+	}
+
+	if data != nil {
+		t.Error("Expected nil user data for missing item")
+	}
+}
+
+func TestSetFavorite(t *testing.T) {
+	testCases := []struct {
+		name     string
+		favorite bool
+		method   string
+	}{
+		{"mark", true, http.MethodPost},
+		{"unmark", false, http.MethodDelete},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != tc.method {
+					t.Errorf("Expected %s request, got %s", tc.method, r.Method)
+				}
+				if r.URL.Path != "/Users/user-1/FavoriteItems/item-1" {
+					t.Errorf("Expected path /Users/user-1/FavoriteItems/item-1, got %s", r.URL.Path)
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			client := NewClient(server.URL, "test-api-key")
+			if err := client.SetFavorite(context.Background(), "user-1", "item-1", tc.favorite); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestSetPlayed(t *testing.T) {
+	testCases := []struct {
+		name   string
+		played bool
+		method string
+	}{
+		{"mark", true, http.MethodPost},
+		{"unmark", false, http.MethodDelete},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != tc.method {
+					t.Errorf("Expected %s request, got %s", tc.method, r.Method)
+				}
+				if r.URL.Path != "/Users/user-1/PlayedItems/item-1" {
+					t.Errorf("Expected path /Users/user-1/PlayedItems/item-1, got %s", r.URL.Path)
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			client := NewClient(server.URL, "test-api-key")
+			if err := client.SetPlayed(context.Background(), "user-1", "item-1", tc.played); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestSetPlayed_serverError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	if err := client.SetPlayed(context.Background(), "user-1", "item-1", true); err == nil {
+		t.Error("Expected error for 500 response")
+	}
+}
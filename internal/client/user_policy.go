@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// UserPolicy is a user's permissions and access policy keyed by JSON field name.
+// Fields are kept as raw JSON so settings the provider doesn't model survive a write.
+type UserPolicy map[string]json.RawMessage
+
+// AuthProvider represents an installed authentication or password reset provider.
+type AuthProvider struct {
+	Name string `json:"Name"`
+	Id   string `json:"Id"`
+}
+
+// GetUserPolicy retrieves a user's policy, which Jellyfin returns as part of the user.
+func (c *Client) GetUserPolicy(ctx context.Context, userID string) (UserPolicy, error) {
+	user, err := c.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Policy == nil {
+		return UserPolicy{}, nil
+	}
+
+	return user.Policy, nil
+}
+
+// UpdateUserPolicy replaces a user's policy. Jellyfin expects the complete object,
+// not a partial one.
+func (c *Client) UpdateUserPolicy(ctx context.Context, userID string, policy UserPolicy) error {
+	body, err := json.Marshal(policy)
+	if err != nil {
+		return fmt.Errorf("failed to marshal user policy: %w", err)
+	}
+
+	path := fmt.Sprintf("/Users/%s/Policy", url.PathEscape(userID))
+
+	resp, err := c.doRequestWithBody(ctx, http.MethodPost, path, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return newAPIError(resp)
+	}
+
+	return nil
+}
+
+// PatchUserPolicy performs a read-modify-write of a user's policy, setting only the
+// given fields. It returns the policy as written. Concurrent patches of the same user's
+// policy run one at a time.
+func (c *Client) PatchUserPolicy(ctx context.Context, userID string, fields map[string]interface{}) (UserPolicy, error) {
+	unlock, err := c.patches.lock(ctx, "user-policy:"+userID)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	policy, err := c.GetUserPolicy(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for name, value := range fields {
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal user policy field %s: %w", name, err)
+		}
+		policy[name] = raw
+	}
+
+	if err := c.UpdateUserPolicy(ctx, userID, policy); err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// GetAuthProviders retrieves the installed authentication providers.
+func (c *Client) GetAuthProviders(ctx context.Context) ([]AuthProvider, error) {
+	return c.getAuthProviders(ctx, "/Auth/Providers")
+}
+
+// GetPasswordResetProviders retrieves the installed password reset providers.
+func (c *Client) GetPasswordResetProviders(ctx context.Context) ([]AuthProvider, error) {
+	return c.getAuthProviders(ctx, "/Auth/PasswordResetProviders")
+}
+
+func (c *Client) getAuthProviders(ctx context.Context, path string) ([]AuthProvider, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, path)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var providers []AuthProvider
+	if err := json.NewDecoder(resp.Body).Decode(&providers); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return providers, nil
+}
+
+// String decodes a string field from the policy, returning "" if it is absent or null.
+func (up UserPolicy) String(name string) string {
+	var value string
+	if raw, ok := up[name]; ok {
+		_ = json.Unmarshal(raw, &value)
+	}
+	return value
+}
+
+// Bool decodes a boolean field from the policy, returning false if it is absent or null.
+func (up UserPolicy) Bool(name string) bool {
+	var value bool
+	if raw, ok := up[name]; ok {
+		_ = json.Unmarshal(raw, &value)
+	}
+	return value
+}
+
+// Int64 decodes an integer field from the policy, returning 0 if it is absent or null.
+func (up UserPolicy) Int64(name string) int64 {
+	var value int64
+	if raw, ok := up[name]; ok {
+		_ = json.Unmarshal(raw, &value)
+	}
+	return value
+}
+
+// Strings decodes a string list field from the policy, returning nil if it is absent or null.
+func (up UserPolicy) Strings(name string) []string {
+	var value []string
+	if raw, ok := up[name]; ok {
+		_ = json.Unmarshal(raw, &value)
+	}
+	return value
+}
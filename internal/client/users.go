@@ -0,0 +1,292 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// User represents a Jellyfin user.
+type User struct {
+	Id               string            `json:"Id"`
+	Name             string            `json:"Name"`
+	HasPassword      bool              `json:"HasPassword"`
+	LastLoginDate    string            `json:"LastLoginDate"`
+	LastActivityDate string            `json:"LastActivityDate"`
+	Configuration    UserConfiguration `json:"Configuration"`
+	Policy           UserPolicy        `json:"Policy"`
+}
+
+// UserConfiguration is a user's playback and display preferences keyed by JSON field
+// name. Fields are kept as raw JSON so settings the provider doesn't model survive a write.
+type UserConfiguration map[string]json.RawMessage
+
+// GetUsers retrieves all users. Concurrent calls share a single request.
+func (c *Client) GetUsers(ctx context.Context) ([]User, error) {
+	users, err := coalesce(ctx, &c.reads, usersReadKey, c.getUsers)
+	if err != nil {
+		return nil, err
+	}
+
+	// Each caller gets its own copy of the list, since the result is shared.
+	return append([]User(nil), users...), nil
+}
+
+func (c *Client) getUsers(ctx context.Context) ([]User, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/Users")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var users []User
+	if err := json.NewDecoder(resp.Body).Decode(&users); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return users, nil
+}
+
+// GetUser retrieves a user by ID. If the user doesn't exist the returned error
+// satisfies IsNotFound.
+func (c *Client) GetUser(ctx context.Context, userID string) (*User, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/Users/"+url.PathEscape(userID))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var user User
+	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &user, nil
+}
+
+// FindUserByName finds a user by name, compared case-insensitively as Jellyfin does.
+// It returns nil if no user has that name.
+func (c *Client) FindUserByName(ctx context.Context, name string) (*User, error) {
+	users, err := c.GetUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range users {
+		if strings.EqualFold(users[i].Name, name) {
+			return &users[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// CreateUser creates a user with the given name and password, which may be empty.
+func (c *Client) CreateUser(ctx context.Context, name, password string) (*User, error) {
+	body, err := json.Marshal(map[string]string{
+		"Name":     name,
+		"Password": password,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.doRequestWithBody(ctx, http.MethodPost, "/Users/New", body)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var user User
+	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &user, nil
+}
+
+// RenameUser changes a user's name. Jellyfin expects the complete user object, so the
+// user is read first and written back with only the name changed. Since the server also
+// applies the embedded configuration, it holds the same lock as PatchUserConfiguration.
+func (c *Client) RenameUser(ctx context.Context, userID, name string) error {
+	unlock, err := c.patches.lock(ctx, "user-configuration:"+userID)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	path := "/Users/" + url.PathEscape(userID)
+
+	resp, err := c.doRequest(ctx, http.MethodGet, path)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return newAPIError(resp)
+	}
+
+	var user map[string]json.RawMessage
+	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	user["Name"], err = json.Marshal(name)
+	if err != nil {
+		return fmt.Errorf("failed to marshal user name: %w", err)
+	}
+
+	body, err := json.Marshal(user)
+	if err != nil {
+		return fmt.Errorf("failed to marshal user: %w", err)
+	}
+
+	updateResp, err := c.doRequestWithBody(ctx, http.MethodPost, path, body)
+	if err != nil {
+		return err
+	}
+	defer updateResp.Body.Close()
+
+	if updateResp.StatusCode != http.StatusNoContent && updateResp.StatusCode != http.StatusOK {
+		return newAPIError(updateResp)
+	}
+
+	return nil
+}
+
+// SetUserPassword sets a user's password. currentPw is only needed when the caller
+// isn't an administrator and may be empty otherwise. An empty newPw resets the
+// password, leaving the user without one.
+func (c *Client) SetUserPassword(ctx context.Context, userID, currentPw, newPw string) error {
+	request := map[string]interface{}{
+		"NewPw":         newPw,
+		"ResetPassword": newPw == "",
+	}
+	if currentPw != "" {
+		request["CurrentPw"] = currentPw
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.doRequestWithBody(ctx, http.MethodPost, fmt.Sprintf("/Users/%s/Password", url.PathEscape(userID)), body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return newAPIError(resp)
+	}
+
+	return nil
+}
+
+// DeleteUser deletes a user.
+func (c *Client) DeleteUser(ctx context.Context, userID string) error {
+	resp, err := c.doRequest(ctx, http.MethodDelete, "/Users/"+url.PathEscape(userID))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return newAPIError(resp)
+	}
+
+	return nil
+}
+
+// GetCurrentUser retrieves the user the client is authenticated as. Requests made
+// with an API key aren't tied to a user and fail.
+func (c *Client) GetCurrentUser(ctx context.Context) (*User, error) {
+	return c.GetUser(ctx, "Me")
+}
+
+// UpdateUserConfiguration replaces a user's configuration. Jellyfin expects the
+// complete object, not a partial one.
+func (c *Client) UpdateUserConfiguration(ctx context.Context, userID string, config UserConfiguration) error {
+	body, err := json.Marshal(config)
+	if err != nil {
+		return fmt.Errorf("failed to marshal user configuration: %w", err)
+	}
+
+	path := fmt.Sprintf("/Users/%s/Configuration", url.PathEscape(userID))
+
+	resp, err := c.doRequestWithBody(ctx, http.MethodPost, path, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return newAPIError(resp)
+	}
+
+	return nil
+}
+
+// PatchUserConfiguration performs a read-modify-write of a user's configuration,
+// setting only the given fields. It returns the configuration as written. Concurrent
+// patches of the same user's configuration run one at a time.
+func (c *Client) PatchUserConfiguration(ctx context.Context, userID string, fields map[string]interface{}) (UserConfiguration, error) {
+	unlock, err := c.patches.lock(ctx, "user-configuration:"+userID)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	user, err := c.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	config := user.Configuration
+	if config == nil {
+		config = UserConfiguration{}
+	}
+
+	for name, value := range fields {
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal user configuration field %s: %w", name, err)
+		}
+		config[name] = raw
+	}
+
+	if err := c.UpdateUserConfiguration(ctx, userID, config); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// String decodes a string field from the configuration, returning "" if it is absent or null.
+func (uc UserConfiguration) String(name string) string {
+	var value string
+	if raw, ok := uc[name]; ok {
+		_ = json.Unmarshal(raw, &value)
+	}
+	return value
+}
@@ -0,0 +1,756 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestGetUsers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if r.URL.Path != "/Users" {
+			t.Errorf("Expected path /Users, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"Id":"user-1","Name":"alice","Policy":{"IsAdministrator":true}},{"Id":"user-2","Name":"bob","Policy":{"IsDisabled":true}}]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	users, err := client.GetUsers(context.Background())
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(users) != 2 {
+		t.Fatalf("Expected 2 users, got %d", len(users))
+	}
+
+	if users[1].Name != "bob" {
+		t.Errorf("Expected second user 'bob', got %s", users[1].Name)
+	}
+
+	if !users[0].Policy.Bool("IsAdministrator") || users[0].Policy.Bool("IsDisabled") {
+		t.Errorf("Expected alice to be an enabled administrator, got policy %v", users[0].Policy)
+	}
+
+	if users[1].Policy.Bool("IsAdministrator") || !users[1].Policy.Bool("IsDisabled") {
+		t.Errorf("Expected bob to be a disabled non-administrator, got policy %v", users[1].Policy)
+	}
+}
+
+func TestGetUser(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/Users/user-1" {
+			t.Errorf("Expected path /Users/user-1, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"Id":"user-1","Name":"alice","HasPassword":true,"Configuration":{"SubtitleMode":"Smart","AudioLanguagePreference":null}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	user, err := client.GetUser(context.Background(), "user-1")
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if user.Name != "alice" {
+		t.Errorf("Expected name 'alice', got %s", user.Name)
+	}
+
+	if user.Configuration.String("SubtitleMode") != "Smart" {
+		t.Errorf("Expected SubtitleMode 'Smart', got %s", user.Configuration.String("SubtitleMode"))
+	}
+
+	if user.Configuration.String("AudioLanguagePreference") != "" {
+		t.Errorf("Expected empty AudioLanguagePreference for null, got %s", user.Configuration.String("AudioLanguagePreference"))
+	}
+}
+
+func TestGetUser_notFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	_, err := client.GetUser(context.Background(), "missing")
+
+	if !IsNotFound(err) {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+}
+
+func TestFindUserByName(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/Users" {
+			t.Errorf("Expected path /Users, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"Id":"user-1","Name":"alice"},{"Id":"user-2","Name":"Bob","Policy":{"IsAdministrator":true}}]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	user, err := client.FindUserByName(context.Background(), "bob")
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if user == nil || user.Id != "user-2" {
+		t.Fatalf("Expected user-2, got %+v", user)
+	}
+
+	if !user.Policy.Bool("IsAdministrator") {
+		t.Error("Expected IsAdministrator to be true")
+	}
+
+	missing, err := client.FindUserByName(context.Background(), "carol")
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if missing != nil {
+		t.Errorf("Expected no user, got %+v", missing)
+	}
+}
+
+func TestPatchUserConfiguration_preservesUnmanagedFields(t *testing.T) {
+	var posted map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/Users/user-1":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"Id":"user-1","Configuration":{"SubtitleMode":"Default","PlayDefaultAudioTrack":true}}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/Users/user-1/Configuration":
+			if err := json.NewDecoder(r.Body).Decode(&posted); err != nil {
+				t.Errorf("Failed to decode posted configuration: %v", err)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected %s request to %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	config, err := client.PatchUserConfiguration(context.Background(), "user-1", map[string]interface{}{
+		"SubtitleMode": "Always",
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.String("SubtitleMode") != "Always" {
+		t.Errorf("Expected returned SubtitleMode 'Always', got %s", config.String("SubtitleMode"))
+	}
+
+	if posted["SubtitleMode"] != "Always" {
+		t.Errorf("Expected posted SubtitleMode 'Always', got %v", posted["SubtitleMode"])
+	}
+
+	if posted["PlayDefaultAudioTrack"] != true {
+		t.Errorf("Expected PlayDefaultAudioTrack to be preserved, got %v", posted["PlayDefaultAudioTrack"])
+	}
+}
+
+func TestGetCurrentUser(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/Users/Me" {
+			t.Errorf("Expected path /Users/Me, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"Id":"user-1","Name":"automation","LastActivityDate":"2024-05-01T12:00:00.1234567Z"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	user, err := client.GetCurrentUser(context.Background())
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if user.Name != "automation" {
+		t.Errorf("Expected name 'automation', got %s", user.Name)
+	}
+
+	if user.LastActivityDate != "2024-05-01T12:00:00.1234567Z" {
+		t.Errorf("Expected last activity date to be decoded, got %s", user.LastActivityDate)
+	}
+}
+
+func TestCreateUser(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if r.URL.Path != "/Users/New" {
+			t.Errorf("Expected path /Users/New, got %s", r.URL.Path)
+		}
+
+		var body map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body["Name"] != "carol" || body["Password"] != "secret" {
+			t.Errorf("Expected name and password in body, got %v", body)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"Id":"user-3","Name":"carol","Policy":{"AuthenticationProviderId":"default"}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	user, err := client.CreateUser(context.Background(), "carol", "secret")
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if user.Id != "user-3" {
+		t.Errorf("Expected id 'user-3', got %s", user.Id)
+	}
+
+	if user.Policy.String("AuthenticationProviderId") != "default" {
+		t.Errorf("Expected policy to be decoded, got %v", user.Policy)
+	}
+}
+
+func TestRenameUser(t *testing.T) {
+	var posted map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/Users/user-1" {
+			t.Errorf("Expected path /Users/user-1, got %s", r.URL.Path)
+		}
+
+		if r.Method == http.MethodGet {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"Id":"user-1","Name":"alice","HasPassword":true}`))
+			return
+		}
+
+		_ = json.NewDecoder(r.Body).Decode(&posted)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	if err := client.RenameUser(context.Background(), "user-1", "alicia"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if posted["Name"] != "alicia" {
+		t.Errorf("Expected name 'alicia', got %v", posted["Name"])
+	}
+
+	if posted["HasPassword"] != true {
+		t.Errorf("Expected other fields to be preserved, got %v", posted)
+	}
+}
+
+func TestSetUserPassword_reset(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/Users/user-1/Password" {
+			t.Errorf("Expected path /Users/user-1/Password, got %s", r.URL.Path)
+		}
+
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body["ResetPassword"] != true {
+			t.Errorf("Expected an empty password to reset, got %v", body)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	if err := client.SetUserPassword(context.Background(), "user-1", "", ""); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestSetUserPassword(t *testing.T) {
+	testCases := []struct {
+		name      string
+		currentPw string
+		expected  map[string]interface{}
+	}{
+		{
+			name:     "as administrator",
+			expected: map[string]interface{}{"NewPw": "new-secret", "ResetPassword": false},
+		},
+		{
+			name:      "with current password",
+			currentPw: "old-secret",
+			expected:  map[string]interface{}{"CurrentPw": "old-secret", "NewPw": "new-secret", "ResetPassword": false},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var posted map[string]interface{}
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/Users/user-1/Password" {
+					t.Errorf("Expected POST /Users/user-1/Password, got %s %s", r.Method, r.URL.Path)
+				}
+
+				_ = json.NewDecoder(r.Body).Decode(&posted)
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer server.Close()
+
+			client := NewClient(server.URL, "test-api-key")
+			if err := client.SetUserPassword(context.Background(), "user-1", tc.currentPw, "new-secret"); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if !reflect.DeepEqual(posted, tc.expected) {
+				t.Errorf("Expected body %v, got %v", tc.expected, posted)
+			}
+		})
+	}
+}
+
+func TestPatchUserPolicy(t *testing.T) {
+	var posted map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/Users/user-1":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"Id":"user-1","Policy":{"IsAdministrator":true,"AuthenticationProviderId":"default"}}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/Users/user-1/Policy":
+			_ = json.NewDecoder(r.Body).Decode(&posted)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	policy, err := client.PatchUserPolicy(context.Background(), "user-1", map[string]interface{}{
+		"AuthenticationProviderId": "ldap",
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if policy.String("AuthenticationProviderId") != "ldap" {
+		t.Errorf("Expected returned policy to be updated, got %v", policy)
+	}
+
+	if posted["AuthenticationProviderId"] != "ldap" || posted["IsAdministrator"] != true {
+		t.Errorf("Expected full policy with updated provider, got %v", posted)
+	}
+}
+
+func TestGetUserPolicy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/Users/user-1" {
+			t.Errorf("Expected path /Users/user-1, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"Id":"user-1","Policy":{"IsDisabled":true,"MaxActiveSessions":2}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	policy, err := client.GetUserPolicy(context.Background(), "user-1")
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if !policy.Bool("IsDisabled") {
+		t.Error("Expected IsDisabled to be true")
+	}
+
+	if policy.Int64("MaxActiveSessions") != 2 {
+		t.Errorf("Expected MaxActiveSessions 2, got %d", policy.Int64("MaxActiveSessions"))
+	}
+}
+
+func TestPatchUserPolicy_isDisabled(t *testing.T) {
+	var posted map[string]json.RawMessage
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/Users/user-1":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"Id":"user-1","Policy":{"IsDisabled":false,"BlockedTags":["adult"],"AccessSchedules":[{"DayOfWeek":"Sunday","StartHour":8,"EndHour":20}]}}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/Users/user-1/Policy":
+			_ = json.NewDecoder(r.Body).Decode(&posted)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	policy, err := client.PatchUserPolicy(context.Background(), "user-1", map[string]interface{}{
+		"IsDisabled": true,
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if !policy.Bool("IsDisabled") {
+		t.Error("Expected returned policy to be disabled")
+	}
+
+	if string(posted["IsDisabled"]) != "true" {
+		t.Errorf("Expected IsDisabled true to be posted, got %s", posted["IsDisabled"])
+	}
+
+	if string(posted["BlockedTags"]) != `["adult"]` {
+		t.Errorf("Expected BlockedTags to be preserved, got %s", posted["BlockedTags"])
+	}
+
+	if string(posted["AccessSchedules"]) != `[{"DayOfWeek":"Sunday","StartHour":8,"EndHour":20}]` {
+		t.Errorf("Expected AccessSchedules to be preserved verbatim, got %s", posted["AccessSchedules"])
+	}
+}
+
+func TestPatchUserPolicy_enableRemoteAccess(t *testing.T) {
+	stored := map[string]json.RawMessage{
+		"EnableRemoteAccess": json.RawMessage(`true`),
+		"IsAdministrator":    json.RawMessage(`false`),
+		"BlockedTags":        json.RawMessage(`["adult"]`),
+		"EnabledFolders":     json.RawMessage(`["folder-1"]`),
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/Users/user-1":
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"Id": "user-1", "Policy": stored})
+		case r.Method == http.MethodPost && r.URL.Path == "/Users/user-1/Policy":
+			stored = map[string]json.RawMessage{}
+			_ = json.NewDecoder(r.Body).Decode(&stored)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+
+	for _, enabled := range []bool{false, true} {
+		policy, err := client.PatchUserPolicy(context.Background(), "user-1", map[string]interface{}{
+			"EnableRemoteAccess": enabled,
+		})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if policy.Bool("EnableRemoteAccess") != enabled {
+			t.Errorf("Expected returned EnableRemoteAccess %t, got %t", enabled, policy.Bool("EnableRemoteAccess"))
+		}
+
+		if got := string(stored["EnableRemoteAccess"]); got != strconv.FormatBool(enabled) {
+			t.Errorf("Expected EnableRemoteAccess %t to be posted, got %s", enabled, got)
+		}
+
+		if string(stored["BlockedTags"]) != `["adult"]` {
+			t.Errorf("Expected BlockedTags to be preserved, got %s", stored["BlockedTags"])
+		}
+
+		if string(stored["EnabledFolders"]) != `["folder-1"]` {
+			t.Errorf("Expected EnabledFolders to be preserved, got %s", stored["EnabledFolders"])
+		}
+
+		if string(stored["IsAdministrator"]) != "false" {
+			t.Errorf("Expected IsAdministrator to be preserved, got %s", stored["IsAdministrator"])
+		}
+	}
+}
+
+// TestPatchUser_concurrent patches different fields of the same user's policy and
+// configuration in parallel, as jellyfin_user, jellyfin_user_state and
+// jellyfin_user_library_access do. Run it with -race.
+func TestPatchUser_concurrent(t *testing.T) {
+	var mu sync.Mutex
+	user := map[string]map[string]json.RawMessage{
+		"Policy":        {"IsAdministrator": json.RawMessage(`false`)},
+		"Configuration": {"AudioLanguagePreference": json.RawMessage(`"eng"`)},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/Users/user-1":
+			mu.Lock()
+			body, _ := json.Marshal(map[string]interface{}{
+				"Id":            "user-1",
+				"Policy":        user["Policy"],
+				"Configuration": user["Configuration"],
+			})
+			mu.Unlock()
+
+			// Hold the read so an unserialized patch would overlap with another.
+			time.Sleep(10 * time.Millisecond)
+
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(body)
+		case r.Method == http.MethodPost && (r.URL.Path == "/Users/user-1/Policy" || r.URL.Path == "/Users/user-1/Configuration"):
+			var posted map[string]json.RawMessage
+			if err := json.NewDecoder(r.Body).Decode(&posted); err != nil {
+				t.Errorf("Failed to decode posted body: %v", err)
+			}
+
+			mu.Lock()
+			if r.URL.Path == "/Users/user-1/Policy" {
+				user["Policy"] = posted
+			} else {
+				user["Configuration"] = posted
+			}
+			mu.Unlock()
+
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+
+	const patches = 10
+
+	var wg sync.WaitGroup
+	for i := 0; i < patches; i++ {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := client.PatchUserPolicy(context.Background(), "user-1", map[string]interface{}{
+				"Field" + strconv.Itoa(i): i,
+			})
+			if err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			_, err := client.PatchUserConfiguration(context.Background(), "user-1", map[string]interface{}{
+				"Field" + strconv.Itoa(i): i,
+			})
+			if err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	got, err := client.GetUser(context.Background(), "user-1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	for i := 0; i < patches; i++ {
+		name := "Field" + strconv.Itoa(i)
+		if got.Policy.Int64(name) != int64(i) {
+			t.Errorf("Expected policy %s to be %d, got %s", name, i, got.Policy[name])
+		}
+		if string(got.Configuration[name]) != strconv.Itoa(i) {
+			t.Errorf("Expected configuration %s to be %d, got %s", name, i, got.Configuration[name])
+		}
+	}
+
+	if string(got.Policy["IsAdministrator"]) != "false" {
+		t.Errorf("Expected IsAdministrator to be preserved, got %s", got.Policy["IsAdministrator"])
+	}
+
+	if got.Configuration.String("AudioLanguagePreference") != "eng" {
+		t.Errorf("Expected AudioLanguagePreference to be preserved, got %s", got.Configuration["AudioLanguagePreference"])
+	}
+}
+
+// TestRenameUser_concurrentConfiguration renames a user while its configuration is
+// patched, as jellyfin_user and jellyfin_user_configuration do. Run it with -race.
+func TestRenameUser_concurrentConfiguration(t *testing.T) {
+	var mu sync.Mutex
+	user := map[string]json.RawMessage{
+		"Id":            json.RawMessage(`"user-1"`),
+		"Name":          json.RawMessage(`"alice"`),
+		"Configuration": json.RawMessage(`{}`),
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/Users/user-1":
+			mu.Lock()
+			body, _ := json.Marshal(user)
+			mu.Unlock()
+
+			// Hold the read so an unserialized write would overlap with another.
+			time.Sleep(10 * time.Millisecond)
+
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(body)
+		case r.Method == http.MethodPost && r.URL.Path == "/Users/user-1":
+			// Like Jellyfin, a user update also applies its embedded configuration.
+			var posted map[string]json.RawMessage
+			_ = json.NewDecoder(r.Body).Decode(&posted)
+
+			// Apply it late, so an unserialized rename lands after the configuration patch.
+			time.Sleep(20 * time.Millisecond)
+
+			mu.Lock()
+			user["Name"] = posted["Name"]
+			user["Configuration"] = posted["Configuration"]
+			mu.Unlock()
+
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodPost && r.URL.Path == "/Users/user-1/Configuration":
+			var posted json.RawMessage
+			_ = json.NewDecoder(r.Body).Decode(&posted)
+
+			mu.Lock()
+			user["Configuration"] = posted
+			mu.Unlock()
+
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+
+		if err := client.RenameUser(context.Background(), "user-1", "alice2"); err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		_, err := client.PatchUserConfiguration(context.Background(), "user-1", map[string]interface{}{
+			"AudioLanguagePreference": "eng",
+		})
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+	}()
+
+	wg.Wait()
+
+	got, err := client.GetUser(context.Background(), "user-1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if got.Name != "alice2" {
+		t.Errorf("Expected name alice2, got %s", got.Name)
+	}
+
+	if got.Configuration.String("AudioLanguagePreference") != "eng" {
+		t.Errorf("Expected the configuration patch to be kept, got %s", got.Configuration["AudioLanguagePreference"])
+	}
+}
+
+func TestPatchUserPolicy_folders(t *testing.T) {
+	var posted map[string]json.RawMessage
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/Users/user-1":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"Id":"user-1","Policy":{"IsAdministrator":true,"EnableAllFolders":true,"EnabledFolders":[],"MaxParentalRating":12}}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/Users/user-1/Policy":
+			_ = json.NewDecoder(r.Body).Decode(&posted)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	policy, err := client.PatchUserPolicy(context.Background(), "user-1", map[string]interface{}{
+		"EnableAllFolders": false,
+		"EnabledFolders":   []string{"f137a2dd21bbc1b99aa5c0f6bf02a805"},
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if got := policy.Strings("EnabledFolders"); len(got) != 1 || got[0] != "f137a2dd21bbc1b99aa5c0f6bf02a805" {
+		t.Errorf("Expected the enabled folder to be returned, got %v", got)
+	}
+
+	if string(posted["EnableAllFolders"]) != "false" {
+		t.Errorf("Expected EnableAllFolders false to be posted, got %s", posted["EnableAllFolders"])
+	}
+
+	if string(posted["IsAdministrator"]) != "true" || string(posted["MaxParentalRating"]) != "12" {
+		t.Errorf("Expected unrelated fields to be preserved, got %v", posted)
+	}
+}
+
+func TestGetAuthProviders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/Auth/Providers" {
+			t.Errorf("Expected path /Auth/Providers, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"Name":"Default","Id":"default"},{"Name":"LDAP-Auth","Id":"ldap"}]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	providers, err := client.GetAuthProviders(context.Background())
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(providers) != 2 || providers[1].Id != "ldap" {
+		t.Errorf("Expected 2 providers ending with 'ldap', got %v", providers)
+	}
+}
@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// defaultActivityLogLimit caps how many entries jellyfin_activity_log returns.
+const defaultActivityLogLimit = 100
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ActivityLogDataSource{}
+
+func NewActivityLogDataSource() datasource.DataSource {
+	return &ActivityLogDataSource{}
+}
+
+// ActivityLogDataSource defines the data source implementation.
+type ActivityLogDataSource struct {
+	client *client.Client
+}
+
+// ActivityLogDataSourceModel describes the data source data model.
+type ActivityLogDataSourceModel struct {
+	MinDate    types.String            `tfsdk:"min_date"`
+	StartIndex types.Int64             `tfsdk:"start_index"`
+	Limit      types.Int64             `tfsdk:"limit"`
+	Entries    []ActivityLogEntryModel `tfsdk:"entries"`
+}
+
+// ActivityLogEntryModel describes a single entry in the activity log.
+type ActivityLogEntryModel struct {
+	ID       types.Int64  `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Type     types.String `tfsdk:"type"`
+	UserID   types.String `tfsdk:"user_id"`
+	Severity types.String `tfsdk:"severity"`
+	Date     types.String `tfsdk:"date"`
+}
+
+func (d *ActivityLogDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_activity_log"
+}
+
+func (d *ActivityLogDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads entries from the Jellyfin activity log, such as sign-ins, configuration changes and " +
+			"plugin installs, newest first.",
+
+		Attributes: map[string]schema.Attribute{
+			"min_date": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Only return entries logged at or after this time, in RFC 3339 format " +
+					"(e.g., `2024-01-01T00:00:00Z`). Omit to return entries of any age.",
+			},
+			"start_index": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The number of newest entries to skip. Defaults to `0`.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"limit": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("The maximum number of entries to return. Defaults to `%d`.", defaultActivityLogLimit),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"entries": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The matching entries, newest first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the entry.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "A summary of the activity, such as `admin has logged in from Firefox`.",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The kind of activity, such as `SessionStarted` or `PluginInstalled`.",
+						},
+						"user_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the user who performed the activity. Null for activity by the server itself.",
+						},
+						"severity": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The log level of the entry, such as `Information` or `Warning`.",
+						},
+						"date": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The date and time the activity was logged.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ActivityLogDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ActivityLogDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ActivityLogDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var minDate string
+	if !data.MinDate.IsNull() {
+		t, ok := parseJellyfinDate(data.MinDate.ValueString())
+
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("min_date"),
+				"Invalid Date",
+				fmt.Sprintf("Expected a timestamp such as \"2024-01-01T00:00:00Z\", got %q.", data.MinDate.ValueString()),
+			)
+			return
+		}
+
+		minDate = t.UTC().Format(time.RFC3339)
+	}
+
+	limit := int64(defaultActivityLogLimit)
+	if !data.Limit.IsNull() {
+		limit = data.Limit.ValueInt64()
+	}
+
+	entries, err := d.client.GetActivityLogEntries(ctx, int(data.StartIndex.ValueInt64()), int(limit), minDate)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read the activity log: %s", err))
+		return
+	}
+
+	data.Entries = make([]ActivityLogEntryModel, 0, len(entries))
+	for _, entry := range entries {
+		data.Entries = append(data.Entries, activityLogEntryModel(entry))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// activityLogEntryModel converts an activity log entry to its Terraform model.
+func activityLogEntryModel(entry client.ActivityLogEntry) ActivityLogEntryModel {
+	// Activity by the server itself is logged against the empty GUID.
+	userID := types.StringNull()
+	if strings.Trim(client.NormalizeGuid(entry.UserId), "0") != "" {
+		userID = types.StringValue(entry.UserId)
+	}
+
+	return ActivityLogEntryModel{
+		ID:       types.Int64Value(entry.Id),
+		Name:     types.StringValue(entry.Name),
+		Type:     types.StringValue(entry.Type),
+		UserID:   userID,
+		Severity: types.StringValue(entry.Severity),
+		Date:     types.StringValue(entry.Date),
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccActivityLogDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Signing in to run the test logs activity, so the log is never empty.
+			{
+				Config: `
+data "jellyfin_activity_log" "test" {
+  limit = 5
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.jellyfin_activity_log.test", "entries.0.id"),
+					resource.TestCheckResourceAttrSet("data.jellyfin_activity_log.test", "entries.0.name"),
+					resource.TestCheckResourceAttrSet("data.jellyfin_activity_log.test", "entries.0.date"),
+				),
+			},
+			{
+				Config: `
+data "jellyfin_activity_log" "test" {
+  min_date = "2999-01-01T00:00:00Z"
+}
+`,
+				Check: resource.TestCheckResourceAttr("data.jellyfin_activity_log.test", "entries.#", "0"),
+			},
+		},
+	})
+}
+
+func TestAccActivityLogDataSource_invalidMinDate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "jellyfin_activity_log" "test" {
+  min_date = "yesterday"
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Date"),
+			},
+		},
+	})
+}
@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestActivityLogDataSource_Metadata(t *testing.T) {
+	ds := &ActivityLogDataSource{}
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_activity_log"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestActivityLogDataSource_Schema(t *testing.T) {
+	ds := &ActivityLogDataSource{}
+	req := datasource.SchemaRequest{}
+	resp := &datasource.SchemaResponse{}
+
+	ds.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check min_date attribute
+	minDateAttr, ok := resp.Schema.Attributes["min_date"]
+	if !ok {
+		t.Error("Expected 'min_date' attribute in schema")
+	} else {
+		if !minDateAttr.IsOptional() {
+			t.Error("Expected 'min_date' attribute to be optional")
+		}
+	}
+
+	// Check start_index attribute
+	startIndexAttr, ok := resp.Schema.Attributes["start_index"]
+	if !ok {
+		t.Error("Expected 'start_index' attribute in schema")
+	} else {
+		if !startIndexAttr.IsOptional() {
+			t.Error("Expected 'start_index' attribute to be optional")
+		}
+	}
+
+	// Check limit attribute
+	limitAttr, ok := resp.Schema.Attributes["limit"]
+	if !ok {
+		t.Error("Expected 'limit' attribute in schema")
+	} else {
+		if !limitAttr.IsOptional() {
+			t.Error("Expected 'limit' attribute to be optional")
+		}
+	}
+
+	// Check entries attribute
+	entriesAttr, ok := resp.Schema.Attributes["entries"]
+	if !ok {
+		t.Error("Expected 'entries' attribute in schema")
+	} else {
+		if !entriesAttr.IsComputed() {
+			t.Error("Expected 'entries' attribute to be computed")
+		}
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestActivityLogDataSource_Configure_nilProviderData(t *testing.T) {
+	ds := &ActivityLogDataSource{}
+	req := datasource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestActivityLogDataSource_Configure_wrongType(t *testing.T) {
+	ds := &ActivityLogDataSource{}
+	req := datasource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestActivityLogDataSource_Configure_success(t *testing.T) {
+	ds := &ActivityLogDataSource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := datasource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if ds.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewActivityLogDataSource(t *testing.T) {
+	ds := NewActivityLogDataSource()
+	if ds == nil {
+		t.Error("Expected data source to be instantiated")
+	}
+
+	_, ok := ds.(*ActivityLogDataSource)
+	if !ok {
+		t.Error("Expected data source to be *ActivityLogDataSource")
+	}
+}
+
+func TestActivityLogEntryModel(t *testing.T) {
+	got := activityLogEntryModel(client.ActivityLogEntry{
+		Id:       42,
+		Name:     "admin has logged in from Firefox",
+		Type:     "SessionStarted",
+		UserId:   "5d1c8a3e-0b6f-4f8c-9e2a-1b7d4c6a8f90",
+		Severity: "Information",
+		Date:     "2024-01-01T00:00:00.0000000Z",
+	})
+
+	if got.ID.ValueInt64() != 42 {
+		t.Errorf("Expected id 42, got %s", got.ID)
+	}
+
+	if got.Type.ValueString() != "SessionStarted" || got.Severity.ValueString() != "Information" {
+		t.Errorf("Expected type and severity to be copied, got %s and %s", got.Type, got.Severity)
+	}
+
+	if got.UserID.ValueString() != "5d1c8a3e-0b6f-4f8c-9e2a-1b7d4c6a8f90" {
+		t.Errorf("Expected user_id to be copied, got %s", got.UserID)
+	}
+
+	if got.Date.ValueString() != "2024-01-01T00:00:00.0000000Z" {
+		t.Errorf("Expected date to be copied, got %s", got.Date)
+	}
+}
+
+func TestActivityLogEntryModel_serverActivity(t *testing.T) {
+	for _, userID := range []string{"", "00000000000000000000000000000000", "00000000-0000-0000-0000-000000000000"} {
+		got := activityLogEntryModel(client.ActivityLogEntry{Id: 1, Name: "Scan media library completed", UserId: userID})
+
+		if !got.UserID.IsNull() {
+			t.Errorf("Expected null user_id for %q, got %s", userID, got.UserID)
+		}
+	}
+}
@@ -6,7 +6,9 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,9 +20,13 @@ import (
 	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
 )
 
+// apiKeyImportAppNamePrefix marks an import ID as an app name rather than an access token.
+const apiKeyImportAppNamePrefix = "app_name:"
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &APIKeyResource{}
 var _ resource.ResourceWithImportState = &APIKeyResource{}
+var _ resource.ResourceWithModifyPlan = &APIKeyResource{}
 
 func NewAPIKeyResource() resource.Resource {
 	return &APIKeyResource{}
@@ -45,7 +51,7 @@ func (r *APIKeyResource) Metadata(ctx context.Context, req resource.MetadataRequ
 
 func (r *APIKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a Jellyfin API key.",
+		MarkdownDescription: "Manages a Jellyfin API key. When the provider sets `require_key_import`, keys can only be imported, not created.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -56,8 +62,9 @@ func (r *APIKeyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"app_name": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The name of the application using this API key.",
+				Required: true,
+				MarkdownDescription: "The name of the application using this API key. Changing it replaces the key; " +
+					"set `create_before_destroy` in a `lifecycle` block so the new key exists before the old one is revoked.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -100,6 +107,30 @@ func (r *APIKeyResource) Configure(ctx context.Context, req resource.ConfigureRe
 	r.client = client
 }
 
+// ModifyPlan warns when a new key's name lacks the provider's key_name_prefix, since
+// such keys are left out of managed-key inventories.
+func (r *APIKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || r.client.KeyNamePrefix() == "" || req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() {
+		return
+	}
+
+	var appName types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("app_name"), &appName)...)
+
+	if resp.Diagnostics.HasError() || appName.IsUnknown() || appName.IsNull() {
+		return
+	}
+
+	if !r.client.IsManagedKeyName(appName.ValueString()) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("app_name"),
+			"API Key Name Missing Managed Prefix",
+			fmt.Sprintf("The app_name %q does not start with the provider's key_name_prefix %q, so the key won't be listed as managed by jellyfin_api_keys.",
+				appName.ValueString(), r.client.KeyNamePrefix()),
+		)
+	}
+}
+
 func (r *APIKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data APIKeyResourceModel
 
@@ -111,49 +142,26 @@ func (r *APIKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	appName := data.AppName.ValueString()
 
-	tflog.Debug(ctx, "Creating API key", map[string]interface{}{
-		"app_name": appName,
-	})
-
-	// Get existing keys before creation to find the new one after
-	existingKeys, err := r.client.GetKeys(ctx)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list existing API keys: %s", err))
+	if r.client.RequireKeyImport() {
+		resp.Diagnostics.AddError(
+			"API Key Creation Disabled",
+			fmt.Sprintf("The provider is configured with require_key_import, so API keys aren't created by Terraform. "+
+				"Create a key named %q in the Jellyfin dashboard under Administration > API Keys, "+
+				"then import it by its access token, e.g. terraform import <resource address> <access token>.", appName),
+		)
 		return
 	}
 
-	existingIDs := make(map[int64]bool)
-	for _, key := range existingKeys.Items {
-		existingIDs[key.Id] = true
-	}
+	tflog.Debug(ctx, "Creating API key", map[string]interface{}{
+		"app_name": appName,
+	})
 
-	// Create the new API key
-	err = r.client.CreateKey(ctx, appName)
+	createdKey, err := r.client.CreateKeyAndGet(ctx, appName)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create API key: %s", err))
 		return
 	}
 
-	// Find the newly created key by comparing with existing keys
-	newKeys, err := r.client.GetKeys(ctx)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list API keys after creation: %s", err))
-		return
-	}
-
-	var createdKey *client.APIKey
-	for _, key := range newKeys.Items {
-		if !existingIDs[key.Id] && key.AppName == appName {
-			createdKey = &key
-			break
-		}
-	}
-
-	if createdKey == nil {
-		resp.Diagnostics.AddError("Client Error", "Unable to find the newly created API key")
-		return
-	}
-
 	// Set the resource data using the AccessToken as the terraform resource ID
 	// (Jellyfin API doesn't return a stable Id for API keys)
 	data.ID = types.StringValue(createdKey.AccessToken)
@@ -242,5 +250,78 @@ func (r *APIKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *APIKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	appName, byName := strings.CutPrefix(req.ID, apiKeyImportAppNamePrefix)
+
+	if !byName {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	if appName == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in the form '%s<app_name>' or an access token, got: %q", apiKeyImportAppNamePrefix, req.ID),
+		)
+		return
+	}
+
+	keys, err := r.client.FindKeysByAppName(ctx, appName)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list API keys: %s", err))
+		return
+	}
+
+	accessToken, diags := apiKeyImportToken(appName, keys)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), accessToken)...)
+}
+
+// apiKeyImportToken returns the access token of the only key named appName, failing
+// when there is none or the name is ambiguous. Candidates are identified by creation
+// date and the end of their token, so the tokens themselves aren't exposed.
+func apiKeyImportToken(appName string, keys []client.APIKey) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch len(keys) {
+	case 0:
+		diags.AddError(
+			"API Key Not Found",
+			fmt.Sprintf("No API key with app name %q was found.", appName),
+		)
+		return "", diags
+	case 1:
+		return keys[0].AccessToken, diags
+	}
+
+	candidates := make([]string, 0, len(keys))
+	for _, key := range keys {
+		candidates = append(candidates, fmt.Sprintf("- created %s, access token ending in %q", key.DateCreated, tokenSuffix(key.AccessToken)))
+	}
+
+	diags.AddError(
+		"Multiple API Keys Found",
+		fmt.Sprintf("%d API keys have app name %q:\n%s\n\n", len(keys), appName, strings.Join(candidates, "\n"))+
+			"Import one of them by its access token instead, which the jellyfin_api_keys data source lists, "+
+			"or revoke the keys that are no longer used.",
+	)
+
+	return "", diags
+}
+
+// tokenSuffix returns the last few characters of an access token, enough to tell keys
+// apart without revealing them.
+func tokenSuffix(token string) string {
+	const visible = 4
+
+	if len(token) <= visible {
+		return token
+	}
+
+	return token[len(token)-visible:]
 }
@@ -5,9 +5,11 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestAccAPIKeyResource_basic(t *testing.T) {
@@ -36,6 +38,65 @@ func TestAccAPIKeyResource_basic(t *testing.T) {
 	})
 }
 
+func TestAccAPIKeyResource_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIKeyResourceConfig_basic("test-api-key-import"),
+			},
+			// Import by access token
+			{
+				ResourceName:      "jellyfin_api_key.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Import by app name
+			{
+				ResourceName:      "jellyfin_api_key.test",
+				ImportState:       true,
+				ImportStateId:     "app_name:test-api-key-import",
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  "jellyfin_api_key.test",
+				ImportState:   true,
+				ImportStateId: "app_name:test-api-key-import-missing",
+				ExpectError:   regexp.MustCompile("API Key Not Found"),
+			},
+		},
+	})
+}
+
+func TestAccAPIKeyResource_importAmbiguousAppName(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "jellyfin_api_key" "test1" {
+  app_name = "test-api-key-import-duplicate"
+}
+
+resource "jellyfin_api_key" "test2" {
+  app_name = "test-api-key-import-duplicate"
+
+  depends_on = [jellyfin_api_key.test1]
+}
+`,
+			},
+			{
+				ResourceName:  "jellyfin_api_key.test1",
+				ImportState:   true,
+				ImportStateId: "app_name:test-api-key-import-duplicate",
+				ExpectError:   regexp.MustCompile("Multiple API Keys Found"),
+			},
+		},
+	})
+}
+
 func TestAccAPIKeyResource_multipleKeys(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -86,6 +147,61 @@ func TestAccAPIKeyResource_appNameRequiresReplace(t *testing.T) {
 	})
 }
 
+func TestAccAPIKeyResource_createBeforeDestroy(t *testing.T) {
+	var previousToken string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIKeyResourceConfig_createBeforeDestroy("test-api-key-cbd", "1"),
+				Check: resource.TestCheckResourceAttrWith("jellyfin_api_key.test", "access_token", func(value string) error {
+					previousToken = value
+					return nil
+				}),
+			},
+			// Rotate with the same app_name, so the old and new keys briefly share it
+			{
+				Config: testAccAPIKeyResourceConfig_createBeforeDestroy("test-api-key-cbd", "2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("jellyfin_api_key.test", plancheck.ResourceActionCreateBeforeDestroy),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_api_key.test", "app_name", "test-api-key-cbd"),
+					resource.TestCheckResourceAttrWith("jellyfin_api_key.test", "access_token", func(value string) error {
+						if value == previousToken {
+							return fmt.Errorf("expected a new access token after replacement, got the previous one")
+						}
+						previousToken = value
+						return nil
+					}),
+				),
+			},
+			// Rename, which replaces the key through app_name
+			{
+				Config: testAccAPIKeyResourceConfig_createBeforeDestroy("test-api-key-cbd-renamed", "2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("jellyfin_api_key.test", plancheck.ResourceActionCreateBeforeDestroy),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_api_key.test", "app_name", "test-api-key-cbd-renamed"),
+					resource.TestCheckResourceAttrWith("jellyfin_api_key.test", "access_token", func(value string) error {
+						if value == previousToken {
+							return fmt.Errorf("expected a new access token after replacement, got the previous one")
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAPIKeyResource_specialCharacters(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -155,6 +271,23 @@ resource "jellyfin_api_key" "test" {
 `, appName)
 }
 
+func testAccAPIKeyResourceConfig_createBeforeDestroy(appName, rotation string) string {
+	return fmt.Sprintf(`
+resource "terraform_data" "rotation" {
+  input = %[2]q
+}
+
+resource "jellyfin_api_key" "test" {
+  app_name = %[1]q
+
+  lifecycle {
+    create_before_destroy = true
+    replace_triggered_by  = [terraform_data.rotation]
+  }
+}
+`, appName, rotation)
+}
+
 func testAccAPIKeyResourceConfig_multiple(appName1, appName2 string) string {
 	return fmt.Sprintf(`
 resource "jellyfin_api_key" "test1" {
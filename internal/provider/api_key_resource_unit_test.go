@@ -5,6 +5,7 @@ package provider
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -147,3 +148,63 @@ func TestNewAPIKeyResource(t *testing.T) {
 		t.Error("Expected resource to be *APIKeyResource")
 	}
 }
+
+func TestAPIKeyImportToken(t *testing.T) {
+	token, diags := apiKeyImportToken("CI", []client.APIKey{
+		{Id: 7, AccessToken: "0123456789abcdef0123456789abcdef", AppName: "CI"},
+	})
+
+	if diags.HasError() {
+		t.Fatalf("Expected no errors, got %v", diags)
+	}
+
+	if token != "0123456789abcdef0123456789abcdef" {
+		t.Errorf("Expected the key's access token, got %q", token)
+	}
+}
+
+func TestAPIKeyImportToken_notFound(t *testing.T) {
+	_, diags := apiKeyImportToken("CI", nil)
+
+	if !diags.HasError() {
+		t.Fatal("Expected an error when no key has the app name")
+	}
+
+	if diags[0].Summary() != "API Key Not Found" {
+		t.Errorf("Expected summary %q, got %q", "API Key Not Found", diags[0].Summary())
+	}
+}
+
+func TestAPIKeyImportToken_ambiguous(t *testing.T) {
+	keys := []client.APIKey{
+		{Id: 1, AccessToken: "aaaaaaaaaaaaaaaaaaaaaaaaaaaa1111", AppName: "CI", DateCreated: "2024-01-01T00:00:00.0000000Z"},
+		{Id: 2, AccessToken: "bbbbbbbbbbbbbbbbbbbbbbbbbbbb2222", AppName: "CI", DateCreated: "2024-02-01T00:00:00.0000000Z"},
+	}
+
+	token, diags := apiKeyImportToken("CI", keys)
+
+	if !diags.HasError() {
+		t.Fatal("Expected an error when several keys share the app name")
+	}
+
+	if token != "" {
+		t.Errorf("Expected no token, got %q", token)
+	}
+
+	if diags[0].Summary() != "Multiple API Keys Found" {
+		t.Errorf("Expected summary %q, got %q", "Multiple API Keys Found", diags[0].Summary())
+	}
+
+	detail := diags[0].Detail()
+	for _, want := range []string{`"1111"`, `"2222"`, "2024-01-01", "2024-02-01"} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("Expected detail to list %s, got %s", want, detail)
+		}
+	}
+
+	for _, key := range keys {
+		if strings.Contains(detail, key.AccessToken) {
+			t.Errorf("Expected detail not to reveal access tokens, got %s", detail)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &APIKeysDataSource{}
+
+func NewAPIKeysDataSource() datasource.DataSource {
+	return &APIKeysDataSource{}
+}
+
+// APIKeysDataSource defines the data source implementation.
+type APIKeysDataSource struct {
+	client *client.Client
+}
+
+// APIKeysDataSourceModel describes the data source data model.
+type APIKeysDataSourceModel struct {
+	ManagedOnly types.Bool         `tfsdk:"managed_only"`
+	Keys        []APIKeyEntryModel `tfsdk:"keys"`
+}
+
+// APIKeyEntryModel describes a single API key in the list.
+type APIKeyEntryModel struct {
+	AppName     types.String `tfsdk:"app_name"`
+	AccessToken types.String `tfsdk:"access_token"`
+	DateCreated types.String `tfsdk:"date_created"`
+	Managed     types.Bool   `tfsdk:"managed"`
+}
+
+func (d *APIKeysDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_keys"
+}
+
+func (d *APIKeysDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the API keys on the Jellyfin server, optionally only those marked as managed by the provider's `key_name_prefix`.",
+
+		Attributes: map[string]schema.Attribute{
+			"managed_only": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "When `true`, only return keys whose name starts with the provider's `key_name_prefix`, which must then be set. " +
+					"The filter is applied after listing all keys.",
+			},
+			"keys": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The matching API keys, in the order returned by the server.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"app_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the application using the key.",
+						},
+						"access_token": schema.StringAttribute{
+							Computed:            true,
+							Sensitive:           true,
+							MarkdownDescription: "The API key token.",
+						},
+						"date_created": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The date and time when the key was created.",
+						},
+						"managed": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the key's name starts with the provider's `key_name_prefix`.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *APIKeysDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *APIKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data APIKeysDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managedOnly := data.ManagedOnly.ValueBool()
+
+	if managedOnly && d.client.KeyNamePrefix() == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("managed_only"),
+			"Missing Key Name Prefix",
+			"managed_only requires the provider's key_name_prefix to be set, since it identifies the managed keys.",
+		)
+		return
+	}
+
+	result, err := d.client.GetKeys(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list API keys: %s", err))
+		return
+	}
+
+	data.Keys = []APIKeyEntryModel{}
+
+	for _, key := range result.Items {
+		managed := d.client.IsManagedKeyName(key.AppName)
+
+		if managedOnly && !managed {
+			continue
+		}
+
+		data.Keys = append(data.Keys, APIKeyEntryModel{
+			AppName:     types.StringValue(key.AppName),
+			AccessToken: types.StringValue(key.AccessToken),
+			DateCreated: types.StringValue(key.DateCreated),
+			Managed:     types.BoolValue(managed),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
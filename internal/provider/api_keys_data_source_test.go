@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAPIKeysDataSource_managedOnly(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIKeysDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.jellyfin_api_keys.managed", "keys.#", "1"),
+					resource.TestCheckResourceAttr("data.jellyfin_api_keys.managed", "keys.0.app_name", "tf-acc-managed"),
+					resource.TestCheckResourceAttr("data.jellyfin_api_keys.managed", "keys.0.managed", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAPIKeysDataSource_managedOnlyWithoutPrefix(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "jellyfin_api_keys" "test" {
+  managed_only = true
+}
+`,
+				ExpectError: regexp.MustCompile("Missing Key Name Prefix"),
+			},
+		},
+	})
+}
+
+const testAccAPIKeysDataSourceConfig = `
+provider "jellyfin" {
+  key_name_prefix = "tf-acc-"
+}
+
+resource "jellyfin_api_key" "managed" {
+  app_name = "tf-acc-managed"
+}
+
+resource "jellyfin_api_key" "unmanaged" {
+  app_name = "acc-unmanaged"
+}
+
+data "jellyfin_api_keys" "managed" {
+  managed_only = true
+
+  depends_on = [jellyfin_api_key.managed, jellyfin_api_key.unmanaged]
+}
+`
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestAPIKeysDataSource_Metadata(t *testing.T) {
+	ds := &APIKeysDataSource{}
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_api_keys"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestAPIKeysDataSource_Schema(t *testing.T) {
+	ds := &APIKeysDataSource{}
+	req := datasource.SchemaRequest{}
+	resp := &datasource.SchemaResponse{}
+
+	ds.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check managed_only attribute
+	managedOnlyAttr, ok := resp.Schema.Attributes["managed_only"]
+	if !ok {
+		t.Error("Expected 'managed_only' attribute in schema")
+	} else {
+		if !managedOnlyAttr.IsOptional() {
+			t.Error("Expected 'managed_only' attribute to be optional")
+		}
+	}
+
+	// Check keys attribute
+	keysAttr, ok := resp.Schema.Attributes["keys"]
+	if !ok {
+		t.Error("Expected 'keys' attribute in schema")
+	} else {
+		if !keysAttr.IsComputed() {
+			t.Error("Expected 'keys' attribute to be computed")
+		}
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestAPIKeysDataSource_Configure_nilProviderData(t *testing.T) {
+	ds := &APIKeysDataSource{}
+	req := datasource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestAPIKeysDataSource_Configure_wrongType(t *testing.T) {
+	ds := &APIKeysDataSource{}
+	req := datasource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestAPIKeysDataSource_Configure_success(t *testing.T) {
+	ds := &APIKeysDataSource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := datasource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if ds.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewAPIKeysDataSource(t *testing.T) {
+	ds := NewAPIKeysDataSource()
+	if ds == nil {
+		t.Error("Expected data source to be instantiated")
+	}
+
+	_, ok := ds.(*APIKeysDataSource)
+	if !ok {
+		t.Error("Expected data source to be *APIKeysDataSource")
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &AuthHeaderFunction{}
+
+func NewAuthHeaderFunction() function.Function {
+	return &AuthHeaderFunction{}
+}
+
+// AuthHeaderFunction defines the function implementation.
+type AuthHeaderFunction struct{}
+
+func (f *AuthHeaderFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "auth_header"
+}
+
+func (f *AuthHeaderFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build a Jellyfin Authorization header",
+		MarkdownDescription: "Returns a `MediaBrowser` `Authorization` header value in the format the provider uses, " +
+			"e.g. `MediaBrowser Client=\"Terraform\", Device=\"Terraform Provider\", DeviceId=\"terraform-provider-jellyfin\", Version=\"1.0.0\", Token=\"...\"`. " +
+			"Useful for calling the Jellyfin API with the `http` provider or from external tools. " +
+			"Without a token the header only identifies the client, as needed to sign in.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "client",
+				MarkdownDescription: "The name of the client (e.g., `Terraform`).",
+			},
+			function.StringParameter{
+				Name:                "device",
+				MarkdownDescription: "The name of the device (e.g., `Terraform Provider`).",
+			},
+			function.StringParameter{
+				Name:                "device_id",
+				MarkdownDescription: "A unique identifier for the device. Jellyfin ties sessions to it.",
+			},
+			function.StringParameter{
+				Name:                "version",
+				MarkdownDescription: "The version of the client (e.g., `1.0.0`).",
+			},
+			function.StringParameter{
+				Name:                "token",
+				AllowNullValue:      true,
+				MarkdownDescription: "An API key or session token to include, or `null` or an empty string to leave it out.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *AuthHeaderFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var clientName, device, deviceID, version string
+	var token *string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &clientName, &device, &deviceID, &version, &token))
+
+	if resp.Error != nil {
+		return
+	}
+
+	accessToken := ""
+	if token != nil {
+		accessToken = *token
+	}
+
+	header := client.FormatAuthorization(clientName, device, deviceID, version, accessToken)
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, header))
+}
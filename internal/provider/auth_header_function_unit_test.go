@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAuthHeaderFunction_Metadata(t *testing.T) {
+	f := &AuthHeaderFunction{}
+	resp := &function.MetadataResponse{}
+
+	f.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "auth_header" {
+		t.Errorf("Expected Name 'auth_header', got %q", resp.Name)
+	}
+}
+
+func TestAuthHeaderFunction_Definition(t *testing.T) {
+	f := &AuthHeaderFunction{}
+	resp := &function.DefinitionResponse{}
+
+	f.Definition(context.Background(), function.DefinitionRequest{}, resp)
+
+	if len(resp.Definition.Parameters) != 5 {
+		t.Errorf("Expected 5 parameters, got %d", len(resp.Definition.Parameters))
+	}
+
+	if resp.Definition.Return == nil {
+		t.Error("Expected a return definition")
+	}
+}
+
+func TestAuthHeaderFunction_Run(t *testing.T) {
+	testCases := []struct {
+		name     string
+		token    types.String
+		expected string
+	}{
+		{
+			name:     "with token",
+			token:    types.StringValue("abc123"),
+			expected: `MediaBrowser Client="Terraform", Device="CI", DeviceId="ci-1", Version="1.2.3", Token="abc123"`,
+		},
+		{
+			name:     "null token",
+			token:    types.StringNull(),
+			expected: `MediaBrowser Client="Terraform", Device="CI", DeviceId="ci-1", Version="1.2.3"`,
+		},
+		{
+			name:     "empty token",
+			token:    types.StringValue(""),
+			expected: `MediaBrowser Client="Terraform", Device="CI", DeviceId="ci-1", Version="1.2.3"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.StringValue("Terraform"),
+					types.StringValue("CI"),
+					types.StringValue("ci-1"),
+					types.StringValue("1.2.3"),
+					tc.token,
+				}),
+			}
+			resp := &function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+			}
+
+			(&AuthHeaderFunction{}).Run(ctx, req, resp)
+
+			if resp.Error != nil {
+				t.Fatalf("Unexpected error: %s", resp.Error)
+			}
+
+			if !resp.Result.Value().Equal(types.StringValue(tc.expected)) {
+				t.Errorf("Expected %s, got %s", tc.expected, resp.Result.Value())
+			}
+		})
+	}
+}
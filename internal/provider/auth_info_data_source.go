@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AuthInfoDataSource{}
+
+func NewAuthInfoDataSource() datasource.DataSource {
+	return &AuthInfoDataSource{}
+}
+
+// AuthInfoDataSource defines the data source implementation.
+type AuthInfoDataSource struct {
+	client *client.Client
+}
+
+// AuthInfoDataSourceModel describes the data source data model.
+type AuthInfoDataSourceModel struct {
+	Method              types.String `tfsdk:"method"`
+	AuthenticatedUserID types.String `tfsdk:"authenticated_user_id"`
+	ServerID            types.String `tfsdk:"server_id"`
+}
+
+func (d *AuthInfoDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_auth_info"
+}
+
+func (d *AuthInfoDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reports how the provider authenticated with the server, so modules can branch on it. " +
+			"No credentials are exposed.",
+
+		Attributes: map[string]schema.Attribute{
+			"method": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: fmt.Sprintf("The authentication method: `%s` when signed in with `username` and `password`, "+
+					"`%s` when signed in with Quick Connect, or `%s` when using `api_key_file`.",
+					client.AuthMethodPassword, client.AuthMethodQuickConnect, client.AuthMethodAPIKey),
+			},
+			"authenticated_user_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the signed-in user. Null for API keys, which aren't tied to a user.",
+			},
+			"server_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the server the provider is connected to.",
+			},
+		},
+	}
+}
+
+func (d *AuthInfoDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AuthInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AuthInfoDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	info := d.client.AuthInfo()
+
+	// Signing in reports the server ID, but an API key client never learns it that way.
+	serverID := info.ServerID
+	if serverID == "" {
+		system, err := d.client.GetSystemInfo(ctx)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read server information: %s", err))
+			return
+		}
+
+		serverID = system.Id
+	}
+
+	data.Method = types.StringValue(info.Method)
+	data.AuthenticatedUserID = optionalString(info.UserID)
+	data.ServerID = optionalString(serverID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
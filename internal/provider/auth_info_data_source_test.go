@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAuthInfoDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuthInfoDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.jellyfin_auth_info.test", "method", "password"),
+					resource.TestCheckResourceAttrPair("data.jellyfin_auth_info.test", "authenticated_user_id", "data.jellyfin_me.test", "id"),
+					resource.TestCheckResourceAttrSet("data.jellyfin_auth_info.test", "server_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAuthInfoDataSource_invalidAuthMethod(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "jellyfin" {
+  auth_method = "oauth"
+}
+
+data "jellyfin_auth_info" "test" {}
+`,
+				ExpectError: regexp.MustCompile("Invalid Authentication Method"),
+			},
+		},
+	})
+}
+
+const testAccAuthInfoDataSourceConfig = `
+data "jellyfin_auth_info" "test" {}
+
+data "jellyfin_me" "test" {}
+`
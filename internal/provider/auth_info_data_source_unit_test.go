@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestAuthInfoDataSource_Metadata(t *testing.T) {
+	ds := &AuthInfoDataSource{}
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_auth_info"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestAuthInfoDataSource_Schema(t *testing.T) {
+	ds := &AuthInfoDataSource{}
+	req := datasource.SchemaRequest{}
+	resp := &datasource.SchemaResponse{}
+
+	ds.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check method attribute
+	methodAttr, ok := resp.Schema.Attributes["method"]
+	if !ok {
+		t.Error("Expected 'method' attribute in schema")
+	} else {
+		if !methodAttr.IsComputed() {
+			t.Error("Expected 'method' attribute to be computed")
+		}
+	}
+
+	// Check authenticated_user_id attribute
+	authenticatedUserIdAttr, ok := resp.Schema.Attributes["authenticated_user_id"]
+	if !ok {
+		t.Error("Expected 'authenticated_user_id' attribute in schema")
+	} else {
+		if !authenticatedUserIdAttr.IsComputed() {
+			t.Error("Expected 'authenticated_user_id' attribute to be computed")
+		}
+	}
+
+	// Check server_id attribute
+	serverIdAttr, ok := resp.Schema.Attributes["server_id"]
+	if !ok {
+		t.Error("Expected 'server_id' attribute in schema")
+	} else {
+		if !serverIdAttr.IsComputed() {
+			t.Error("Expected 'server_id' attribute to be computed")
+		}
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestAuthInfoDataSource_Configure_nilProviderData(t *testing.T) {
+	ds := &AuthInfoDataSource{}
+	req := datasource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestAuthInfoDataSource_Configure_wrongType(t *testing.T) {
+	ds := &AuthInfoDataSource{}
+	req := datasource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestAuthInfoDataSource_Configure_success(t *testing.T) {
+	ds := &AuthInfoDataSource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := datasource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if ds.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewAuthInfoDataSource(t *testing.T) {
+	ds := NewAuthInfoDataSource()
+	if ds == nil {
+		t.Error("Expected data source to be instantiated")
+	}
+
+	_, ok := ds.(*AuthInfoDataSource)
+	if !ok {
+		t.Error("Expected data source to be *AuthInfoDataSource")
+	}
+}
+
+func TestAuthInfoDataSource_Read_quickConnect(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/QuickConnect/Initiate", "/QuickConnect/Connect":
+			_, _ = w.Write([]byte(`{"Authenticated":true,"Secret":"qc-secret","Code":"123456"}`))
+		case "/Users/AuthenticateWithQuickConnect":
+			_, _ = w.Write([]byte(`{"AccessToken":"qc-token","ServerId":"server-1","User":{"Id":"user-1","Name":"alice"}}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+
+	c, _, err := client.NewClientWithQuickConnect(ctx, server.URL, &client.ClientConfig{
+		QuickConnectPollInterval: time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	ds := &AuthInfoDataSource{client: c}
+
+	schemaResp := &datasource.SchemaResponse{}
+	ds.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	values := map[string]tftypes.Value{}
+	for name, attr := range schemaResp.Schema.Attributes {
+		values[name] = tftypes.NewValue(attr.GetType().TerraformType(ctx), nil)
+	}
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+	req := datasource.ReadRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+	}
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}
+
+	ds.Read(ctx, req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	var data AuthInfoDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+
+	// The method must match the provider's auth_method value so modules can compare them.
+	if data.Method.ValueString() != authMethodQuickConnect {
+		t.Errorf("Expected method %q, got %s", authMethodQuickConnect, data.Method)
+	}
+
+	if data.AuthenticatedUserID.ValueString() != "user-1" {
+		t.Errorf("Expected authenticated_user_id user-1, got %s", data.AuthenticatedUserID)
+	}
+
+	if data.ServerID.ValueString() != "server-1" {
+		t.Errorf("Expected server_id server-1, got %s", data.ServerID)
+	}
+}
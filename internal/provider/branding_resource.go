@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// brandingID is the fixed identifier of the singleton branding resource.
+const brandingID = "branding"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BrandingResource{}
+var _ resource.ResourceWithImportState = &BrandingResource{}
+
+func NewBrandingResource() resource.Resource {
+	return &BrandingResource{}
+}
+
+// BrandingResource defines the resource implementation.
+type BrandingResource struct {
+	client *client.Client
+}
+
+// BrandingResourceModel describes the resource data model.
+type BrandingResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	LoginDisclaimer     types.String `tfsdk:"login_disclaimer"`
+	CustomCSS           types.String `tfsdk:"custom_css"`
+	SplashscreenEnabled types.Bool   `tfsdk:"splashscreen_enabled"`
+}
+
+func (r *BrandingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_branding"
+}
+
+func (r *BrandingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the server's branding: the login disclaimer, custom CSS and splash screen. " +
+			"Other branding options are preserved. " +
+			"There is one branding configuration per server, and destroying this resource clears the disclaimer and CSS and disables the splash screen.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the branding. Always `" + brandingID + "`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"login_disclaimer": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "The message shown at the bottom of the login page. Markdown and HTML are supported. Defaults to empty.",
+			},
+			"custom_css": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "CSS applied to the web client for every user. Defaults to empty.",
+			},
+			"splashscreen_enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether clients show the server's splash screen while loading. Defaults to `false`.",
+			},
+		},
+	}
+}
+
+func (r *BrandingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *BrandingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BrandingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.apply(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update branding: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "Created branding resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BrandingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BrandingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.GetBrandingConfiguration(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read branding: %s", err))
+		return
+	}
+
+	setBrandingModel(&data, config)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BrandingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data BrandingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.apply(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update branding: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BrandingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The branding configuration can't be deleted, so reset the managed fields instead.
+	_, err := r.client.PatchBrandingConfiguration(ctx, brandingFields(BrandingResourceModel{}))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset branding: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted branding resource")
+}
+
+func (r *BrandingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID != brandingID {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID %q, got: %q", brandingID, req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), brandingID)...)
+}
+
+// apply writes the configured branding to the server and refreshes the model from the result.
+func (r *BrandingResource) apply(ctx context.Context, data *BrandingResourceModel) error {
+	tflog.Debug(ctx, "Updating branding", map[string]interface{}{
+		"splashscreen_enabled": data.SplashscreenEnabled.ValueBool(),
+	})
+
+	config, err := r.client.PatchBrandingConfiguration(ctx, brandingFields(*data))
+	if err != nil {
+		return err
+	}
+
+	setBrandingModel(data, config)
+
+	return nil
+}
+
+// brandingFields returns the branding configuration fields for the model. Null values
+// are written as empty, which is what Delete relies on to reset them.
+func brandingFields(data BrandingResourceModel) map[string]interface{} {
+	return map[string]interface{}{
+		"LoginDisclaimer":     data.LoginDisclaimer.ValueString(),
+		"CustomCss":           data.CustomCSS.ValueString(),
+		"SplashscreenEnabled": data.SplashscreenEnabled.ValueBool(),
+	}
+}
+
+// setBrandingModel copies the managed fields from a branding configuration into the model.
+func setBrandingModel(data *BrandingResourceModel, config client.ServerConfiguration) {
+	data.ID = types.StringValue(brandingID)
+	data.LoginDisclaimer = types.StringValue(config.String("LoginDisclaimer"))
+	data.CustomCSS = types.StringValue(config.String("CustomCss"))
+	data.SplashscreenEnabled = types.BoolValue(config.Bool("SplashscreenEnabled"))
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBrandingResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccBrandingResourceConfig("Authorized users only", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_branding.test", "id", "branding"),
+					resource.TestCheckResourceAttr("jellyfin_branding.test", "login_disclaimer", "Authorized users only"),
+					resource.TestCheckResourceAttr("jellyfin_branding.test", "custom_css", ".skinHeader { background: #101010; }"),
+					resource.TestCheckResourceAttr("jellyfin_branding.test", "splashscreen_enabled", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "jellyfin_branding.test",
+				ImportState:       true,
+				ImportStateId:     "branding",
+				ImportStateVerify: true,
+			},
+			// Update testing
+			{
+				Config: testAccBrandingResourceConfig("Welcome", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_branding.test", "login_disclaimer", "Welcome"),
+					resource.TestCheckResourceAttr("jellyfin_branding.test", "splashscreen_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBrandingResourceConfig(disclaimer string, splashscreen bool) string {
+	return fmt.Sprintf(`
+resource "jellyfin_branding" "test" {
+  login_disclaimer     = %[1]q
+  custom_css           = ".skinHeader { background: #101010; }"
+  splashscreen_enabled = %[2]t
+}
+`, disclaimer, splashscreen)
+}
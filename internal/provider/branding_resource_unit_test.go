@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestBrandingResource_Metadata(t *testing.T) {
+	r := &BrandingResource{}
+	req := resource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &resource.MetadataResponse{}
+
+	r.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_branding"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestBrandingResource_Schema(t *testing.T) {
+	r := &BrandingResource{}
+	req := resource.SchemaRequest{}
+	resp := &resource.SchemaResponse{}
+
+	r.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check id attribute
+	idAttr, ok := resp.Schema.Attributes["id"]
+	if !ok {
+		t.Error("Expected 'id' attribute in schema")
+	} else {
+		if !idAttr.IsComputed() {
+			t.Error("Expected 'id' attribute to be computed")
+		}
+	}
+
+	// Check login_disclaimer attribute
+	loginDisclaimerAttr, ok := resp.Schema.Attributes["login_disclaimer"]
+	if !ok {
+		t.Error("Expected 'login_disclaimer' attribute in schema")
+	} else {
+		if !loginDisclaimerAttr.IsOptional() {
+			t.Error("Expected 'login_disclaimer' attribute to be optional")
+		}
+		if !loginDisclaimerAttr.IsComputed() {
+			t.Error("Expected 'login_disclaimer' attribute to be computed")
+		}
+	}
+
+	// Check custom_css attribute
+	customCssAttr, ok := resp.Schema.Attributes["custom_css"]
+	if !ok {
+		t.Error("Expected 'custom_css' attribute in schema")
+	} else {
+		if !customCssAttr.IsOptional() {
+			t.Error("Expected 'custom_css' attribute to be optional")
+		}
+		if !customCssAttr.IsComputed() {
+			t.Error("Expected 'custom_css' attribute to be computed")
+		}
+	}
+
+	// Check splashscreen_enabled attribute
+	splashscreenEnabledAttr, ok := resp.Schema.Attributes["splashscreen_enabled"]
+	if !ok {
+		t.Error("Expected 'splashscreen_enabled' attribute in schema")
+	} else {
+		if !splashscreenEnabledAttr.IsOptional() {
+			t.Error("Expected 'splashscreen_enabled' attribute to be optional")
+		}
+		if !splashscreenEnabledAttr.IsComputed() {
+			t.Error("Expected 'splashscreen_enabled' attribute to be computed")
+		}
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestBrandingResource_Configure_nilProviderData(t *testing.T) {
+	r := &BrandingResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestBrandingResource_Configure_wrongType(t *testing.T) {
+	r := &BrandingResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestBrandingResource_Configure_success(t *testing.T) {
+	r := &BrandingResource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := resource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if r.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewBrandingResource(t *testing.T) {
+	r := NewBrandingResource()
+	if r == nil {
+		t.Error("Expected resource to be instantiated")
+	}
+
+	_, ok := r.(*BrandingResource)
+	if !ok {
+		t.Error("Expected resource to be *BrandingResource")
+	}
+}
+
+func TestBrandingFields(t *testing.T) {
+	got := brandingFields(BrandingResourceModel{
+		LoginDisclaimer:     types.StringValue("Authorized users only"),
+		CustomCSS:           types.StringValue("body { color: red; }"),
+		SplashscreenEnabled: types.BoolValue(true),
+	})
+	expected := map[string]interface{}{
+		"LoginDisclaimer":     "Authorized users only",
+		"CustomCss":           "body { color: red; }",
+		"SplashscreenEnabled": true,
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+
+	// An empty model is what Delete writes to reset the branding.
+	got = brandingFields(BrandingResourceModel{})
+	expected = map[string]interface{}{
+		"LoginDisclaimer":     "",
+		"CustomCss":           "",
+		"SplashscreenEnabled": false,
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
+
+func TestSetBrandingModel(t *testing.T) {
+	var config client.ServerConfiguration
+	if err := json.Unmarshal([]byte(`{"LoginDisclaimer":"Hello","CustomCss":".x{}","SplashscreenEnabled":true}`), &config); err != nil {
+		t.Fatalf("Failed to decode configuration: %v", err)
+	}
+
+	var data BrandingResourceModel
+	setBrandingModel(&data, config)
+
+	if data.ID.ValueString() != brandingID {
+		t.Errorf("Expected id %q, got %s", brandingID, data.ID)
+	}
+
+	if data.LoginDisclaimer.ValueString() != "Hello" || data.CustomCSS.ValueString() != ".x{}" {
+		t.Errorf("Expected disclaimer and CSS to be copied, got %s and %s", data.LoginDisclaimer, data.CustomCSS)
+	}
+
+	if !data.SplashscreenEnabled.ValueBool() {
+		t.Error("Expected splashscreen_enabled to be true")
+	}
+}
@@ -0,0 +1,493 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CollectionResource{}
+var _ resource.ResourceWithImportState = &CollectionResource{}
+var _ resource.ResourceWithUpgradeState = &CollectionResource{}
+
+func NewCollectionResource() resource.Resource {
+	return &CollectionResource{}
+}
+
+// CollectionResource defines the resource implementation.
+type CollectionResource struct {
+	client *client.Client
+}
+
+// CollectionResourceModel describes the resource data model.
+type CollectionResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	ItemIDs          types.List   `tfsdk:"item_ids"`
+	PrimaryImageURL  types.String `tfsdk:"primary_image_url"`
+	PrimaryImagePath types.String `tfsdk:"primary_image_path"`
+	ImageTag         types.String `tfsdk:"image_tag"`
+}
+
+// hasPrimaryImage reports whether the model configures a primary image source.
+func (m *CollectionResourceModel) hasPrimaryImage() bool {
+	return !m.PrimaryImageURL.IsNull() || !m.PrimaryImagePath.IsNull()
+}
+
+func (r *CollectionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_collection"
+}
+
+func (r *CollectionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: 1,
+		MarkdownDescription: "Manages a collection (box set) grouping related items, such as the movies of a franchise. " +
+			"A poster can optionally be uploaded from a URL or a local file.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The item ID of the collection.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the collection. Changing this forces a new collection to be created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"item_ids": schema.ListAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				MarkdownDescription: "The IDs of the items in the collection, in display order. If omitted, the members are not managed. " +
+					"Jellyfin orders a collection by its display order setting, which defaults to release date; when this is set, " +
+					"the collection is switched to the `Default` display order, which lists items in the order they were added. " +
+					"Jellyfin has no endpoint to reorder members, so the members from the first out-of-place item onward are " +
+					"removed and added back in the configured order.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+				},
+			},
+			"primary_image_url": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A URL to download the collection's poster from. Conflicts with `primary_image_path`.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("primary_image_path")),
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"primary_image_path": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A local file to upload as the collection's poster. Conflicts with `primary_image_url`.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"image_tag": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: "The server's tag for the current poster, or null if the collection has none. " +
+					"If the poster is replaced or removed outside Terraform, the next plan uploads it again.",
+			},
+		},
+	}
+}
+
+func (r *CollectionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CollectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CollectionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var itemIDs []string
+	if !data.ItemIDs.IsUnknown() {
+		resp.Diagnostics.Append(data.ItemIDs.ElementsAs(ctx, &itemIDs, false)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := r.client.CreateCollection(ctx, data.Name.ValueString(), itemIDs)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create collection: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(id)
+
+	// Save the ID right away so a failed upload doesn't orphan the collection.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+
+	if !data.ItemIDs.IsUnknown() {
+		if err := r.client.SetCollectionDisplayOrder(ctx, id, client.CollectionDisplayOrderDefault); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set collection display order: %s", err))
+			return
+		}
+	}
+
+	if data.hasPrimaryImage() {
+		resp.Diagnostics.Append(r.uploadPrimaryImage(ctx, &data)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.refreshExisting(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Created collection resource", map[string]interface{}{
+		"id": id,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CollectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CollectionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	previousTag := data.ImageTag
+
+	found, diags := r.refresh(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !found {
+		tflog.Debug(ctx, "Collection not found, removing from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// If the poster was replaced or removed outside Terraform, forget its source so
+	// the next plan uploads it again.
+	if data.hasPrimaryImage() && !data.ImageTag.Equal(previousTag) {
+		tflog.Debug(ctx, "Collection poster changed outside Terraform", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		data.PrimaryImageURL = types.StringNull()
+		data.PrimaryImagePath = types.StringNull()
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CollectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state CollectionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	plan.ID = state.ID
+
+	if !plan.ItemIDs.IsUnknown() && !plan.ItemIDs.Equal(state.ItemIDs) {
+		var planned, current []string
+		resp.Diagnostics.Append(plan.ItemIDs.ElementsAs(ctx, &planned, false)...)
+		resp.Diagnostics.Append(state.ItemIDs.ElementsAs(ctx, &current, false)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if err := r.client.SetCollectionDisplayOrder(ctx, id, client.CollectionDisplayOrderDefault); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set collection display order: %s", err))
+			return
+		}
+
+		removed, added := reorderCollection(current, planned)
+
+		if err := r.client.RemoveCollectionItems(ctx, id, removed); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove items from collection: %s", err))
+			return
+		}
+
+		if err := r.client.AddCollectionItems(ctx, id, added); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add items to collection: %s", err))
+			return
+		}
+	}
+
+	if !plan.PrimaryImageURL.Equal(state.PrimaryImageURL) || !plan.PrimaryImagePath.Equal(state.PrimaryImagePath) {
+		if plan.hasPrimaryImage() {
+			resp.Diagnostics.Append(r.uploadPrimaryImage(ctx, &plan)...)
+		} else if err := r.client.DeleteItemImage(ctx, id, client.ImageTypePrimary); err != nil && !client.IsNotFound(err) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove collection poster: %s", err))
+		}
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.refreshExisting(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *CollectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CollectionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteItem(ctx, data.ID.ValueString())
+
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete collection: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted collection resource")
+}
+
+func (r *CollectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *CollectionResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 stored item_ids as an unordered set.
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":                 schema.StringAttribute{Computed: true},
+					"name":               schema.StringAttribute{Required: true},
+					"item_ids":           schema.SetAttribute{Optional: true, Computed: true, ElementType: types.StringType},
+					"primary_image_url":  schema.StringAttribute{Optional: true},
+					"primary_image_path": schema.StringAttribute{Optional: true},
+					"image_tag":          schema.StringAttribute{Computed: true},
+				},
+			},
+			StateUpgrader: upgradeCollectionStateV0,
+		},
+	}
+}
+
+// collectionResourceModelV0 describes the version 0 resource data model.
+type collectionResourceModelV0 struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	ItemIDs          types.Set    `tfsdk:"item_ids"`
+	PrimaryImageURL  types.String `tfsdk:"primary_image_url"`
+	PrimaryImagePath types.String `tfsdk:"primary_image_path"`
+	ImageTag         types.String `tfsdk:"image_tag"`
+}
+
+// upgradeCollectionStateV0 converts item_ids from a set to a list. The set's order is
+// arbitrary, so the next refresh replaces it with the collection's actual order.
+func upgradeCollectionStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior collectionResourceModelV0
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	itemIDs := types.ListNull(types.StringType)
+	if !prior.ItemIDs.IsNull() {
+		var diags diag.Diagnostics
+		itemIDs, diags = types.ListValue(types.StringType, prior.ItemIDs.Elements())
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, CollectionResourceModel{
+		ID:               prior.ID,
+		Name:             prior.Name,
+		ItemIDs:          itemIDs,
+		PrimaryImageURL:  prior.PrimaryImageURL,
+		PrimaryImagePath: prior.PrimaryImagePath,
+		ImageTag:         prior.ImageTag,
+	})...)
+}
+
+// reorderCollection returns the members to remove from a collection holding current,
+// and the members to then add, so that it holds planned in order. Members are only
+// ever appended, so everything from the first out-of-place member onward is removed
+// and added back.
+func reorderCollection(current, planned []string) (remove, add []string) {
+	wanted := make(map[string]bool, len(planned))
+	for _, id := range planned {
+		wanted[id] = true
+	}
+
+	var kept []string
+	for _, id := range current {
+		if wanted[id] {
+			kept = append(kept, id)
+		} else {
+			remove = append(remove, id)
+		}
+	}
+
+	prefix := 0
+	for prefix < len(kept) && prefix < len(planned) && kept[prefix] == planned[prefix] {
+		prefix++
+	}
+
+	remove = append(remove, kept[prefix:]...)
+	add = append(add, planned[prefix:]...)
+
+	return remove, add
+}
+
+// uploadPrimaryImage reads the poster from the configured URL or file and uploads it.
+func (r *CollectionResource) uploadPrimaryImage(ctx context.Context, data *CollectionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var image []byte
+	var err error
+
+	if !data.PrimaryImageURL.IsNull() {
+		image, err = r.client.DownloadImage(ctx, data.PrimaryImageURL.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("primary_image_url"), "Image Download Error", fmt.Sprintf("Unable to download collection poster: %s", err))
+			return diags
+		}
+	} else {
+		image, err = os.ReadFile(data.PrimaryImagePath.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("primary_image_path"), "Image Read Error", fmt.Sprintf("Unable to read collection poster: %s", err))
+			return diags
+		}
+	}
+
+	tflog.Debug(ctx, "Uploading collection poster", map[string]interface{}{
+		"id":    data.ID.ValueString(),
+		"bytes": len(image),
+	})
+
+	if err := r.client.UploadItemImage(ctx, data.ID.ValueString(), client.ImageTypePrimary, image); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to upload collection poster: %s", err))
+	}
+
+	return diags
+}
+
+// refresh reads the collection's name, members and poster tag from the server. It
+// reports false if the collection no longer exists.
+func (r *CollectionResource) refresh(ctx context.Context, data *CollectionResourceModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	item, err := r.client.GetItem(ctx, data.ID.ValueString())
+	if client.IsNotFound(err) {
+		return false, diags
+	}
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read collection: %s", err))
+		return false, diags
+	}
+
+	itemIDs, err := r.client.GetCollectionItemIDs(ctx, data.ID.ValueString())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list collection items: %s", err))
+		return false, diags
+	}
+
+	itemList, listDiags := types.ListValueFrom(ctx, types.StringType, itemIDs)
+	diags.Append(listDiags...)
+
+	if diags.HasError() {
+		return false, diags
+	}
+
+	data.Name = types.StringValue(item.Name)
+	data.ItemIDs = itemList
+	data.ImageTag = types.StringNull()
+
+	if tag, ok := item.ImageTags[client.ImageTypePrimary]; ok && tag != "" {
+		data.ImageTag = types.StringValue(tag)
+	}
+
+	return true, diags
+}
+
+// refreshExisting is refresh for a collection that was just written, where a missing
+// collection is an error.
+func (r *CollectionResource) refreshExisting(ctx context.Context, data *CollectionResourceModel) diag.Diagnostics {
+	found, diags := r.refresh(ctx, data)
+
+	if !found && !diags.HasError() {
+		diags.AddError("Client Error", fmt.Sprintf("Collection %s not found after writing it", data.ID.ValueString()))
+	}
+
+	return diags
+}
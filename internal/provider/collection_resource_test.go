@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCollectionResource(t *testing.T) {
+	itemID := os.Getenv("JELLYFIN_TEST_ITEM_ID")
+	posterPath := testAccWritePoster(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t); testAccPreCheckItem(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCollectionResourceConfig(fmt.Sprintf("item_ids = [%q]", itemID)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("jellyfin_collection.test", "id"),
+					resource.TestCheckResourceAttr("jellyfin_collection.test", "name", "Terraform Acceptance Collection"),
+					resource.TestCheckResourceAttr("jellyfin_collection.test", "item_ids.#", "1"),
+					resource.TestCheckResourceAttr("jellyfin_collection.test", "item_ids.0", itemID),
+					resource.TestCheckNoResourceAttr("jellyfin_collection.test", "image_tag"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "jellyfin_collection.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update testing: upload a poster
+			{
+				Config: testAccCollectionResourceConfig(fmt.Sprintf("item_ids = [%q]\n  primary_image_path = %q", itemID, posterPath)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_collection.test", "primary_image_path", posterPath),
+					resource.TestCheckResourceAttrSet("jellyfin_collection.test", "image_tag"),
+				),
+			},
+			// Update testing: remove the members and the poster
+			{
+				Config: testAccCollectionResourceConfig("item_ids = []"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_collection.test", "item_ids.#", "0"),
+					resource.TestCheckNoResourceAttr("jellyfin_collection.test", "image_tag"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCollectionResource_reorder(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t); testAccPreCheckLibrary(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCollectionResourceMembersConfig("0", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_collection.test", "item_ids.#", "2"),
+					resource.TestCheckResourceAttrPair("jellyfin_collection.test", "item_ids.0", "data.jellyfin_items.members", "items.0.id"),
+					resource.TestCheckResourceAttrPair("jellyfin_collection.test", "item_ids.1", "data.jellyfin_items.members", "items.1.id"),
+				),
+			},
+			// Reverse the existing members
+			{
+				Config: testAccCollectionResourceMembersConfig("1", "0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_collection.test", "item_ids.#", "2"),
+					resource.TestCheckResourceAttrPair("jellyfin_collection.test", "item_ids.0", "data.jellyfin_items.members", "items.1.id"),
+					resource.TestCheckResourceAttrPair("jellyfin_collection.test", "item_ids.1", "data.jellyfin_items.members", "items.0.id"),
+				),
+			},
+		},
+	})
+}
+
+// testAccWritePoster writes a small PNG image to a temporary file and returns its path.
+func testAccWritePoster(t *testing.T) string {
+	t.Helper()
+
+	img := image.NewRGBA(image.Rect(0, 0, 2, 3))
+	for x := 0; x < 2; x++ {
+		for y := 0; y < 3; y++ {
+			img.Set(x, y, color.RGBA{R: 200, A: 255})
+		}
+	}
+
+	posterPath := filepath.Join(t.TempDir(), "poster.png")
+	file, err := os.Create(posterPath)
+	if err != nil {
+		t.Fatalf("Failed to create poster: %v", err)
+	}
+	defer file.Close()
+
+	if err := png.Encode(file, img); err != nil {
+		t.Fatalf("Failed to encode poster: %v", err)
+	}
+
+	return posterPath
+}
+
+func testAccCollectionResourceConfig(attributes string) string {
+	return fmt.Sprintf(`
+resource "jellyfin_collection" "test" {
+  name = "Terraform Acceptance Collection"
+  %[1]s
+}
+`, attributes)
+}
+
+// testAccCollectionResourceMembersConfig builds a collection of two movies from the
+// server, listed in the given order of their indexes.
+func testAccCollectionResourceMembersConfig(first, second string) string {
+	return fmt.Sprintf(`
+data "jellyfin_items" "members" {
+  include_item_types = ["Movie"]
+  recursive          = true
+  max_items          = 2
+}
+
+resource "jellyfin_collection" "test" {
+  name = "Terraform Acceptance Ordered Collection"
+  item_ids = [
+    data.jellyfin_items.members.items[%[1]s].id,
+    data.jellyfin_items.members.items[%[2]s].id,
+  ]
+}
+`, first, second)
+}
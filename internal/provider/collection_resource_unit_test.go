@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestCollectionResource_Metadata(t *testing.T) {
+	r := &CollectionResource{}
+	req := resource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &resource.MetadataResponse{}
+
+	r.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_collection"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestCollectionResource_Schema(t *testing.T) {
+	r := &CollectionResource{}
+	req := resource.SchemaRequest{}
+	resp := &resource.SchemaResponse{}
+
+	r.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check id attribute
+	idAttr, ok := resp.Schema.Attributes["id"]
+	if !ok {
+		t.Error("Expected 'id' attribute in schema")
+	} else {
+		if !idAttr.IsComputed() {
+			t.Error("Expected 'id' attribute to be computed")
+		}
+	}
+
+	// Check name attribute
+	nameAttr, ok := resp.Schema.Attributes["name"]
+	if !ok {
+		t.Error("Expected 'name' attribute in schema")
+	} else {
+		if !nameAttr.IsRequired() {
+			t.Error("Expected 'name' attribute to be required")
+		}
+	}
+
+	// Check item_ids attribute
+	itemIdsAttr, ok := resp.Schema.Attributes["item_ids"]
+	if !ok {
+		t.Error("Expected 'item_ids' attribute in schema")
+	} else {
+		if !itemIdsAttr.IsOptional() {
+			t.Error("Expected 'item_ids' attribute to be optional")
+		}
+		if !itemIdsAttr.IsComputed() {
+			t.Error("Expected 'item_ids' attribute to be computed")
+		}
+		if _, ok := itemIdsAttr.(schema.ListAttribute); !ok {
+			t.Errorf("Expected 'item_ids' attribute to be an ordered list, got %T", itemIdsAttr)
+		}
+	}
+
+	// item_ids changed from a set to a list in version 1
+	if resp.Schema.Version != 1 {
+		t.Errorf("Expected schema version 1, got %d", resp.Schema.Version)
+	}
+
+	// Check primary_image_url attribute
+	primaryImageUrlAttr, ok := resp.Schema.Attributes["primary_image_url"]
+	if !ok {
+		t.Error("Expected 'primary_image_url' attribute in schema")
+	} else {
+		if !primaryImageUrlAttr.IsOptional() {
+			t.Error("Expected 'primary_image_url' attribute to be optional")
+		}
+	}
+
+	// Check primary_image_path attribute
+	primaryImagePathAttr, ok := resp.Schema.Attributes["primary_image_path"]
+	if !ok {
+		t.Error("Expected 'primary_image_path' attribute in schema")
+	} else {
+		if !primaryImagePathAttr.IsOptional() {
+			t.Error("Expected 'primary_image_path' attribute to be optional")
+		}
+	}
+
+	// Check image_tag attribute
+	imageTagAttr, ok := resp.Schema.Attributes["image_tag"]
+	if !ok {
+		t.Error("Expected 'image_tag' attribute in schema")
+	} else {
+		if !imageTagAttr.IsComputed() {
+			t.Error("Expected 'image_tag' attribute to be computed")
+		}
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestCollectionResource_Configure_nilProviderData(t *testing.T) {
+	r := &CollectionResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestCollectionResource_Configure_wrongType(t *testing.T) {
+	r := &CollectionResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestCollectionResource_Configure_success(t *testing.T) {
+	r := &CollectionResource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := resource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if r.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewCollectionResource(t *testing.T) {
+	r := NewCollectionResource()
+	if r == nil {
+		t.Error("Expected resource to be instantiated")
+	}
+
+	_, ok := r.(*CollectionResource)
+	if !ok {
+		t.Error("Expected resource to be *CollectionResource")
+	}
+}
+
+func TestReorderCollection_reorderExistingMembers(t *testing.T) {
+	remove, add := reorderCollection([]string{"a", "b", "c", "d"}, []string{"a", "c", "b", "d"})
+
+	if !slices.Equal(remove, []string{"b", "c", "d"}) {
+		t.Errorf("Expected remove [b c d], got %v", remove)
+	}
+
+	if !slices.Equal(add, []string{"c", "b", "d"}) {
+		t.Errorf("Expected add [c b d], got %v", add)
+	}
+}
+
+func TestReorderCollection_membershipAndOrder(t *testing.T) {
+	remove, add := reorderCollection([]string{"a", "b", "c"}, []string{"a", "c", "e", "b"})
+
+	if !slices.Equal(remove, []string{"b", "c"}) {
+		t.Errorf("Expected remove [b c], got %v", remove)
+	}
+
+	if !slices.Equal(add, []string{"c", "e", "b"}) {
+		t.Errorf("Expected add [c e b], got %v", add)
+	}
+
+	remove, add = reorderCollection([]string{"a", "b", "c"}, []string{"a", "c"})
+
+	if !slices.Equal(remove, []string{"b"}) || len(add) != 0 {
+		t.Errorf("Expected remove [b] and nothing added, got remove %v, add %v", remove, add)
+	}
+
+	remove, add = reorderCollection([]string{"a", "b"}, []string{"a", "b", "c"})
+
+	if len(remove) != 0 || !slices.Equal(add, []string{"c"}) {
+		t.Errorf("Expected nothing removed and add [c], got remove %v, add %v", remove, add)
+	}
+}
+
+func TestReorderCollection_unchangedAndEmpty(t *testing.T) {
+	remove, add := reorderCollection([]string{"a", "b"}, []string{"a", "b"})
+
+	if len(remove) != 0 || len(add) != 0 {
+		t.Errorf("Expected no changes, got remove %v, add %v", remove, add)
+	}
+
+	remove, add = reorderCollection([]string{"a", "b"}, nil)
+
+	if !slices.Equal(remove, []string{"a", "b"}) || len(add) != 0 {
+		t.Errorf("Expected remove [a b] and nothing added, got remove %v, add %v", remove, add)
+	}
+
+	remove, add = reorderCollection(nil, []string{"a", "b"})
+
+	if len(remove) != 0 || !slices.Equal(add, []string{"a", "b"}) {
+		t.Errorf("Expected nothing removed and add [a b], got remove %v, add %v", remove, add)
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// collectionTypeRelease lists the library collection types accepted from a server version on.
+type collectionTypeRelease struct {
+	minVersion string
+	types      []string
+}
+
+// collectionTypeReleases mirrors Jellyfin's CollectionTypeOptions enum, which the server
+// doesn't expose through the API. Entries are ordered by version, newest last.
+var collectionTypeReleases = []collectionTypeRelease{
+	{
+		minVersion: "10.0.0",
+		types:      []string{"movies", "tvshows", "music", "musicvideos", "homevideos", "boxsets", "books", "mixed"},
+	},
+}
+
+// collectionTypesForVersion returns the collection types accepted by a server version.
+// Versions older than every release, or unparseable ones, get the oldest list.
+func collectionTypesForVersion(version string) []string {
+	accepted := collectionTypeReleases[0].types
+	for _, release := range collectionTypeReleases {
+		if client.CompareVersions(version, release.minVersion) >= 0 {
+			accepted = release.types
+		}
+	}
+	return accepted
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CollectionTypesDataSource{}
+
+func NewCollectionTypesDataSource() datasource.DataSource {
+	return &CollectionTypesDataSource{}
+}
+
+// CollectionTypesDataSource defines the data source implementation.
+type CollectionTypesDataSource struct {
+	client *client.Client
+}
+
+// CollectionTypesDataSourceModel describes the data source data model.
+type CollectionTypesDataSourceModel struct {
+	ServerVersion   types.String `tfsdk:"server_version"`
+	CollectionTypes types.List   `tfsdk:"collection_types"`
+}
+
+func (d *CollectionTypesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_collection_types"
+}
+
+func (d *CollectionTypesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the library content types (`collection_type` values) the connected server accepts. " +
+			"Jellyfin doesn't expose these through its API, so they come from a list of the server's " +
+			"`CollectionTypeOptions` values kept in the provider, selected by server version.",
+
+		Attributes: map[string]schema.Attribute{
+			"server_version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The version of the connected server the list was selected for.",
+			},
+			"collection_types": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The accepted collection types (e.g., `movies`, `tvshows`, `music`).",
+			},
+		},
+	}
+}
+
+func (d *CollectionTypesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CollectionTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CollectionTypesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	info, err := d.client.GetSystemInfo(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read server information: %s", err))
+		return
+	}
+
+	collectionTypes, diags := types.ListValueFrom(ctx, types.StringType, collectionTypesForVersion(info.Version))
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ServerVersion = types.StringValue(info.Version)
+	data.CollectionTypes = collectionTypes
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCollectionTypesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCollectionTypesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.jellyfin_collection_types.test", "server_version"),
+					resource.TestCheckTypeSetElemAttr("data.jellyfin_collection_types.test", "collection_types.*", "movies"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCollectionTypesDataSourceConfig = `
+data "jellyfin_collection_types" "test" {}
+`
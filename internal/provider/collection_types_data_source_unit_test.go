@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestCollectionTypesDataSource_Metadata(t *testing.T) {
+	ds := &CollectionTypesDataSource{}
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_collection_types"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestCollectionTypesDataSource_Schema(t *testing.T) {
+	ds := &CollectionTypesDataSource{}
+	req := datasource.SchemaRequest{}
+	resp := &datasource.SchemaResponse{}
+
+	ds.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check server_version attribute
+	serverVersionAttr, ok := resp.Schema.Attributes["server_version"]
+	if !ok {
+		t.Error("Expected 'server_version' attribute in schema")
+	} else {
+		if !serverVersionAttr.IsComputed() {
+			t.Error("Expected 'server_version' attribute to be computed")
+		}
+	}
+
+	// Check collection_types attribute
+	collectionTypesAttr, ok := resp.Schema.Attributes["collection_types"]
+	if !ok {
+		t.Error("Expected 'collection_types' attribute in schema")
+	} else {
+		if !collectionTypesAttr.IsComputed() {
+			t.Error("Expected 'collection_types' attribute to be computed")
+		}
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestCollectionTypesDataSource_Configure_nilProviderData(t *testing.T) {
+	ds := &CollectionTypesDataSource{}
+	req := datasource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestCollectionTypesDataSource_Configure_wrongType(t *testing.T) {
+	ds := &CollectionTypesDataSource{}
+	req := datasource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestCollectionTypesDataSource_Configure_success(t *testing.T) {
+	ds := &CollectionTypesDataSource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := datasource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if ds.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewCollectionTypesDataSource(t *testing.T) {
+	ds := NewCollectionTypesDataSource()
+	if ds == nil {
+		t.Error("Expected data source to be instantiated")
+	}
+
+	_, ok := ds.(*CollectionTypesDataSource)
+	if !ok {
+		t.Error("Expected data source to be *CollectionTypesDataSource")
+	}
+}
+
+func TestCollectionTypesForVersion(t *testing.T) {
+	for _, version := range []string{"10.9.11", "10.8.13", "1.0.0", "unknown"} {
+		accepted := collectionTypesForVersion(version)
+
+		if len(accepted) == 0 || accepted[0] != "movies" {
+			t.Errorf("Expected collection types for %q to start with movies, got %v", version, accepted)
+		}
+	}
+}
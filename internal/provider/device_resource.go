@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DeviceResource{}
+var _ resource.ResourceWithImportState = &DeviceResource{}
+
+func NewDeviceResource() resource.Resource {
+	return &DeviceResource{}
+}
+
+// DeviceResource defines the resource implementation.
+type DeviceResource struct {
+	client *client.Client
+}
+
+// DeviceResourceModel describes the resource data model.
+type DeviceResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	DeviceID         types.String `tfsdk:"device_id"`
+	Name             types.String `tfsdk:"name"`
+	AppName          types.String `tfsdk:"app_name"`
+	LastUserName     types.String `tfsdk:"last_user_name"`
+	DateLastActivity types.String `tfsdk:"date_last_activity"`
+}
+
+func (r *DeviceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device"
+}
+
+func (r *DeviceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a device registered with the Jellyfin server. Devices register themselves when a client logs in, " +
+			"so creating this resource only adopts an existing device. " +
+			"Destroying it deregisters the device, which signs it out and revokes its access token.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of this resource. Same as `device_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"device_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the device.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the device.",
+			},
+			"app_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the client application on the device.",
+			},
+			"last_user_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the user who last used the device.",
+			},
+			"date_last_activity": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The date and time of the device's last activity.",
+			},
+		},
+	}
+}
+
+func (r *DeviceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DeviceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DeviceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	device, err := r.client.GetDevice(ctx, data.DeviceID.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read device: %s", err))
+		return
+	}
+
+	setDeviceModel(&data, device)
+
+	tflog.Trace(ctx, "Adopted device", map[string]interface{}{
+		"device_id": device.Id,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeviceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DeviceResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	device, err := r.client.GetDevice(ctx, data.DeviceID.ValueString())
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read device: %s", err))
+		return
+	}
+
+	setDeviceModel(&data, device)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeviceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// device_id forces replacement and everything else is computed, so Update is never called with real changes.
+	var data DeviceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeviceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DeviceResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDevice(ctx, data.DeviceID.ValueString())
+
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete device: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted device", map[string]interface{}{
+		"device_id": data.DeviceID.ValueString(),
+	})
+}
+
+func (r *DeviceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("device_id"), req.ID)...)
+}
+
+// setDeviceModel copies the device's details into the resource model.
+func setDeviceModel(data *DeviceResourceModel, device *client.Device) {
+	data.ID = types.StringValue(device.Id)
+	data.DeviceID = types.StringValue(device.Id)
+	data.Name = types.StringValue(device.Name)
+	data.AppName = types.StringValue(device.AppName)
+	data.LastUserName = types.StringValue(device.LastUserName)
+	data.DateLastActivity = types.StringValue(device.DateLastActivity)
+}
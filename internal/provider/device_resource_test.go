@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDeviceResource_missingDevice(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Only devices that already exist can be adopted.
+			{
+				Config: `
+resource "jellyfin_device" "test" {
+  device_id = "tf-acc-missing-device"
+}
+`,
+				ExpectError: regexp.MustCompile("Unable to read device"),
+			},
+		},
+	})
+}
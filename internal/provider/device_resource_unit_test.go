@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestDeviceResource_Metadata(t *testing.T) {
+	r := &DeviceResource{}
+	req := resource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &resource.MetadataResponse{}
+
+	r.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_device"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestDeviceResource_Schema(t *testing.T) {
+	r := &DeviceResource{}
+	req := resource.SchemaRequest{}
+	resp := &resource.SchemaResponse{}
+
+	r.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check id attribute
+	idAttr, ok := resp.Schema.Attributes["id"]
+	if !ok {
+		t.Error("Expected 'id' attribute in schema")
+	} else {
+		if !idAttr.IsComputed() {
+			t.Error("Expected 'id' attribute to be computed")
+		}
+	}
+
+	// Check device_id attribute
+	deviceIdAttr, ok := resp.Schema.Attributes["device_id"]
+	if !ok {
+		t.Error("Expected 'device_id' attribute in schema")
+	} else {
+		if !deviceIdAttr.IsRequired() {
+			t.Error("Expected 'device_id' attribute to be required")
+		}
+	}
+
+	// Check name attribute
+	nameAttr, ok := resp.Schema.Attributes["name"]
+	if !ok {
+		t.Error("Expected 'name' attribute in schema")
+	} else {
+		if !nameAttr.IsComputed() {
+			t.Error("Expected 'name' attribute to be computed")
+		}
+	}
+
+	// Check app_name attribute
+	appNameAttr, ok := resp.Schema.Attributes["app_name"]
+	if !ok {
+		t.Error("Expected 'app_name' attribute in schema")
+	} else {
+		if !appNameAttr.IsComputed() {
+			t.Error("Expected 'app_name' attribute to be computed")
+		}
+	}
+
+	// Check last_user_name attribute
+	lastUserNameAttr, ok := resp.Schema.Attributes["last_user_name"]
+	if !ok {
+		t.Error("Expected 'last_user_name' attribute in schema")
+	} else {
+		if !lastUserNameAttr.IsComputed() {
+			t.Error("Expected 'last_user_name' attribute to be computed")
+		}
+	}
+
+	// Check date_last_activity attribute
+	dateLastActivityAttr, ok := resp.Schema.Attributes["date_last_activity"]
+	if !ok {
+		t.Error("Expected 'date_last_activity' attribute in schema")
+	} else {
+		if !dateLastActivityAttr.IsComputed() {
+			t.Error("Expected 'date_last_activity' attribute to be computed")
+		}
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestDeviceResource_Configure_nilProviderData(t *testing.T) {
+	r := &DeviceResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestDeviceResource_Configure_wrongType(t *testing.T) {
+	r := &DeviceResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestDeviceResource_Configure_success(t *testing.T) {
+	r := &DeviceResource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := resource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if r.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewDeviceResource(t *testing.T) {
+	r := NewDeviceResource()
+	if r == nil {
+		t.Error("Expected resource to be instantiated")
+	}
+
+	_, ok := r.(*DeviceResource)
+	if !ok {
+		t.Error("Expected resource to be *DeviceResource")
+	}
+}
+
+func TestSetDeviceModel(t *testing.T) {
+	data := DeviceResourceModel{}
+
+	setDeviceModel(&data, &client.Device{
+		Id:               "device-1",
+		Name:             "Firefox",
+		AppName:          "Jellyfin Web",
+		LastUserName:     "alice",
+		DateLastActivity: "2024-06-01T10:00:00.0000000Z",
+	})
+
+	if data.ID.ValueString() != "device-1" || data.DeviceID.ValueString() != "device-1" {
+		t.Errorf("Expected id and device_id device-1, got %s and %s", data.ID, data.DeviceID)
+	}
+
+	if data.Name.ValueString() != "Firefox" || data.AppName.ValueString() != "Jellyfin Web" {
+		t.Errorf("Expected Firefox running Jellyfin Web, got %s running %s", data.Name, data.AppName)
+	}
+
+	if data.LastUserName.ValueString() != "alice" {
+		t.Errorf("Expected last user alice, got %s", data.LastUserName)
+	}
+
+	if data.DateLastActivity.ValueString() != "2024-06-01T10:00:00.0000000Z" {
+		t.Errorf("Expected last activity to be copied, got %s", data.DateLastActivity)
+	}
+}
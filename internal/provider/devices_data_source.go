@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DevicesDataSource{}
+
+func NewDevicesDataSource() datasource.DataSource {
+	return &DevicesDataSource{}
+}
+
+// DevicesDataSource defines the data source implementation.
+type DevicesDataSource struct {
+	client *client.Client
+}
+
+// DevicesDataSourceModel describes the data source data model.
+type DevicesDataSourceModel struct {
+	Devices []DeviceEntryModel `tfsdk:"devices"`
+}
+
+// DeviceEntryModel describes a single device in the list.
+type DeviceEntryModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	AppName          types.String `tfsdk:"app_name"`
+	LastUserName     types.String `tfsdk:"last_user_name"`
+	DateLastActivity types.String `tfsdk:"date_last_activity"`
+}
+
+func (d *DevicesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_devices"
+}
+
+func (d *DevicesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the devices registered with the Jellyfin server.",
+
+		Attributes: map[string]schema.Attribute{
+			"devices": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The registered devices, in the order returned by the server.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the device.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the device.",
+						},
+						"app_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the client application on the device.",
+						},
+						"last_user_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the user who last used the device.",
+						},
+						"date_last_activity": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The date and time of the device's last activity.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DevicesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DevicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DevicesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	devices, err := d.client.GetDevices(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list devices: %s", err))
+		return
+	}
+
+	data.Devices = []DeviceEntryModel{}
+
+	for _, device := range devices {
+		data.Devices = append(data.Devices, DeviceEntryModel{
+			ID:               types.StringValue(device.Id),
+			Name:             types.StringValue(device.Name),
+			AppName:          types.StringValue(device.AppName),
+			LastUserName:     types.StringValue(device.LastUserName),
+			DateLastActivity: types.StringValue(device.DateLastActivity),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestDevicesDataSource_Metadata(t *testing.T) {
+	ds := &DevicesDataSource{}
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_devices"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestDevicesDataSource_Schema(t *testing.T) {
+	ds := &DevicesDataSource{}
+	req := datasource.SchemaRequest{}
+	resp := &datasource.SchemaResponse{}
+
+	ds.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check devices attribute
+	devicesAttr, ok := resp.Schema.Attributes["devices"]
+	if !ok {
+		t.Error("Expected 'devices' attribute in schema")
+	} else {
+		if !devicesAttr.IsComputed() {
+			t.Error("Expected 'devices' attribute to be computed")
+		}
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestDevicesDataSource_Configure_nilProviderData(t *testing.T) {
+	ds := &DevicesDataSource{}
+	req := datasource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestDevicesDataSource_Configure_wrongType(t *testing.T) {
+	ds := &DevicesDataSource{}
+	req := datasource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestDevicesDataSource_Configure_success(t *testing.T) {
+	ds := &DevicesDataSource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := datasource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if ds.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewDevicesDataSource(t *testing.T) {
+	ds := NewDevicesDataSource()
+	if ds == nil {
+		t.Error("Expected data source to be instantiated")
+	}
+
+	_, ok := ds.(*DevicesDataSource)
+	if !ok {
+		t.Error("Expected data source to be *DevicesDataSource")
+	}
+}
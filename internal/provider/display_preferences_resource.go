@@ -0,0 +1,326 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+const (
+	// defaultDisplayPreferencesID is the preferences set the web client keeps user settings in.
+	defaultDisplayPreferencesID = "usersettings"
+
+	// defaultDisplayPreferencesClient is the client name the web client saves preferences under.
+	defaultDisplayPreferencesClient = "emby"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DisplayPreferencesResource{}
+var _ resource.ResourceWithImportState = &DisplayPreferencesResource{}
+
+func NewDisplayPreferencesResource() resource.Resource {
+	return &DisplayPreferencesResource{}
+}
+
+// DisplayPreferencesResource defines the resource implementation.
+type DisplayPreferencesResource struct {
+	client *client.Client
+}
+
+// DisplayPreferencesResourceModel describes the resource data model.
+type DisplayPreferencesResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	UserID               types.String `tfsdk:"user_id"`
+	DisplayPreferencesID types.String `tfsdk:"display_preferences_id"`
+	Client               types.String `tfsdk:"client"`
+	CustomPreferences    types.Map    `tfsdk:"custom_preferences"`
+}
+
+func (r *DisplayPreferencesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_display_preferences"
+}
+
+func (r *DisplayPreferencesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages custom display preferences of a Jellyfin user for one client, such as the web client's home screen sections. " +
+			"Only the keys in `custom_preferences` are managed; the built-in settings and other custom preferences are left as they are. " +
+			"Destroying this resource removes the managed keys.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for this resource, in the form `user_id/display_preferences_id/client`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the user whose preferences are managed.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"display_preferences_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(defaultDisplayPreferencesID),
+				MarkdownDescription: fmt.Sprintf("The ID of the preferences set. Defaults to `%s`, where the web client keeps its user settings; "+
+					"library views use the ID of the library.", defaultDisplayPreferencesID),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"client": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(defaultDisplayPreferencesClient),
+				MarkdownDescription: fmt.Sprintf("The client the preferences belong to. Defaults to `%s`, the name the web client uses.", defaultDisplayPreferencesClient),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"custom_preferences": schema.MapAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The custom preferences to set, such as `homesection0 = \"resume\"`.",
+			},
+		},
+	}
+}
+
+func (r *DisplayPreferencesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DisplayPreferencesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DisplayPreferencesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data, nil)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Created display preferences resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DisplayPreferencesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DisplayPreferencesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prefs, err := r.client.GetDisplayPreferences(ctx, data.DisplayPreferencesID.ValueString(), data.UserID.ValueString(), data.Client.ValueString())
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read display preferences: %s", err))
+		return
+	}
+
+	var prior map[string]string
+	if !data.CustomPreferences.IsNull() {
+		resp.Diagnostics.Append(data.CustomPreferences.ElementsAs(ctx, &prior, false)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	custom, diags := types.MapValueFrom(ctx, types.StringType, customPreferencesState(prior, prefs.CustomPrefs()))
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(displayPreferencesResourceID(data))
+	data.CustomPreferences = custom
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DisplayPreferencesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state DisplayPreferencesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var prior map[string]string
+	resp.Diagnostics.Append(state.CustomPreferences.ElementsAs(ctx, &prior, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data, prior)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DisplayPreferencesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DisplayPreferencesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var managed map[string]string
+	resp.Diagnostics.Append(data.CustomPreferences.ElementsAs(ctx, &managed, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.PatchCustomPrefs(ctx, data.DisplayPreferencesID.ValueString(), data.UserID.ValueString(), data.Client.ValueString(),
+		nil, removedCustomPreferences(managed, nil))
+
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove custom display preferences: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted display preferences resource")
+}
+
+func (r *DisplayPreferencesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in the form 'user_id/display_preferences_id/client', got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("display_preferences_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("client"), parts[2])...)
+}
+
+// apply writes the planned custom preferences, removing keys that were in prior but
+// are no longer configured.
+func (r *DisplayPreferencesResource) apply(ctx context.Context, data *DisplayPreferencesResourceModel, prior map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var custom map[string]string
+	diags.Append(data.CustomPreferences.ElementsAs(ctx, &custom, false)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	tflog.Debug(ctx, "Updating custom display preferences", map[string]interface{}{
+		"user_id":                data.UserID.ValueString(),
+		"display_preferences_id": data.DisplayPreferencesID.ValueString(),
+		"client":                 data.Client.ValueString(),
+	})
+
+	_, err := r.client.PatchCustomPrefs(ctx, data.DisplayPreferencesID.ValueString(), data.UserID.ValueString(), data.Client.ValueString(),
+		custom, removedCustomPreferences(prior, custom))
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update display preferences: %s", err))
+		return diags
+	}
+
+	data.ID = types.StringValue(displayPreferencesResourceID(*data))
+
+	return diags
+}
+
+// displayPreferencesResourceID returns the composite ID of the resource.
+func displayPreferencesResourceID(data DisplayPreferencesResourceModel) string {
+	return data.UserID.ValueString() + "/" + data.DisplayPreferencesID.ValueString() + "/" + data.Client.ValueString()
+}
+
+// removedCustomPreferences returns the keys of prior that aren't in planned, sorted.
+func removedCustomPreferences(prior, planned map[string]string) []string {
+	var removed []string
+	for key := range prior {
+		if _, ok := planned[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+
+	slices.Sort(removed)
+
+	return removed
+}
+
+// customPreferencesState returns the custom preferences to store in state. With prior
+// managed keys, only those still present on the server are kept, so preferences set by
+// clients don't show as drift. Without them, as after import, every key is returned.
+func customPreferencesState(prior, current map[string]string) map[string]string {
+	if prior == nil {
+		return current
+	}
+
+	state := make(map[string]string, len(prior))
+	for key := range prior {
+		if value, ok := current[key]; ok {
+			state[key] = value
+		}
+	}
+
+	return state
+}
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDisplayPreferencesResource(t *testing.T) {
+	userID := os.Getenv("JELLYFIN_TEST_USER_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t); testAccPreCheckUser(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDisplayPreferencesResourceConfig(userID, `{
+    tf_acc_section = "resume"
+    tf_acc_removed = "true"
+  }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_display_preferences.test", "id", userID+"/usersettings/emby"),
+					resource.TestCheckResourceAttr("jellyfin_display_preferences.test", "custom_preferences.%", "2"),
+					resource.TestCheckResourceAttr("jellyfin_display_preferences.test", "custom_preferences.tf_acc_section", "resume"),
+				),
+			},
+			// Update testing, dropping a key
+			{
+				Config: testAccDisplayPreferencesResourceConfig(userID, `{
+    tf_acc_section = "nextup"
+  }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_display_preferences.test", "custom_preferences.%", "1"),
+					resource.TestCheckResourceAttr("jellyfin_display_preferences.test", "custom_preferences.tf_acc_section", "nextup"),
+				),
+			},
+			// ImportState testing; an import picks up every custom preference of the
+			// user, not just the managed ones.
+			{
+				ResourceName:            "jellyfin_display_preferences.test",
+				ImportState:             true,
+				ImportStateId:           userID + "/usersettings/emby",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"custom_preferences"},
+			},
+		},
+	})
+}
+
+func testAccDisplayPreferencesResourceConfig(userID, customPreferences string) string {
+	return fmt.Sprintf(`
+resource "jellyfin_display_preferences" "test" {
+  user_id            = %[1]q
+  custom_preferences = %[2]s
+}
+`, userID, customPreferences)
+}
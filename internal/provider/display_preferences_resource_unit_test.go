@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestDisplayPreferencesResource_Metadata(t *testing.T) {
+	r := &DisplayPreferencesResource{}
+	req := resource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &resource.MetadataResponse{}
+
+	r.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_display_preferences"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestDisplayPreferencesResource_Schema(t *testing.T) {
+	r := &DisplayPreferencesResource{}
+	req := resource.SchemaRequest{}
+	resp := &resource.SchemaResponse{}
+
+	r.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check id attribute
+	idAttr, ok := resp.Schema.Attributes["id"]
+	if !ok {
+		t.Error("Expected 'id' attribute in schema")
+	} else {
+		if !idAttr.IsComputed() {
+			t.Error("Expected 'id' attribute to be computed")
+		}
+	}
+
+	// Check user_id attribute
+	userIdAttr, ok := resp.Schema.Attributes["user_id"]
+	if !ok {
+		t.Error("Expected 'user_id' attribute in schema")
+	} else {
+		if !userIdAttr.IsRequired() {
+			t.Error("Expected 'user_id' attribute to be required")
+		}
+	}
+
+	// Check display_preferences_id attribute
+	displayPreferencesIdAttr, ok := resp.Schema.Attributes["display_preferences_id"]
+	if !ok {
+		t.Error("Expected 'display_preferences_id' attribute in schema")
+	} else {
+		if !displayPreferencesIdAttr.IsOptional() {
+			t.Error("Expected 'display_preferences_id' attribute to be optional")
+		}
+		if !displayPreferencesIdAttr.IsComputed() {
+			t.Error("Expected 'display_preferences_id' attribute to be computed")
+		}
+	}
+
+	// Check client attribute
+	clientAttr, ok := resp.Schema.Attributes["client"]
+	if !ok {
+		t.Error("Expected 'client' attribute in schema")
+	} else {
+		if !clientAttr.IsOptional() {
+			t.Error("Expected 'client' attribute to be optional")
+		}
+		if !clientAttr.IsComputed() {
+			t.Error("Expected 'client' attribute to be computed")
+		}
+	}
+
+	// Check custom_preferences attribute
+	customPreferencesAttr, ok := resp.Schema.Attributes["custom_preferences"]
+	if !ok {
+		t.Error("Expected 'custom_preferences' attribute in schema")
+	} else {
+		if !customPreferencesAttr.IsRequired() {
+			t.Error("Expected 'custom_preferences' attribute to be required")
+		}
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestDisplayPreferencesResource_Configure_nilProviderData(t *testing.T) {
+	r := &DisplayPreferencesResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestDisplayPreferencesResource_Configure_wrongType(t *testing.T) {
+	r := &DisplayPreferencesResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestDisplayPreferencesResource_Configure_success(t *testing.T) {
+	r := &DisplayPreferencesResource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := resource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if r.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewDisplayPreferencesResource(t *testing.T) {
+	r := NewDisplayPreferencesResource()
+	if r == nil {
+		t.Error("Expected resource to be instantiated")
+	}
+
+	_, ok := r.(*DisplayPreferencesResource)
+	if !ok {
+		t.Error("Expected resource to be *DisplayPreferencesResource")
+	}
+}
+
+func TestRemovedCustomPreferences(t *testing.T) {
+	prior := map[string]string{"homesection0": "resume", "homesection1": "nextup", "homesection2": "latestmedia"}
+	planned := map[string]string{"homesection0": "smalllibrarytiles", "homesection3": "none"}
+
+	got := removedCustomPreferences(prior, planned)
+	expected := []string{"homesection1", "homesection2"}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+
+	if got := removedCustomPreferences(nil, planned); len(got) != 0 {
+		t.Errorf("Expected nothing removed on create, got %v", got)
+	}
+
+	if got := removedCustomPreferences(prior, nil); len(got) != 3 {
+		t.Errorf("Expected every key removed on delete, got %v", got)
+	}
+}
+
+func TestCustomPreferencesState(t *testing.T) {
+	current := map[string]string{"homesection0": "resume", "homesection1": "nextup", "chromecastVersion": "stable"}
+
+	testCases := []struct {
+		name     string
+		prior    map[string]string
+		expected map[string]string
+	}{
+		{
+			name:     "import",
+			prior:    nil,
+			expected: current,
+		},
+		{
+			name:     "managed keys only",
+			prior:    map[string]string{"homesection0": "smalllibrarytiles"},
+			expected: map[string]string{"homesection0": "resume"},
+		},
+		{
+			name:     "managed key removed on the server",
+			prior:    map[string]string{"homesection0": "resume", "homesection5": "none"},
+			expected: map[string]string{"homesection0": "resume"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := customPreferencesState(tc.prior, current)
+
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestDisplayPreferencesResourceID(t *testing.T) {
+	got := displayPreferencesResourceID(DisplayPreferencesResourceModel{
+		UserID:               types.StringValue("user-1"),
+		DisplayPreferencesID: types.StringValue("usersettings"),
+		Client:               types.StringValue("emby"),
+	})
+
+	if got != "user-1/usersettings/emby" {
+		t.Errorf("Expected user-1/usersettings/emby, got %s", got)
+	}
+}
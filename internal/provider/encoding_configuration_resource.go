@@ -0,0 +1,258 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// encodingConfigurationID is the fixed identifier of the singleton encoding configuration resource.
+const encodingConfigurationID = "encoding_configuration"
+
+// encodingConfigurationKey is the configuration section holding the transcoding settings.
+const encodingConfigurationKey = "encoding"
+
+// Encoding configuration fields managed by the resource.
+const (
+	hardwareAccelerationField = "HardwareAccelerationType"
+	hardwareEncodingField     = "EnableHardwareEncoding"
+	transcodingTempPathField  = "TranscodingTempPath"
+	encoderPresetField        = "EncoderPreset"
+)
+
+// hardwareAccelerationTypes returns the hardware acceleration methods Jellyfin supports.
+func hardwareAccelerationTypes() []string {
+	return []string{"none", "amf", "qsv", "nvenc", "v4l2m2m", "vaapi", "videotoolbox", "rkmpp"}
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &EncodingConfigurationResource{}
+var _ resource.ResourceWithImportState = &EncodingConfigurationResource{}
+
+func NewEncodingConfigurationResource() resource.Resource {
+	return &EncodingConfigurationResource{}
+}
+
+// EncodingConfigurationResource defines the resource implementation.
+type EncodingConfigurationResource struct {
+	client *client.Client
+}
+
+// EncodingConfigurationResourceModel describes the resource data model.
+type EncodingConfigurationResourceModel struct {
+	ID                       types.String `tfsdk:"id"`
+	HardwareAccelerationType types.String `tfsdk:"hardware_acceleration_type"`
+	EnableHardwareEncoding   types.Bool   `tfsdk:"enable_hardware_encoding"`
+	TranscodingTempPath      types.String `tfsdk:"transcoding_temp_path"`
+	EncoderPreset            types.String `tfsdk:"encoder_preset"`
+}
+
+func (r *EncodingConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_encoding_configuration"
+}
+
+func (r *EncodingConfigurationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	stringAttribute := func(description string, validators ...validator.String) schema.StringAttribute {
+		return schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: description,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+			Validators: validators,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the server's transcoding settings. " +
+			"Only the attributes set in the configuration are written; all other encoding settings are preserved. " +
+			"There is one encoding configuration per server, and destroying this resource leaves the current settings in place.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the encoding configuration. Always `" + encodingConfigurationID + "`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hardware_acceleration_type": stringAttribute(
+				"The hardware acceleration method used for transcoding: `none`, `amf`, `qsv`, `nvenc`, `v4l2m2m`, `vaapi`, `videotoolbox` or `rkmpp`. "+
+					"A method the server's hardware doesn't support makes transcoded playback fail.",
+				stringvalidator.OneOf(hardwareAccelerationTypes()...),
+			),
+			"enable_hardware_encoding": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether the hardware acceleration method is also used to encode, not just decode.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"transcoding_temp_path": stringAttribute(
+				"The directory transcoded segments are written to. Empty to use the server's default.",
+			),
+			"encoder_preset": stringAttribute(
+				"The x264/x265 preset trading speed for quality, such as `veryfast` or `medium`. Empty or `auto` to let the server choose.",
+			),
+		},
+	}
+}
+
+func (r *EncodingConfigurationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *EncodingConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data EncodingConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Created encoding configuration resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EncodingConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data EncodingConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.GetConfiguration(ctx, encodingConfigurationKey)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read encoding configuration: %s", err))
+		return
+	}
+
+	setEncodingConfigurationModel(&data, config)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EncodingConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data EncodingConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EncodingConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The encoding configuration can't be deleted; removing the resource from state
+	// simply stops Terraform from managing it.
+	tflog.Trace(ctx, "Deleted encoding configuration resource (no-op)")
+}
+
+func (r *EncodingConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply writes the configured settings to the server and refreshes the model from the result.
+func (r *EncodingConfigurationResource) apply(ctx context.Context, data *EncodingConfigurationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	fields := encodingConfigurationFields(data)
+
+	tflog.Debug(ctx, "Updating encoding configuration", map[string]interface{}{
+		"fields": len(fields),
+	})
+
+	config, err := r.client.PatchConfiguration(ctx, encodingConfigurationKey, fields)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update encoding configuration: %s", err))
+		return diags
+	}
+
+	setEncodingConfigurationModel(data, config)
+
+	return diags
+}
+
+// encodingConfigurationFields returns the encoding configuration fields set in the model.
+func encodingConfigurationFields(data *EncodingConfigurationResourceModel) map[string]interface{} {
+	fields := map[string]interface{}{}
+
+	if !data.HardwareAccelerationType.IsNull() && !data.HardwareAccelerationType.IsUnknown() {
+		fields[hardwareAccelerationField] = data.HardwareAccelerationType.ValueString()
+	}
+
+	if !data.EnableHardwareEncoding.IsNull() && !data.EnableHardwareEncoding.IsUnknown() {
+		fields[hardwareEncodingField] = data.EnableHardwareEncoding.ValueBool()
+	}
+
+	if !data.TranscodingTempPath.IsNull() && !data.TranscodingTempPath.IsUnknown() {
+		fields[transcodingTempPathField] = data.TranscodingTempPath.ValueString()
+	}
+
+	if !data.EncoderPreset.IsNull() && !data.EncoderPreset.IsUnknown() {
+		fields[encoderPresetField] = data.EncoderPreset.ValueString()
+	}
+
+	return fields
+}
+
+// setEncodingConfigurationModel copies the managed settings from an encoding configuration into the model.
+func setEncodingConfigurationModel(data *EncodingConfigurationResourceModel, config client.ServerConfiguration) {
+	data.ID = types.StringValue(encodingConfigurationID)
+	data.HardwareAccelerationType = types.StringValue(config.String(hardwareAccelerationField))
+	data.EnableHardwareEncoding = types.BoolValue(config.Bool(hardwareEncodingField))
+	data.TranscodingTempPath = types.StringValue(config.String(transcodingTempPathField))
+	data.EncoderPreset = types.StringValue(config.String(encoderPresetField))
+}
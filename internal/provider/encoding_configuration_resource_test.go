@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEncodingConfigurationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Software transcoding works on any test server.
+			{
+				Config: `
+resource "jellyfin_encoding_configuration" "test" {
+  hardware_acceleration_type = "none"
+  enable_hardware_encoding   = false
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_encoding_configuration.test", "id", "encoding_configuration"),
+					resource.TestCheckResourceAttr("jellyfin_encoding_configuration.test", "hardware_acceleration_type", "none"),
+					resource.TestCheckResourceAttr("jellyfin_encoding_configuration.test", "enable_hardware_encoding", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "jellyfin_encoding_configuration.test",
+				ImportState:       true,
+				ImportStateId:     "encoding_configuration",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEncodingConfigurationResource_invalidHardwareAcceleration(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "jellyfin_encoding_configuration" "test" {
+  hardware_acceleration_type = "cuda"
+}
+`,
+				ExpectError: regexp.MustCompile("value must be one of"),
+			},
+		},
+	})
+}
@@ -0,0 +1,256 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestEncodingConfigurationResource_Metadata(t *testing.T) {
+	r := &EncodingConfigurationResource{}
+	req := resource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &resource.MetadataResponse{}
+
+	r.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_encoding_configuration"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestEncodingConfigurationResource_Schema(t *testing.T) {
+	r := &EncodingConfigurationResource{}
+	req := resource.SchemaRequest{}
+	resp := &resource.SchemaResponse{}
+
+	r.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check id attribute
+	idAttr, ok := resp.Schema.Attributes["id"]
+	if !ok {
+		t.Error("Expected 'id' attribute in schema")
+	} else {
+		if !idAttr.IsComputed() {
+			t.Error("Expected 'id' attribute to be computed")
+		}
+	}
+
+	// Check hardware_acceleration_type attribute
+	hardwareAccelerationTypeAttr, ok := resp.Schema.Attributes["hardware_acceleration_type"]
+	if !ok {
+		t.Error("Expected 'hardware_acceleration_type' attribute in schema")
+	} else {
+		if !hardwareAccelerationTypeAttr.IsOptional() {
+			t.Error("Expected 'hardware_acceleration_type' attribute to be optional")
+		}
+		if !hardwareAccelerationTypeAttr.IsComputed() {
+			t.Error("Expected 'hardware_acceleration_type' attribute to be computed")
+		}
+	}
+
+	// Check enable_hardware_encoding attribute
+	enableHardwareEncodingAttr, ok := resp.Schema.Attributes["enable_hardware_encoding"]
+	if !ok {
+		t.Error("Expected 'enable_hardware_encoding' attribute in schema")
+	} else {
+		if !enableHardwareEncodingAttr.IsOptional() {
+			t.Error("Expected 'enable_hardware_encoding' attribute to be optional")
+		}
+		if !enableHardwareEncodingAttr.IsComputed() {
+			t.Error("Expected 'enable_hardware_encoding' attribute to be computed")
+		}
+	}
+
+	// Check transcoding_temp_path attribute
+	transcodingTempPathAttr, ok := resp.Schema.Attributes["transcoding_temp_path"]
+	if !ok {
+		t.Error("Expected 'transcoding_temp_path' attribute in schema")
+	} else {
+		if !transcodingTempPathAttr.IsOptional() {
+			t.Error("Expected 'transcoding_temp_path' attribute to be optional")
+		}
+		if !transcodingTempPathAttr.IsComputed() {
+			t.Error("Expected 'transcoding_temp_path' attribute to be computed")
+		}
+	}
+
+	// Check encoder_preset attribute
+	encoderPresetAttr, ok := resp.Schema.Attributes["encoder_preset"]
+	if !ok {
+		t.Error("Expected 'encoder_preset' attribute in schema")
+	} else {
+		if !encoderPresetAttr.IsOptional() {
+			t.Error("Expected 'encoder_preset' attribute to be optional")
+		}
+		if !encoderPresetAttr.IsComputed() {
+			t.Error("Expected 'encoder_preset' attribute to be computed")
+		}
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestEncodingConfigurationResource_Configure_nilProviderData(t *testing.T) {
+	r := &EncodingConfigurationResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestEncodingConfigurationResource_Configure_wrongType(t *testing.T) {
+	r := &EncodingConfigurationResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestEncodingConfigurationResource_Configure_success(t *testing.T) {
+	r := &EncodingConfigurationResource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := resource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if r.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewEncodingConfigurationResource(t *testing.T) {
+	r := NewEncodingConfigurationResource()
+	if r == nil {
+		t.Error("Expected resource to be instantiated")
+	}
+
+	_, ok := r.(*EncodingConfigurationResource)
+	if !ok {
+		t.Error("Expected resource to be *EncodingConfigurationResource")
+	}
+}
+
+func TestEncodingConfigurationFields(t *testing.T) {
+	got := encodingConfigurationFields(&EncodingConfigurationResourceModel{
+		HardwareAccelerationType: types.StringValue("vaapi"),
+		EnableHardwareEncoding:   types.BoolValue(true),
+		TranscodingTempPath:      types.StringValue("/cache/transcodes"),
+		EncoderPreset:            types.StringUnknown(),
+	})
+	expected := map[string]interface{}{
+		"HardwareAccelerationType": "vaapi",
+		"EnableHardwareEncoding":   true,
+		"TranscodingTempPath":      "/cache/transcodes",
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+
+	encoded, err := json.Marshal(got)
+	if err != nil {
+		t.Fatalf("Failed to encode fields: %v", err)
+	}
+
+	expectedJSON := `{"EnableHardwareEncoding":true,"HardwareAccelerationType":"vaapi","TranscodingTempPath":"/cache/transcodes"}`
+	if string(encoded) != expectedJSON {
+		t.Errorf("Expected %s, got %s", expectedJSON, encoded)
+	}
+}
+
+func TestSetEncodingConfigurationModel(t *testing.T) {
+	var config client.ServerConfiguration
+	if err := json.Unmarshal([]byte(`{"HardwareAccelerationType":"nvenc","EnableHardwareEncoding":true,"TranscodingTempPath":"/tmp/t","EncoderPreset":"veryfast","EnableTonemapping":true}`), &config); err != nil {
+		t.Fatalf("Failed to decode configuration: %v", err)
+	}
+
+	var data EncodingConfigurationResourceModel
+	setEncodingConfigurationModel(&data, config)
+
+	if data.ID.ValueString() != encodingConfigurationID {
+		t.Errorf("Expected id %q, got %s", encodingConfigurationID, data.ID)
+	}
+
+	if data.HardwareAccelerationType.ValueString() != "nvenc" || !data.EnableHardwareEncoding.ValueBool() {
+		t.Errorf("Expected nvenc with hardware encoding, got %s and %s", data.HardwareAccelerationType, data.EnableHardwareEncoding)
+	}
+
+	if data.TranscodingTempPath.ValueString() != "/tmp/t" || data.EncoderPreset.ValueString() != "veryfast" {
+		t.Errorf("Expected temp path and preset to be copied, got %s and %s", data.TranscodingTempPath, data.EncoderPreset)
+	}
+}
+
+func TestHardwareAccelerationTypeValidation(t *testing.T) {
+	v := stringvalidator.OneOf(hardwareAccelerationTypes()...)
+
+	testCases := []struct {
+		value       string
+		expectError bool
+	}{
+		{value: "none"},
+		{value: "vaapi"},
+		{value: "videotoolbox"},
+		{value: "VAAPI", expectError: true},
+		{value: "cuda", expectError: true},
+		{value: "", expectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.value, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("hardware_acceleration_type"),
+				ConfigValue: types.StringValue(tc.value),
+			}
+			resp := &validator.StringResponse{}
+
+			v.ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Errorf("Expected error %t for %q, got %v", tc.expectError, tc.value, resp.Diagnostics)
+			}
+		})
+	}
+}
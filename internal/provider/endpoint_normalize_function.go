@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &EndpointNormalizeFunction{}
+
+func NewEndpointNormalizeFunction() function.Function {
+	return &EndpointNormalizeFunction{}
+}
+
+// EndpointNormalizeFunction defines the function implementation.
+type EndpointNormalizeFunction struct{}
+
+func (f *EndpointNormalizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "endpoint_normalize"
+}
+
+func (f *EndpointNormalizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Normalize a Jellyfin server URL",
+		MarkdownDescription: "Returns a server URL in the same canonical form the provider uses for its `endpoint`: " +
+			"the scheme, which must be `http` or `https`, and the host are lowercased, and trailing slashes are trimmed " +
+			"while any base path, such as a reverse proxy subpath, is kept. Useful for outputs and for building links to the server.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "raw",
+				MarkdownDescription: "The server URL to normalize (e.g., `HTTPS://Media.Example.com/jellyfin/`).",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *EndpointNormalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var raw string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &raw))
+
+	if resp.Error != nil {
+		return
+	}
+
+	endpoint, err := client.NormalizeEndpoint(raw)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, endpoint))
+}
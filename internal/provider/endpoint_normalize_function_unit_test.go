@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestEndpointNormalizeFunction_Metadata(t *testing.T) {
+	f := &EndpointNormalizeFunction{}
+	resp := &function.MetadataResponse{}
+
+	f.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "endpoint_normalize" {
+		t.Errorf("Expected Name 'endpoint_normalize', got %q", resp.Name)
+	}
+}
+
+func TestEndpointNormalizeFunction_Definition(t *testing.T) {
+	f := &EndpointNormalizeFunction{}
+	resp := &function.DefinitionResponse{}
+
+	f.Definition(context.Background(), function.DefinitionRequest{}, resp)
+
+	if len(resp.Definition.Parameters) != 1 {
+		t.Errorf("Expected 1 parameter, got %d", len(resp.Definition.Parameters))
+	}
+
+	if resp.Definition.Return == nil {
+		t.Error("Expected a return definition")
+	}
+}
+
+func TestEndpointNormalizeFunction_Run(t *testing.T) {
+	testCases := []struct {
+		name      string
+		raw       string
+		expected  string
+		expectErr bool
+	}{
+		{
+			name:     "base path kept",
+			raw:      "HTTPS://Media.Example.com/jellyfin/",
+			expected: "https://media.example.com/jellyfin",
+		},
+		{
+			name:      "missing scheme",
+			raw:       "localhost:8096",
+			expectErr: true,
+		},
+		{
+			name:      "unsupported scheme",
+			raw:       "ftp://media.example.com",
+			expectErr: true,
+		},
+		{
+			name:      "empty",
+			raw:       "",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.StringValue(tc.raw),
+				}),
+			}
+			resp := &function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+			}
+
+			(&EndpointNormalizeFunction{}).Run(ctx, req, resp)
+
+			if tc.expectErr {
+				if resp.Error == nil {
+					t.Error("Expected an error")
+				}
+				return
+			}
+
+			if resp.Error != nil {
+				t.Fatalf("Unexpected error: %s", resp.Error)
+			}
+
+			if !resp.Result.Value().Equal(types.StringValue(tc.expected)) {
+				t.Errorf("Expected %s, got %s", tc.expected, resp.Result.Value())
+			}
+		})
+	}
+}
@@ -0,0 +1,248 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// imageExtractionFields maps each image extraction attribute to its library option.
+var imageExtractionFields = map[string]string{
+	"enable_photos":                              "EnablePhotos",
+	"enable_chapter_image_extraction":            "EnableChapterImageExtraction",
+	"extract_chapter_images_during_library_scan": "ExtractChapterImagesDuringLibraryScan",
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ImageExtractionResource{}
+var _ resource.ResourceWithImportState = &ImageExtractionResource{}
+
+func NewImageExtractionResource() resource.Resource {
+	return &ImageExtractionResource{}
+}
+
+// ImageExtractionResource defines the resource implementation.
+type ImageExtractionResource struct {
+	client *client.Client
+}
+
+// ImageExtractionResourceModel describes the resource data model.
+type ImageExtractionResourceModel struct {
+	ID                             types.String `tfsdk:"id"`
+	LibraryID                      types.String `tfsdk:"library_id"`
+	EnablePhotos                   types.Bool   `tfsdk:"enable_photos"`
+	EnableChapterImageExtraction   types.Bool   `tfsdk:"enable_chapter_image_extraction"`
+	ExtractChapterImagesDuringScan types.Bool   `tfsdk:"extract_chapter_images_during_library_scan"`
+}
+
+// settings returns the model's image extraction attributes keyed by attribute name.
+func (m *ImageExtractionResourceModel) settings() map[string]*types.Bool {
+	return map[string]*types.Bool{
+		"enable_photos":                              &m.EnablePhotos,
+		"enable_chapter_image_extraction":            &m.EnableChapterImageExtraction,
+		"extract_chapter_images_during_library_scan": &m.ExtractChapterImagesDuringScan,
+	}
+}
+
+func (r *ImageExtractionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_image_extraction"
+}
+
+func (r *ImageExtractionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	settingAttribute := func(description string) schema.BoolAttribute {
+		return schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: description,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a library's image extraction settings, which affect how long scans take and which thumbnails are available. " +
+			"Only the attributes set in the configuration are written; all other library settings are preserved. " +
+			"The server-wide dummy chapter duration is set on `jellyfin_server_configuration`. " +
+			"Destroying this resource leaves the current settings in place.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for this resource. Same as `library_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"library_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The item ID of the library whose settings are managed.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enable_photos": settingAttribute("Whether photos in the library's folders are imported and shown."),
+			"enable_chapter_image_extraction": settingAttribute("Whether thumbnail images are extracted from videos for chapter selection. " +
+				"Extraction is slow and uses disk space."),
+			"extract_chapter_images_during_library_scan": settingAttribute("Whether chapter images are extracted while the library is scanned, " +
+				"rather than by the nightly scheduled task. Has no effect unless `enable_chapter_image_extraction` is `true`."),
+		},
+	}
+}
+
+func (r *ImageExtractionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ImageExtractionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ImageExtractionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Created image extraction resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ImageExtractionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ImageExtractionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options, err := r.client.GetLibraryOptions(ctx, data.LibraryID.ValueString())
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read library options: %s", err))
+		return
+	}
+
+	data.ID = data.LibraryID
+	setImageExtractionModel(&data, options)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ImageExtractionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ImageExtractionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ImageExtractionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Library options can't be deleted; removing the resource from state simply stops
+	// Terraform from managing them.
+	tflog.Trace(ctx, "Deleted image extraction resource (no-op)")
+}
+
+func (r *ImageExtractionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("library_id"), req.ID)...)
+}
+
+// apply writes the configured settings to the library and refreshes the model from the result.
+func (r *ImageExtractionResource) apply(ctx context.Context, data *ImageExtractionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	fields := map[string]interface{}{}
+
+	for attribute, value := range data.settings() {
+		if !value.IsNull() && !value.IsUnknown() {
+			fields[imageExtractionFields[attribute]] = value.ValueBool()
+		}
+	}
+
+	libraryID := data.LibraryID.ValueString()
+
+	tflog.Debug(ctx, "Updating library image extraction settings", map[string]interface{}{
+		"library_id": libraryID,
+		"fields":     len(fields),
+	})
+
+	options, err := r.client.PatchLibraryOptions(ctx, libraryID, fields)
+
+	if client.IsNotFound(err) {
+		diags.AddAttributeError(
+			path.Root("library_id"),
+			"Library Not Found",
+			fmt.Sprintf("No library with ID %q was found.", libraryID),
+		)
+		return diags
+	}
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update library options: %s", err))
+		return diags
+	}
+
+	data.ID = types.StringValue(libraryID)
+	setImageExtractionModel(data, options)
+
+	return diags
+}
+
+// setImageExtractionModel copies the managed settings from a library's options into the model.
+func setImageExtractionModel(data *ImageExtractionResourceModel, options client.ServerConfiguration) {
+	for attribute, value := range data.settings() {
+		*value = types.BoolValue(options.Bool(imageExtractionFields[attribute]))
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccImageExtractionResource_basic(t *testing.T) {
+	libraryName := os.Getenv("JELLYFIN_TEST_LIBRARY_NAME")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t); testAccPreCheckLibrary(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccImageExtractionResourceConfig(libraryName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("jellyfin_image_extraction.test", "library_id", "data.jellyfin_library.test", "id"),
+					resource.TestCheckResourceAttr("jellyfin_image_extraction.test", "enable_chapter_image_extraction", "true"),
+					resource.TestCheckResourceAttr("jellyfin_image_extraction.test", "extract_chapter_images_during_library_scan", "false"),
+					resource.TestCheckResourceAttrSet("jellyfin_image_extraction.test", "enable_photos"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "jellyfin_image_extraction.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update testing
+			{
+				Config: testAccImageExtractionResourceConfig(libraryName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_image_extraction.test", "enable_chapter_image_extraction", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccImageExtractionResourceConfig(libraryName string, chapterImages bool) string {
+	return fmt.Sprintf(`
+data "jellyfin_library" "test" {
+  name = %[1]q
+}
+
+resource "jellyfin_image_extraction" "test" {
+  library_id                                 = data.jellyfin_library.test.id
+  enable_chapter_image_extraction            = %[2]t
+  extract_chapter_images_during_library_scan = false
+}
+`, libraryName, chapterImages)
+}
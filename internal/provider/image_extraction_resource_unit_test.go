@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestImageExtractionResource_Metadata(t *testing.T) {
+	r := &ImageExtractionResource{}
+	req := resource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &resource.MetadataResponse{}
+
+	r.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_image_extraction"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestImageExtractionResource_Schema(t *testing.T) {
+	r := &ImageExtractionResource{}
+	req := resource.SchemaRequest{}
+	resp := &resource.SchemaResponse{}
+
+	r.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check id attribute
+	idAttr, ok := resp.Schema.Attributes["id"]
+	if !ok {
+		t.Error("Expected 'id' attribute in schema")
+	} else {
+		if !idAttr.IsComputed() {
+			t.Error("Expected 'id' attribute to be computed")
+		}
+	}
+
+	// Check library_id attribute
+	libraryIdAttr, ok := resp.Schema.Attributes["library_id"]
+	if !ok {
+		t.Error("Expected 'library_id' attribute in schema")
+	} else {
+		if !libraryIdAttr.IsRequired() {
+			t.Error("Expected 'library_id' attribute to be required")
+		}
+	}
+
+	// Check enable_photos attribute
+	enablePhotosAttr, ok := resp.Schema.Attributes["enable_photos"]
+	if !ok {
+		t.Error("Expected 'enable_photos' attribute in schema")
+	} else {
+		if !enablePhotosAttr.IsOptional() {
+			t.Error("Expected 'enable_photos' attribute to be optional")
+		}
+		if !enablePhotosAttr.IsComputed() {
+			t.Error("Expected 'enable_photos' attribute to be computed")
+		}
+	}
+
+	// Check enable_chapter_image_extraction attribute
+	enableChapterImageExtractionAttr, ok := resp.Schema.Attributes["enable_chapter_image_extraction"]
+	if !ok {
+		t.Error("Expected 'enable_chapter_image_extraction' attribute in schema")
+	} else {
+		if !enableChapterImageExtractionAttr.IsOptional() {
+			t.Error("Expected 'enable_chapter_image_extraction' attribute to be optional")
+		}
+		if !enableChapterImageExtractionAttr.IsComputed() {
+			t.Error("Expected 'enable_chapter_image_extraction' attribute to be computed")
+		}
+	}
+
+	// Check extract_chapter_images_during_library_scan attribute
+	extractChapterImagesDuringLibraryScanAttr, ok := resp.Schema.Attributes["extract_chapter_images_during_library_scan"]
+	if !ok {
+		t.Error("Expected 'extract_chapter_images_during_library_scan' attribute in schema")
+	} else {
+		if !extractChapterImagesDuringLibraryScanAttr.IsOptional() {
+			t.Error("Expected 'extract_chapter_images_during_library_scan' attribute to be optional")
+		}
+		if !extractChapterImagesDuringLibraryScanAttr.IsComputed() {
+			t.Error("Expected 'extract_chapter_images_during_library_scan' attribute to be computed")
+		}
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestImageExtractionResource_Configure_nilProviderData(t *testing.T) {
+	r := &ImageExtractionResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestImageExtractionResource_Configure_wrongType(t *testing.T) {
+	r := &ImageExtractionResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestImageExtractionResource_Configure_success(t *testing.T) {
+	r := &ImageExtractionResource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := resource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if r.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewImageExtractionResource(t *testing.T) {
+	r := NewImageExtractionResource()
+	if r == nil {
+		t.Error("Expected resource to be instantiated")
+	}
+
+	_, ok := r.(*ImageExtractionResource)
+	if !ok {
+		t.Error("Expected resource to be *ImageExtractionResource")
+	}
+}
+
+func TestSetImageExtractionModel(t *testing.T) {
+	var data ImageExtractionResourceModel
+	setImageExtractionModel(&data, client.ServerConfiguration{
+		"EnablePhotos":                 json.RawMessage(`true`),
+		"EnableChapterImageExtraction": json.RawMessage(`false`),
+		"EnableRealtimeMonitor":        json.RawMessage(`true`),
+	})
+
+	if !data.EnablePhotos.ValueBool() {
+		t.Error("Expected enable_photos to be true")
+	}
+	if data.EnableChapterImageExtraction.IsNull() || data.EnableChapterImageExtraction.ValueBool() {
+		t.Errorf("Expected enable_chapter_image_extraction to be false, got %s", data.EnableChapterImageExtraction)
+	}
+
+	// Options the server omits read as false rather than null.
+	if data.ExtractChapterImagesDuringScan.IsNull() || data.ExtractChapterImagesDuringScan.ValueBool() {
+		t.Errorf("Expected extract_chapter_images_during_library_scan to be false, got %s", data.ExtractChapterImagesDuringScan)
+	}
+}
@@ -0,0 +1,345 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+const (
+	// defaultItemWaitTimeout is how long wait_for waits for an item when no timeout is set.
+	defaultItemWaitTimeout = 5 * time.Minute
+
+	// itemWaitInterval is how often wait_for checks whether the item exists.
+	itemWaitInterval = 2 * time.Second
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ItemDataSource{}
+
+func NewItemDataSource() datasource.DataSource {
+	return &ItemDataSource{}
+}
+
+// ItemDataSource defines the data source implementation.
+type ItemDataSource struct {
+	client *client.Client
+}
+
+// ItemDataSourceModel describes the data source data model.
+type ItemDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	UserID         types.String `tfsdk:"user_id"`
+	Name           types.String `tfsdk:"name"`
+	Type           types.String `tfsdk:"type"`
+	ParentID       types.String `tfsdk:"parent_id"`
+	Path           types.String `tfsdk:"path"`
+	Overview       types.String `tfsdk:"overview"`
+	ProductionYear types.Int64  `tfsdk:"production_year"`
+	FullJSON       types.String `tfsdk:"full_json"`
+	WaitFor        types.Bool   `tfsdk:"wait_for"`
+	Timeout        types.String `tfsdk:"timeout"`
+
+	IncludeMediaStreams types.Bool             `tfsdk:"include_media_streams"`
+	MediaStreams        []ItemMediaStreamModel `tfsdk:"media_streams"`
+}
+
+// ItemMediaStreamModel describes a single stream of the item's media.
+type ItemMediaStreamModel struct {
+	Type     types.String `tfsdk:"type"`
+	Codec    types.String `tfsdk:"codec"`
+	Width    types.Int64  `tfsdk:"width"`
+	Height   types.Int64  `tfsdk:"height"`
+	Language types.String `tfsdk:"language"`
+}
+
+func (d *ItemDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_item"
+}
+
+func (d *ItemDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves a Jellyfin media item by ID.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the item.",
+			},
+			"user_id": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Read the item as this user, through `/Users/{userId}/Items/{id}`. " +
+					"The item must be visible to the user, and user-specific fields such as playback state are included in `full_json`. " +
+					"Omit to read the item without a user context.",
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the item.",
+			},
+			"type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The item type (e.g., `Movie`, `Series`, `Genre`, `BoxSet`).",
+			},
+			"parent_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the item's parent, if any.",
+			},
+			"path": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The filesystem path of the item, if any.",
+			},
+			"overview": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The description of the item. Null if it has none.",
+			},
+			"production_year": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The year the item was produced or released. Null if unknown.",
+			},
+			"full_json": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: "The complete item as returned by the API, as normalized JSON. " +
+					"Use `jsondecode()` to access fields not exposed as attributes.",
+			},
+			"wait_for": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Whether to wait for the item to appear instead of failing when it doesn't exist, " +
+					"e.g. while a library scan is still adding it. Defaults to `false`.",
+			},
+			"timeout": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: fmt.Sprintf("How long `wait_for` waits for the item, as a duration string (e.g., `30s` or `10m`). "+
+					"Defaults to `%s`.", defaultItemWaitTimeout),
+			},
+			"include_media_streams": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Whether to fetch the item's media streams into `media_streams`. " +
+					"This requests the item's media sources, which can be large, so it defaults to `false`.",
+			},
+			"media_streams": schema.ListNestedAttribute{
+				Computed: true,
+				MarkdownDescription: "The video, audio and subtitle streams of the item's default media source. " +
+					"Null unless `include_media_streams` is `true`; empty for items that aren't playable.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The stream type (e.g., `Video`, `Audio`, `Subtitle`).",
+						},
+						"codec": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The codec of the stream (e.g., `hevc`, `aac`, `subrip`).",
+						},
+						"width": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The width of the picture in pixels. Null for streams without one.",
+						},
+						"height": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The height of the picture in pixels. Null for streams without one.",
+						},
+						"language": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The language of the stream (e.g., `eng`), if known.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ItemDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ItemDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ItemDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getItem := func(ctx context.Context) (string, error) {
+		if data.UserID.IsNull() {
+			return d.client.GetItemRaw(ctx, data.ID.ValueString())
+		}
+		return d.client.GetUserItemRaw(ctx, data.UserID.ValueString(), data.ID.ValueString())
+	}
+
+	var raw string
+	var err error
+
+	if data.WaitFor.ValueBool() {
+		timeout := defaultItemWaitTimeout
+
+		if !data.Timeout.IsNull() && !data.Timeout.IsUnknown() {
+			timeout, err = time.ParseDuration(data.Timeout.ValueString())
+
+			if err != nil || timeout <= 0 {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("timeout"),
+					"Invalid Duration",
+					fmt.Sprintf("Expected a positive duration such as \"30s\" or \"10m\", got %q.", data.Timeout.ValueString()),
+				)
+				return
+			}
+		}
+
+		err = client.PollUntil(ctx, itemWaitInterval, timeout, func(ctx context.Context) (bool, error) {
+			var getErr error
+			raw, getErr = getItem(ctx)
+
+			if client.IsNotFound(getErr) {
+				return false, nil
+			}
+
+			return getErr == nil, getErr
+		})
+
+		if errors.Is(err, client.ErrPollTimeout) {
+			resp.Diagnostics.AddError(
+				"Timed Out Waiting for Item",
+				fmt.Sprintf("No item with ID %q appeared within %s. If a library scan is adding it, check that the scan is running or increase timeout.", data.ID.ValueString(), timeout),
+			)
+			return
+		}
+	} else {
+		raw, err = getItem(ctx)
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(itemReadErrorDiagnostics(data, err)...)
+		return
+	}
+
+	resp.Diagnostics.Append(setItemModel(&data, raw)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.IncludeMediaStreams.ValueBool() {
+		streams, err := d.client.GetItemMediaStreams(ctx, data.ID.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read item media streams: %s", err))
+			return
+		}
+
+		data.MediaStreams = mediaStreamModels(streams)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// itemReadErrorDiagnostics reports a failure to read the item, distinguishing an item
+// that doesn't exist, or isn't visible to user_id, from other errors.
+func itemReadErrorDiagnostics(data ItemDataSourceModel, err error) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch {
+	case client.IsNotFound(err) && !data.UserID.IsNull():
+		diags.AddError(
+			"Item Not Found",
+			fmt.Sprintf("No item with ID %q was found for user %q.", data.ID.ValueString(), data.UserID.ValueString()),
+		)
+	case client.IsNotFound(err):
+		diags.AddError(
+			"Item Not Found",
+			fmt.Sprintf("No item with ID %q was found.", data.ID.ValueString()),
+		)
+	default:
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read item: %s", err))
+	}
+
+	return diags
+}
+
+// setItemModel fills the model from the raw item JSON, leaving media_streams to be
+// filled separately.
+func setItemModel(data *ItemDataSourceModel, raw string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var item client.Item
+	if err := json.Unmarshal([]byte(raw), &item); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to decode item: %s", err))
+		return diags
+	}
+
+	fullJSON, err := normalizeJSON(raw)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to normalize item JSON: %s", err))
+		return diags
+	}
+
+	data.ID = types.StringValue(item.Id)
+	data.Name = types.StringValue(item.Name)
+	data.Type = types.StringValue(item.Type)
+	data.ParentID = types.StringValue(item.ParentId)
+	data.Path = types.StringValue(item.Path)
+	data.Overview = optionalString(item.Overview)
+	data.ProductionYear = types.Int64Null()
+	if item.ProductionYear > 0 {
+		data.ProductionYear = types.Int64Value(item.ProductionYear)
+	}
+	data.FullJSON = types.StringValue(fullJSON)
+	data.MediaStreams = nil
+
+	return diags
+}
+
+// mediaStreamModels converts media streams to their Terraform representation, leaving
+// the picture size null for streams without a picture.
+func mediaStreamModels(streams []client.MediaStream) []ItemMediaStreamModel {
+	models := make([]ItemMediaStreamModel, 0, len(streams))
+
+	for _, stream := range streams {
+		model := ItemMediaStreamModel{
+			Type:     types.StringValue(stream.Type),
+			Codec:    optionalString(stream.Codec),
+			Width:    types.Int64Null(),
+			Height:   types.Int64Null(),
+			Language: optionalString(stream.Language),
+		}
+
+		if stream.Width > 0 && stream.Height > 0 {
+			model.Width = types.Int64Value(stream.Width)
+			model.Height = types.Int64Value(stream.Height)
+		}
+
+		models = append(models, model)
+	}
+
+	return models
+}
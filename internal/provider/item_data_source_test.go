@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccItemDataSource(t *testing.T) {
+	itemID := os.Getenv("JELLYFIN_TEST_ITEM_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t); testAccPreCheckItem(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccItemDataSourceConfig(itemID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.jellyfin_item.test", "id", itemID),
+					resource.TestCheckResourceAttrSet("data.jellyfin_item.test", "name"),
+					resource.TestCheckResourceAttrSet("data.jellyfin_item.test", "type"),
+					resource.TestCheckResourceAttrSet("data.jellyfin_item.test", "full_json"),
+					resource.TestCheckNoResourceAttr("data.jellyfin_item.test", "media_streams.#"),
+				),
+			},
+			{
+				Config: testAccItemDataSourceConfigWithMediaStreams(itemID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.jellyfin_item.test", "media_streams.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccItemDataSource_user(t *testing.T) {
+	itemID := os.Getenv("JELLYFIN_TEST_ITEM_ID")
+	userID := os.Getenv("JELLYFIN_TEST_USER_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t); testAccPreCheckItem(t); testAccPreCheckUser(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "jellyfin_item" "test" {
+  id      = %[1]q
+  user_id = %[2]q
+}
+`, itemID, userID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.jellyfin_item.test", "id", itemID),
+					resource.TestCheckResourceAttrSet("data.jellyfin_item.test", "name"),
+					resource.TestCheckResourceAttrSet("data.jellyfin_item.test", "full_json"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccItemDataSource_notFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccItemDataSourceConfig("00000000000000000000000000000000"),
+				ExpectError: regexp.MustCompile("Item Not Found"),
+			},
+		},
+	})
+}
+
+func TestAccItemDataSource_waitForTimeout(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "jellyfin_item" "test" {
+  id       = "00000000000000000000000000000000"
+  wait_for = true
+  timeout  = "5s"
+}
+`,
+				ExpectError: regexp.MustCompile("Timed Out Waiting for Item"),
+			},
+		},
+	})
+}
+
+func testAccItemDataSourceConfig(id string) string {
+	return fmt.Sprintf(`
+data "jellyfin_item" "test" {
+  id = %[1]q
+}
+`, id)
+}
+
+func testAccItemDataSourceConfigWithMediaStreams(id string) string {
+	return fmt.Sprintf(`
+data "jellyfin_item" "test" {
+  id                    = %[1]q
+  include_media_streams = true
+}
+`, id)
+}
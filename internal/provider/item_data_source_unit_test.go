@@ -0,0 +1,332 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestItemDataSource_Metadata(t *testing.T) {
+	ds := &ItemDataSource{}
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_item"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestItemDataSource_Schema(t *testing.T) {
+	ds := &ItemDataSource{}
+	req := datasource.SchemaRequest{}
+	resp := &datasource.SchemaResponse{}
+
+	ds.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check id attribute
+	idAttr, ok := resp.Schema.Attributes["id"]
+	if !ok {
+		t.Error("Expected 'id' attribute in schema")
+	} else {
+		if !idAttr.IsRequired() {
+			t.Error("Expected 'id' attribute to be required")
+		}
+	}
+
+	// Check name attribute
+	nameAttr, ok := resp.Schema.Attributes["name"]
+	if !ok {
+		t.Error("Expected 'name' attribute in schema")
+	} else {
+		if !nameAttr.IsComputed() {
+			t.Error("Expected 'name' attribute to be computed")
+		}
+	}
+
+	// Check type attribute
+	typeAttr, ok := resp.Schema.Attributes["type"]
+	if !ok {
+		t.Error("Expected 'type' attribute in schema")
+	} else {
+		if !typeAttr.IsComputed() {
+			t.Error("Expected 'type' attribute to be computed")
+		}
+	}
+
+	// Check full_json attribute
+	fullJsonAttr, ok := resp.Schema.Attributes["full_json"]
+	if !ok {
+		t.Error("Expected 'full_json' attribute in schema")
+	} else {
+		if !fullJsonAttr.IsComputed() {
+			t.Error("Expected 'full_json' attribute to be computed")
+		}
+	}
+
+	// Check media_streams attribute
+	mediaStreamsAttr, ok := resp.Schema.Attributes["media_streams"]
+	if !ok {
+		t.Error("Expected 'media_streams' attribute in schema")
+	} else {
+		if !mediaStreamsAttr.IsComputed() {
+			t.Error("Expected 'media_streams' attribute to be computed")
+		}
+	}
+
+	// Check overview and production_year attributes
+	for _, name := range []string{"overview", "production_year"} {
+		attr, ok := resp.Schema.Attributes[name]
+		if !ok {
+			t.Errorf("Expected '%s' attribute in schema", name)
+		} else if !attr.IsComputed() {
+			t.Errorf("Expected '%s' attribute to be computed", name)
+		}
+	}
+
+	// Check user_id, wait_for, timeout and include_media_streams attributes
+	for _, name := range []string{"user_id", "wait_for", "timeout", "include_media_streams"} {
+		attr, ok := resp.Schema.Attributes[name]
+		if !ok {
+			t.Errorf("Expected '%s' attribute in schema", name)
+		} else if !attr.IsOptional() {
+			t.Errorf("Expected '%s' attribute to be optional", name)
+		}
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestItemDataSource_Configure_nilProviderData(t *testing.T) {
+	ds := &ItemDataSource{}
+	req := datasource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestItemDataSource_Configure_wrongType(t *testing.T) {
+	ds := &ItemDataSource{}
+	req := datasource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestItemDataSource_Configure_success(t *testing.T) {
+	ds := &ItemDataSource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := datasource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if ds.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewItemDataSource(t *testing.T) {
+	ds := NewItemDataSource()
+	if ds == nil {
+		t.Error("Expected data source to be instantiated")
+	}
+
+	_, ok := ds.(*ItemDataSource)
+	if !ok {
+		t.Error("Expected data source to be *ItemDataSource")
+	}
+}
+
+func TestMediaStreamModels(t *testing.T) {
+	models := mediaStreamModels([]client.MediaStream{
+		{Type: "Video", Codec: "hevc", Width: 3840, Height: 2160},
+		{Type: "Audio", Codec: "eac3", Language: "eng"},
+	})
+
+	if len(models) != 2 {
+		t.Fatalf("Expected 2 streams, got %d", len(models))
+	}
+
+	if models[0].Width.ValueInt64() != 3840 || models[0].Height.ValueInt64() != 2160 {
+		t.Errorf("Expected 3840x2160, got %sx%s", models[0].Width, models[0].Height)
+	}
+
+	if !models[0].Language.IsNull() {
+		t.Errorf("Expected null language for a video stream without one, got %s", models[0].Language)
+	}
+
+	if !models[1].Width.IsNull() || !models[1].Height.IsNull() {
+		t.Errorf("Expected null picture size for an audio stream, got %sx%s", models[1].Width, models[1].Height)
+	}
+
+	if models[1].Language.ValueString() != "eng" {
+		t.Errorf("Expected language 'eng', got %s", models[1].Language)
+	}
+
+	if models := mediaStreamModels(nil); models == nil || len(models) != 0 {
+		t.Errorf("Expected an empty, non-nil list for no streams, got %#v", models)
+	}
+}
+
+func TestSetItemModel(t *testing.T) {
+	raw := `{
+  "Name": "Alien",
+  "Id": "f27caa37e5142225cceded48f6553502",
+  "Type": "Movie",
+  "ParentId": "a656b907eb3a73532e40e44b968d0225",
+  "Path": "/media/movies/Alien (1979)/Alien (1979).mkv",
+  "Overview": "The crew of a commercial spacecraft encounter a deadly lifeform.",
+  "ProductionYear": 1979,
+  "CommunityRating": 8.1,
+  "ImageTags": {"Primary": "tag-1"}
+}`
+
+	data := ItemDataSourceModel{ID: types.StringValue("f27caa37-e514-2225-cced-ed48f6553502")}
+	diags := setItemModel(&data, raw)
+
+	if diags.HasError() {
+		t.Fatalf("Expected no errors, got %v", diags)
+	}
+
+	if data.ID.ValueString() != "f27caa37e5142225cceded48f6553502" {
+		t.Errorf("Expected id from the response, got %s", data.ID)
+	}
+
+	if data.Name.ValueString() != "Alien" || data.Type.ValueString() != "Movie" {
+		t.Errorf("Expected name Alien and type Movie, got %s and %s", data.Name, data.Type)
+	}
+
+	if data.Path.ValueString() != "/media/movies/Alien (1979)/Alien (1979).mkv" {
+		t.Errorf("Expected path to be copied, got %s", data.Path)
+	}
+
+	if !strings.HasPrefix(data.Overview.ValueString(), "The crew") {
+		t.Errorf("Expected overview to be copied, got %s", data.Overview)
+	}
+
+	if data.ProductionYear.ValueInt64() != 1979 {
+		t.Errorf("Expected production_year 1979, got %s", data.ProductionYear)
+	}
+
+	if !strings.Contains(data.FullJSON.ValueString(), `"CommunityRating":8.1`) {
+		t.Errorf("Expected full_json to include unmodelled fields, got %s", data.FullJSON)
+	}
+}
+
+func TestSetItemModel_missingFields(t *testing.T) {
+	var data ItemDataSourceModel
+	diags := setItemModel(&data, `{"Id":"genre-1","Name":"Horror","Type":"Genre"}`)
+
+	if diags.HasError() {
+		t.Fatalf("Expected no errors, got %v", diags)
+	}
+
+	if !data.Overview.IsNull() {
+		t.Errorf("Expected null overview, got %s", data.Overview)
+	}
+
+	if !data.ProductionYear.IsNull() {
+		t.Errorf("Expected null production_year, got %s", data.ProductionYear)
+	}
+}
+
+func TestSetItemModel_invalidJSON(t *testing.T) {
+	var data ItemDataSourceModel
+	if diags := setItemModel(&data, `{"Id":`); !diags.HasError() {
+		t.Error("Expected an error for invalid JSON")
+	}
+}
+
+func TestItemReadErrorDiagnostics(t *testing.T) {
+	notFound := &client.APIError{StatusCode: http.StatusNotFound}
+
+	testCases := []struct {
+		name            string
+		data            ItemDataSourceModel
+		err             error
+		expectedSummary string
+		expectedDetail  string
+	}{
+		{
+			name:            "not found",
+			data:            ItemDataSourceModel{ID: types.StringValue("item-1"), UserID: types.StringNull()},
+			err:             notFound,
+			expectedSummary: "Item Not Found",
+			expectedDetail:  `No item with ID "item-1" was found.`,
+		},
+		{
+			name:            "not found for user",
+			data:            ItemDataSourceModel{ID: types.StringValue("item-1"), UserID: types.StringValue("user-1")},
+			err:             notFound,
+			expectedSummary: "Item Not Found",
+			expectedDetail:  `No item with ID "item-1" was found for user "user-1".`,
+		},
+		{
+			name:            "other error",
+			data:            ItemDataSourceModel{ID: types.StringValue("item-1"), UserID: types.StringNull()},
+			err:             fmt.Errorf("connection refused"),
+			expectedSummary: "Client Error",
+			expectedDetail:  "Unable to read item: connection refused",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := itemReadErrorDiagnostics(tc.data, tc.err)
+
+			if len(diags) != 1 {
+				t.Fatalf("Expected 1 diagnostic, got %d", len(diags))
+			}
+
+			if diags[0].Summary() != tc.expectedSummary {
+				t.Errorf("Expected summary %q, got %q", tc.expectedSummary, diags[0].Summary())
+			}
+
+			if diags[0].Detail() != tc.expectedDetail {
+				t.Errorf("Expected detail %q, got %q", tc.expectedDetail, diags[0].Detail())
+			}
+		})
+	}
+}
@@ -0,0 +1,250 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ItemUserDataResource{}
+var _ resource.ResourceWithImportState = &ItemUserDataResource{}
+
+func NewItemUserDataResource() resource.Resource {
+	return &ItemUserDataResource{}
+}
+
+// ItemUserDataResource defines the resource implementation.
+type ItemUserDataResource struct {
+	client *client.Client
+}
+
+// ItemUserDataResourceModel describes the resource data model.
+type ItemUserDataResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	UserID     types.String `tfsdk:"user_id"`
+	ItemID     types.String `tfsdk:"item_id"`
+	IsFavorite types.Bool   `tfsdk:"is_favorite"`
+	Played     types.Bool   `tfsdk:"played"`
+}
+
+func (r *ItemUserDataResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_item_userdata"
+}
+
+func (r *ItemUserDataResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the per-user state of a Jellyfin item, such as whether it is a favorite or has been played. " +
+			"Destroying this resource clears both flags for the user.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for this resource, in the form `user_id/item_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the user whose item state is managed.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"item_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the item.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"is_favorite": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the item is marked as a favorite for the user. Defaults to `false`.",
+			},
+			"played": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the item is marked as played for the user. Defaults to `false`.",
+			},
+		},
+	}
+}
+
+func (r *ItemUserDataResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ItemUserDataResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ItemUserDataResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID := data.UserID.ValueString()
+	itemID := data.ItemID.ValueString()
+
+	tflog.Debug(ctx, "Setting item user data", map[string]interface{}{
+		"user_id": userID,
+		"item_id": itemID,
+	})
+
+	if err := r.client.SetFavorite(ctx, userID, itemID, data.IsFavorite.ValueBool()); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set favorite state: %s", err))
+		return
+	}
+
+	if err := r.client.SetPlayed(ctx, userID, itemID, data.Played.ValueBool()); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set played state: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(userID + "/" + itemID)
+
+	tflog.Trace(ctx, "Created item user data resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ItemUserDataResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ItemUserDataResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userData, err := r.client.GetItemUserData(ctx, data.UserID.ValueString(), data.ItemID.ValueString())
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read item user data: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(data.UserID.ValueString() + "/" + data.ItemID.ValueString())
+	data.IsFavorite = types.BoolValue(userData.IsFavorite)
+	data.Played = types.BoolValue(userData.Played)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ItemUserDataResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ItemUserDataResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID := data.UserID.ValueString()
+	itemID := data.ItemID.ValueString()
+
+	if !data.IsFavorite.Equal(state.IsFavorite) {
+		if err := r.client.SetFavorite(ctx, userID, itemID, data.IsFavorite.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set favorite state: %s", err))
+			return
+		}
+	}
+
+	if !data.Played.Equal(state.Played) {
+		if err := r.client.SetPlayed(ctx, userID, itemID, data.Played.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set played state: %s", err))
+			return
+		}
+	}
+
+	data.ID = state.ID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ItemUserDataResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ItemUserDataResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID := data.UserID.ValueString()
+	itemID := data.ItemID.ValueString()
+
+	tflog.Debug(ctx, "Clearing item user data", map[string]interface{}{
+		"user_id": userID,
+		"item_id": itemID,
+	})
+
+	// Revert both flags rather than deleting anything on the server
+	if err := r.client.SetFavorite(ctx, userID, itemID, false); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to clear favorite state: %s", err))
+		return
+	}
+
+	if err := r.client.SetPlayed(ctx, userID, itemID, false); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to clear played state: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted item user data resource")
+}
+
+func (r *ItemUserDataResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in the form 'user_id/item_id', got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("item_id"), parts[1])...)
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccItemUserDataResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t); testAccPreCheckItem(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccItemUserDataResourceConfig(true, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_item_userdata.test", "is_favorite", "true"),
+					resource.TestCheckResourceAttr("jellyfin_item_userdata.test", "played", "false"),
+					resource.TestCheckResourceAttrSet("jellyfin_item_userdata.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "jellyfin_item_userdata.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update testing
+			{
+				Config: testAccItemUserDataResourceConfig(false, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_item_userdata.test", "is_favorite", "false"),
+					resource.TestCheckResourceAttr("jellyfin_item_userdata.test", "played", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccItemUserDataResourceConfig(isFavorite, played bool) string {
+	return fmt.Sprintf(`
+resource "jellyfin_item_userdata" "test" {
+  user_id     = %[1]q
+  item_id     = %[2]q
+  is_favorite = %[3]t
+  played      = %[4]t
+}
+`, os.Getenv("JELLYFIN_TEST_USER_ID"), os.Getenv("JELLYFIN_TEST_ITEM_ID"), isFavorite, played)
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestItemUserDataResource_Metadata(t *testing.T) {
+	r := &ItemUserDataResource{}
+	req := resource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &resource.MetadataResponse{}
+
+	r.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_item_userdata"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestItemUserDataResource_Schema(t *testing.T) {
+	r := &ItemUserDataResource{}
+	req := resource.SchemaRequest{}
+	resp := &resource.SchemaResponse{}
+
+	r.Schema(context.Background(), req, resp)
+
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	for _, name := range []string{"user_id", "item_id"} {
+		attr, ok := resp.Schema.Attributes[name]
+		if !ok {
+			t.Errorf("Expected '%s' attribute in schema", name)
+		} else if !attr.IsRequired() {
+			t.Errorf("Expected '%s' attribute to be required", name)
+		}
+	}
+
+	for _, name := range []string{"is_favorite", "played"} {
+		attr, ok := resp.Schema.Attributes[name]
+		if !ok {
+			t.Errorf("Expected '%s' attribute in schema", name)
+		} else {
+			if !attr.IsOptional() {
+				t.Errorf("Expected '%s' attribute to be optional", name)
+			}
+			if !attr.IsComputed() {
+				t.Errorf("Expected '%s' attribute to be computed", name)
+			}
+		}
+	}
+
+	idAttr, ok := resp.Schema.Attributes["id"]
+	if !ok {
+		t.Error("Expected 'id' attribute in schema")
+	} else if !idAttr.IsComputed() {
+		t.Error("Expected 'id' attribute to be computed")
+	}
+
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestItemUserDataResource_Configure_nilProviderData(t *testing.T) {
+	r := &ItemUserDataResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestItemUserDataResource_Configure_wrongType(t *testing.T) {
+	r := &ItemUserDataResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: "wrong type",
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestItemUserDataResource_Configure_success(t *testing.T) {
+	r := &ItemUserDataResource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := resource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if r.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewItemUserDataResource(t *testing.T) {
+	r := NewItemUserDataResource()
+	if r == nil {
+		t.Error("Expected resource to be instantiated")
+	}
+
+	_, ok := r.(*ItemUserDataResource)
+	if !ok {
+		t.Error("Expected resource to be *ItemUserDataResource")
+	}
+}
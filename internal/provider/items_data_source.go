@@ -0,0 +1,269 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// defaultItemsLimit caps how many items jellyfin_items returns.
+const defaultItemsLimit = 1000
+
+// maxLibraryDepth bounds how many ancestors are followed when resolving an item's library.
+const maxLibraryDepth = 16
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ItemsDataSource{}
+
+func NewItemsDataSource() datasource.DataSource {
+	return &ItemsDataSource{}
+}
+
+// ItemsDataSource defines the data source implementation.
+type ItemsDataSource struct {
+	client *client.Client
+}
+
+// ItemsDataSourceModel describes the data source data model.
+type ItemsDataSourceModel struct {
+	ParentID         types.String      `tfsdk:"parent_id"`
+	SearchTerm       types.String      `tfsdk:"search_term"`
+	IncludeItemTypes types.List        `tfsdk:"include_item_types"`
+	Recursive        types.Bool        `tfsdk:"recursive"`
+	MaxItems         types.Int64       `tfsdk:"max_items"`
+	Items            []ItemsEntryModel `tfsdk:"items"`
+	Truncated        types.Bool        `tfsdk:"truncated"`
+}
+
+// ItemsEntryModel describes a single item in the list.
+type ItemsEntryModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	LibraryID types.String `tfsdk:"library_id"`
+}
+
+func (d *ItemsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_items"
+}
+
+func (d *ItemsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists or searches items by name and type, either within a parent item or across every library on the server, " +
+			"along with the library each item belongs to.",
+
+		Attributes: map[string]schema.Attribute{
+			"parent_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list items under this item, such as a library or series. Omit to search the whole server.",
+			},
+			"search_term": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Only list items whose name matches this search, as in the Jellyfin search box. " +
+					"Set `recursive` to `true` to search inside libraries.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"include_item_types": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Only list items of these types (e.g., `Movie`, `Series`, `MusicAlbum`). Omit to list all types.",
+			},
+			"recursive": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Whether to include items nested below the top level, such as movies inside libraries. " +
+					"Defaults to `false`; set it to `true` when searching the whole server.",
+			},
+			"max_items": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("The maximum number of items to return. Defaults to `%d`.", defaultItemsLimit),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"items": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The matching items, in the order returned by the server.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the item.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the item.",
+						},
+						"library_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The item ID of the library containing the item. Null if it isn't in a library.",
+						},
+					},
+				},
+			},
+			"truncated": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether more items matched than `max_items`, in which case only the first `max_items` are returned.",
+			},
+		},
+	}
+}
+
+func (d *ItemsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ItemsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ItemsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var itemTypes []string
+	if !data.IncludeItemTypes.IsNull() {
+		resp.Diagnostics.Append(data.IncludeItemTypes.ElementsAs(ctx, &itemTypes, false)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	limit := int64(defaultItemsLimit)
+	if !data.MaxItems.IsNull() && !data.MaxItems.IsUnknown() {
+		limit = data.MaxItems.ValueInt64()
+	}
+
+	items, truncated, err := d.client.SearchItems(ctx, client.ItemSearch{
+		ParentID:         data.ParentID.ValueString(),
+		SearchTerm:       data.SearchTerm.ValueString(),
+		IncludeItemTypes: itemTypes,
+		Recursive:        data.Recursive.ValueBool(),
+	}, int(limit))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list items: %s", err))
+		return
+	}
+
+	folders, err := d.client.GetVirtualFolders(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list libraries: %s", err))
+		return
+	}
+
+	resolver := newLibraryResolver(folders, func(ctx context.Context, id string) (string, error) {
+		item, err := d.client.GetItem(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return item.ParentId, nil
+	})
+
+	data.Items = make([]ItemsEntryModel, 0, len(items))
+	for _, item := range items {
+		libraryID, err := resolver.resolve(ctx, item.Id, item.ParentId)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve the library of item %s: %s", item.Id, err))
+			return
+		}
+
+		data.Items = append(data.Items, ItemsEntryModel{
+			ID:        types.StringValue(item.Id),
+			Name:      types.StringValue(item.Name),
+			LibraryID: optionalString(libraryID),
+		})
+	}
+
+	data.Truncated = types.BoolValue(truncated)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// libraryResolver finds the library an item belongs to by following ParentId links
+// until it reaches a library, caching each ancestor's parent along the way.
+type libraryResolver struct {
+	libraries map[string]string
+	parents   map[string]string
+	lookup    func(ctx context.Context, id string) (string, error)
+}
+
+func newLibraryResolver(folders []client.VirtualFolder, lookup func(ctx context.Context, id string) (string, error)) *libraryResolver {
+	// Item endpoints and /Library/VirtualFolders differ in GUID formatting, so libraries
+	// are keyed by normalized ID and mapped back to the library's own form.
+	libraries := make(map[string]string, len(folders))
+	for _, folder := range folders {
+		libraries[client.NormalizeGuid(folder.ItemId)] = folder.ItemId
+	}
+
+	return &libraryResolver{
+		libraries: libraries,
+		parents:   map[string]string{},
+		lookup:    lookup,
+	}
+}
+
+// resolve returns the library ID for the item with the given ID and parent, or "" if
+// the item isn't inside a library.
+func (r *libraryResolver) resolve(ctx context.Context, id, parentID string) (string, error) {
+	if libraryID, ok := r.libraries[client.NormalizeGuid(id)]; ok {
+		return libraryID, nil
+	}
+
+	current := parentID
+	for depth := 0; current != "" && depth < maxLibraryDepth; depth++ {
+		if libraryID, ok := r.libraries[client.NormalizeGuid(current)]; ok {
+			return libraryID, nil
+		}
+
+		parent, ok := r.parents[current]
+		if !ok {
+			var err error
+			parent, err = r.lookup(ctx, current)
+
+			if client.IsNotFound(err) {
+				return "", nil
+			}
+
+			if err != nil {
+				return "", err
+			}
+
+			r.parents[current] = parent
+		}
+
+		current = parent
+	}
+
+	return "", nil
+}
@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccItemsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t); testAccPreCheckLibrary(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccItemsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.jellyfin_items.movies", "items.#"),
+					resource.TestCheckResourceAttrSet("data.jellyfin_items.movies", "truncated"),
+					resource.TestCheckResourceAttr("data.jellyfin_items.one", "items.#", "1"),
+					resource.TestCheckResourceAttr("data.jellyfin_items.none", "items.#", "0"),
+					resource.TestCheckResourceAttr("data.jellyfin_items.none", "truncated", "false"),
+				),
+			},
+		},
+	})
+}
+
+const testAccItemsDataSourceConfig = `
+data "jellyfin_items" "movies" {
+  include_item_types = ["Movie"]
+  recursive          = true
+}
+
+data "jellyfin_items" "one" {
+  max_items = 1
+}
+
+data "jellyfin_items" "none" {
+  search_term        = "no item is called this"
+  include_item_types = ["Movie"]
+  recursive          = true
+}
+`
@@ -0,0 +1,234 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestItemsDataSource_Metadata(t *testing.T) {
+	ds := &ItemsDataSource{}
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_items"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestItemsDataSource_Schema(t *testing.T) {
+	ds := &ItemsDataSource{}
+	req := datasource.SchemaRequest{}
+	resp := &datasource.SchemaResponse{}
+
+	ds.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check parent_id attribute
+	parentIdAttr, ok := resp.Schema.Attributes["parent_id"]
+	if !ok {
+		t.Error("Expected 'parent_id' attribute in schema")
+	} else {
+		if !parentIdAttr.IsOptional() {
+			t.Error("Expected 'parent_id' attribute to be optional")
+		}
+	}
+
+	// Check search_term attribute
+	searchTermAttr, ok := resp.Schema.Attributes["search_term"]
+	if !ok {
+		t.Error("Expected 'search_term' attribute in schema")
+	} else {
+		if !searchTermAttr.IsOptional() {
+			t.Error("Expected 'search_term' attribute to be optional")
+		}
+	}
+
+	// Check include_item_types attribute
+	includeItemTypesAttr, ok := resp.Schema.Attributes["include_item_types"]
+	if !ok {
+		t.Error("Expected 'include_item_types' attribute in schema")
+	} else {
+		if !includeItemTypesAttr.IsOptional() {
+			t.Error("Expected 'include_item_types' attribute to be optional")
+		}
+	}
+
+	// Check recursive attribute
+	recursiveAttr, ok := resp.Schema.Attributes["recursive"]
+	if !ok {
+		t.Error("Expected 'recursive' attribute in schema")
+	} else {
+		if !recursiveAttr.IsOptional() {
+			t.Error("Expected 'recursive' attribute to be optional")
+		}
+	}
+
+	// Check max_items attribute
+	maxItemsAttr, ok := resp.Schema.Attributes["max_items"]
+	if !ok {
+		t.Error("Expected 'max_items' attribute in schema")
+	} else {
+		if !maxItemsAttr.IsOptional() {
+			t.Error("Expected 'max_items' attribute to be optional")
+		}
+	}
+
+	// Check items attribute
+	itemsAttr, ok := resp.Schema.Attributes["items"]
+	if !ok {
+		t.Error("Expected 'items' attribute in schema")
+	} else {
+		if !itemsAttr.IsComputed() {
+			t.Error("Expected 'items' attribute to be computed")
+		}
+	}
+
+	// Check truncated attribute
+	truncatedAttr, ok := resp.Schema.Attributes["truncated"]
+	if !ok {
+		t.Error("Expected 'truncated' attribute in schema")
+	} else {
+		if !truncatedAttr.IsComputed() {
+			t.Error("Expected 'truncated' attribute to be computed")
+		}
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestItemsDataSource_Configure_nilProviderData(t *testing.T) {
+	ds := &ItemsDataSource{}
+	req := datasource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestItemsDataSource_Configure_wrongType(t *testing.T) {
+	ds := &ItemsDataSource{}
+	req := datasource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestItemsDataSource_Configure_success(t *testing.T) {
+	ds := &ItemsDataSource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := datasource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if ds.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewItemsDataSource(t *testing.T) {
+	ds := NewItemsDataSource()
+	if ds == nil {
+		t.Error("Expected data source to be instantiated")
+	}
+
+	_, ok := ds.(*ItemsDataSource)
+	if !ok {
+		t.Error("Expected data source to be *ItemsDataSource")
+	}
+}
+
+func TestLibraryResolver_resolve(t *testing.T) {
+	parents := map[string]string{
+		"season-1": "series-1",
+		"series-1": "f137a2dd21bbc1b99aa5c0f6bf02a805",
+		"orphan":   "",
+	}
+
+	lookups := 0
+	resolver := newLibraryResolver([]client.VirtualFolder{
+		{Name: "Shows", ItemId: "f137a2dd21bbc1b99aa5c0f6bf02a805"},
+	}, func(ctx context.Context, id string) (string, error) {
+		lookups++
+		return parents[id], nil
+	})
+
+	// Ancestors are reported with dashes; the library ID is returned in its own form.
+	parents["series-1"] = "f137a2dd-21bb-c1b9-9aa5-c0f6bf02a805"
+
+	for i := 0; i < 2; i++ {
+		libraryID, err := resolver.resolve(context.Background(), "episode-1", "season-1")
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if libraryID != "f137a2dd21bbc1b99aa5c0f6bf02a805" {
+			t.Errorf("Expected library f137a2dd21bbc1b99aa5c0f6bf02a805, got %q", libraryID)
+		}
+	}
+
+	if lookups != 2 {
+		t.Errorf("Expected ancestors to be looked up once each, got %d lookups", lookups)
+	}
+
+	libraryID, err := resolver.resolve(context.Background(), "item-1", "orphan")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if libraryID != "" {
+		t.Errorf("Expected no library for an item outside a library, got %q", libraryID)
+	}
+}
+
+func TestLibraryResolver_notFound(t *testing.T) {
+	resolver := newLibraryResolver(nil, func(ctx context.Context, id string) (string, error) {
+		return "", &client.APIError{StatusCode: 404}
+	})
+
+	libraryID, err := resolver.resolve(context.Background(), "item-1", "deleted-parent")
+	if err != nil {
+		t.Fatalf("Expected a missing ancestor not to be an error, got %v", err)
+	}
+
+	if libraryID != "" {
+		t.Errorf("Expected no library, got %q", libraryID)
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+)
+
+// normalizeJSON re-encodes a JSON document with sorted object keys and no
+// insignificant whitespace, so semantically equal documents compare equal.
+// Numbers are kept as written, since values such as Jellyfin ticks (100 ns units)
+// exceed float64 precision.
+func normalizeJSON(raw string) (string, error) {
+	decoder := json.NewDecoder(strings.NewReader(raw))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return "", err
+	}
+
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return "", errors.New("invalid character after top-level value")
+	}
+
+	normalized, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	return string(normalized), nil
+}
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+)
+
+func TestNormalizeJSON(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"sorts keys", `{"b": 1, "a": 2}`, `{"a":2,"b":1}`},
+		{"nested objects", `{"z": {"y": true, "x": [3, 1]}}`, `{"z":{"x":[3,1],"y":true}}`},
+		{"whitespace", "{\n  \"a\" : \"b\"\n}", `{"a":"b"}`},
+		{"large ticks", `{"RunTimeTicks": 9007199254740993}`, `{"RunTimeTicks":9007199254740993}`},
+		{"number formatting", `{"a": 1.50, "b": -0, "c": 1e3}`, `{"a":1.50,"b":-0,"c":1e3}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			normalized, err := normalizeJSON(tc.input)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if normalized != tc.expected {
+				t.Errorf("Expected %s, got %s", tc.expected, normalized)
+			}
+		})
+	}
+}
+
+func TestNormalizeJSON_invalid(t *testing.T) {
+	for _, input := range []string{"{not json", `{"a": 1} {"b": 2}`, `{"a": 1}}`} {
+		if _, err := normalizeJSON(input); err == nil {
+			t.Errorf("Expected error for invalid JSON %s", input)
+		}
+	}
+}
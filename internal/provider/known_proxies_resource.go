@@ -0,0 +1,349 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// knownProxiesID is the fixed identifier of the singleton known proxies resource.
+const knownProxiesID = "known_proxies"
+
+// knownProxiesField is the network configuration field holding the trusted proxies.
+const knownProxiesField = "KnownProxies"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &KnownProxiesResource{}
+var _ resource.ResourceWithImportState = &KnownProxiesResource{}
+
+func NewKnownProxiesResource() resource.Resource {
+	return &KnownProxiesResource{}
+}
+
+// KnownProxiesResource defines the resource implementation.
+type KnownProxiesResource struct {
+	client *client.Client
+}
+
+// KnownProxiesResourceModel describes the resource data model.
+type KnownProxiesResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Proxies types.Set    `tfsdk:"proxies"`
+}
+
+func (r *KnownProxiesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_known_proxies"
+}
+
+func (r *KnownProxiesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Adds reverse proxies to the server's trusted proxies (`KnownProxies`), so Jellyfin uses the client address from their `X-Forwarded-For` header. " +
+			"Only the listed proxies are managed; proxies added outside Terraform are left alone. " +
+			"Destroying this resource removes the listed proxies.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the known proxies. Always `" + knownProxiesID + "`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"proxies": schema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The IP addresses or CIDR ranges of the proxies to trust (e.g., `10.0.0.5` or `172.16.0.0/12`).",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(ipOrCIDR{}),
+				},
+			},
+		},
+	}
+}
+
+func (r *KnownProxiesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *KnownProxiesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data KnownProxiesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var proxies []string
+	resp.Diagnostics.Append(data.Proxies.ElementsAs(ctx, &proxies, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.updateProxies(ctx, proxies, nil)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(knownProxiesID)
+
+	tflog.Trace(ctx, "Created known proxies resource", map[string]interface{}{
+		"proxies": len(proxies),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KnownProxiesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data KnownProxiesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var managed []string
+	resp.Diagnostics.Append(data.Proxies.ElementsAs(ctx, &managed, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.GetConfiguration(ctx, networkConfigurationKey)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read network configuration: %s", err))
+		return
+	}
+
+	// Keep only the managed proxies that are still trusted so that proxies removed
+	// outside Terraform show up as drift.
+	current := make(map[string]bool)
+	for _, proxy := range config.Strings(knownProxiesField) {
+		current[proxy] = true
+	}
+
+	proxies := make([]string, 0, len(managed))
+	for _, proxy := range managed {
+		if current[proxy] {
+			proxies = append(proxies, proxy)
+		}
+	}
+
+	if len(proxies) == 0 {
+		tflog.Debug(ctx, "No managed proxies remain, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	proxySet, diags := types.SetValueFrom(ctx, types.StringType, proxies)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(knownProxiesID)
+	data.Proxies = proxySet
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KnownProxiesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state KnownProxiesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planned, current []string
+	resp.Diagnostics.Append(plan.Proxies.ElementsAs(ctx, &planned, false)...)
+	resp.Diagnostics.Append(state.Proxies.ElementsAs(ctx, &current, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	added, removed := diffStringSets(current, planned)
+
+	resp.Diagnostics.Append(r.updateProxies(ctx, added, removed)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(knownProxiesID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *KnownProxiesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data KnownProxiesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var proxies []string
+	resp.Diagnostics.Append(data.Proxies.ElementsAs(ctx, &proxies, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.updateProxies(ctx, nil, proxies)...)
+
+	tflog.Trace(ctx, "Deleted known proxies resource")
+}
+
+func (r *KnownProxiesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID != knownProxiesID {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID %q, got: %q", knownProxiesID, req.ID),
+		)
+		return
+	}
+
+	config, err := r.client.GetConfiguration(ctx, networkConfigurationKey)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read network configuration: %s", err))
+		return
+	}
+
+	// Import adopts every proxy currently trusted by the server.
+	proxySet, diags := types.SetValueFrom(ctx, types.StringType, config.Strings(knownProxiesField))
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), knownProxiesID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("proxies"), proxySet)...)
+}
+
+// updateProxies adds and removes entries in the server's trusted proxies, keeping the
+// order of the existing entries and leaving all others untouched.
+func (r *KnownProxiesResource) updateProxies(ctx context.Context, add, remove []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(add) == 0 && len(remove) == 0 {
+		return diags
+	}
+
+	config, err := r.client.GetConfiguration(ctx, networkConfigurationKey)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read network configuration: %s", err))
+		return diags
+	}
+
+	proxies := mergeProxies(config.Strings(knownProxiesField), add, remove)
+
+	tflog.Debug(ctx, "Updating known proxies", map[string]interface{}{
+		"added":   len(add),
+		"removed": len(remove),
+	})
+
+	if _, err := r.client.PatchConfiguration(ctx, networkConfigurationKey, map[string]interface{}{knownProxiesField: proxies}); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update known proxies: %s", err))
+	}
+
+	return diags
+}
+
+// mergeProxies returns current without the entries in remove, followed by the entries
+// in add that aren't already present.
+func mergeProxies(current, add, remove []string) []string {
+	removed := make(map[string]bool, len(remove))
+	for _, proxy := range remove {
+		removed[proxy] = true
+	}
+
+	seen := make(map[string]bool, len(current)+len(add))
+	proxies := make([]string, 0, len(current)+len(add))
+
+	for _, proxy := range append(append([]string{}, current...), add...) {
+		if removed[proxy] || seen[proxy] {
+			continue
+		}
+		seen[proxy] = true
+		proxies = append(proxies, proxy)
+	}
+
+	return proxies
+}
+
+// ipOrCIDR validates that a string is an IP address or a CIDR range.
+type ipOrCIDR struct{}
+
+func (v ipOrCIDR) Description(ctx context.Context) string {
+	return "value must be an IP address or a CIDR range"
+}
+
+func (v ipOrCIDR) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipOrCIDR) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	if net.ParseIP(value) != nil {
+		return
+	}
+
+	if _, _, err := net.ParseCIDR(value); err == nil {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Proxy Address",
+		fmt.Sprintf("Expected an IP address or a CIDR range such as \"10.0.0.0/8\", got: %q", value),
+	)
+}
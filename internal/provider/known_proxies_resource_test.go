@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccKnownProxiesResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccKnownProxiesResourceConfig("192.0.2.10", "198.51.100.0/24"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_known_proxies.test", "id", "known_proxies"),
+					resource.TestCheckResourceAttr("jellyfin_known_proxies.test", "proxies.#", "2"),
+					resource.TestCheckTypeSetElemAttr("jellyfin_known_proxies.test", "proxies.*", "192.0.2.10"),
+					resource.TestCheckTypeSetElemAttr("jellyfin_known_proxies.test", "proxies.*", "198.51.100.0/24"),
+				),
+			},
+			// Update testing
+			{
+				Config: testAccKnownProxiesResourceConfig("192.0.2.11"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_known_proxies.test", "proxies.#", "1"),
+					resource.TestCheckTypeSetElemAttr("jellyfin_known_proxies.test", "proxies.*", "192.0.2.11"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKnownProxiesResource_invalidProxy(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKnownProxiesResourceConfig("proxy.internal"),
+				ExpectError: regexp.MustCompile("Invalid Proxy Address"),
+			},
+		},
+	})
+}
+
+func testAccKnownProxiesResourceConfig(proxies ...string) string {
+	quoted := make([]string, len(proxies))
+	for i, proxy := range proxies {
+		quoted[i] = fmt.Sprintf("%q", proxy)
+	}
+
+	return fmt.Sprintf(`
+resource "jellyfin_known_proxies" "test" {
+  proxies = [%s]
+}
+`, strings.Join(quoted, ", "))
+}
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestKnownProxiesResource_Metadata(t *testing.T) {
+	r := &KnownProxiesResource{}
+	req := resource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &resource.MetadataResponse{}
+
+	r.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_known_proxies"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestKnownProxiesResource_Schema(t *testing.T) {
+	r := &KnownProxiesResource{}
+	req := resource.SchemaRequest{}
+	resp := &resource.SchemaResponse{}
+
+	r.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check id attribute
+	idAttr, ok := resp.Schema.Attributes["id"]
+	if !ok {
+		t.Error("Expected 'id' attribute in schema")
+	} else {
+		if !idAttr.IsComputed() {
+			t.Error("Expected 'id' attribute to be computed")
+		}
+	}
+
+	// Check proxies attribute
+	proxiesAttr, ok := resp.Schema.Attributes["proxies"]
+	if !ok {
+		t.Error("Expected 'proxies' attribute in schema")
+	} else {
+		if !proxiesAttr.IsRequired() {
+			t.Error("Expected 'proxies' attribute to be required")
+		}
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestKnownProxiesResource_Configure_nilProviderData(t *testing.T) {
+	r := &KnownProxiesResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestKnownProxiesResource_Configure_wrongType(t *testing.T) {
+	r := &KnownProxiesResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestKnownProxiesResource_Configure_success(t *testing.T) {
+	r := &KnownProxiesResource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := resource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if r.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewKnownProxiesResource(t *testing.T) {
+	r := NewKnownProxiesResource()
+	if r == nil {
+		t.Error("Expected resource to be instantiated")
+	}
+
+	_, ok := r.(*KnownProxiesResource)
+	if !ok {
+		t.Error("Expected resource to be *KnownProxiesResource")
+	}
+}
+
+func TestMergeProxies(t *testing.T) {
+	current := []string{"10.0.0.1", "192.168.1.0/24", "172.16.0.5"}
+
+	merged := mergeProxies(current, []string{"10.0.0.2", "10.0.0.1"}, []string{"192.168.1.0/24"})
+
+	expected := []string{"10.0.0.1", "172.16.0.5", "10.0.0.2"}
+	if len(merged) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, merged)
+	}
+	for i := range expected {
+		if merged[i] != expected[i] {
+			t.Errorf("Expected %v, got %v", expected, merged)
+			break
+		}
+	}
+
+	if len(current) != 3 || current[1] != "192.168.1.0/24" {
+		t.Errorf("Expected current list to be left unmodified, got %v", current)
+	}
+}
+
+func TestIPOrCIDR(t *testing.T) {
+	tests := map[string]bool{
+		"10.0.0.5":       true,
+		"172.16.0.0/12":  true,
+		"fd00::1":        true,
+		"fd00::/8":       true,
+		"proxy.internal": false,
+		"10.0.0.0/33":    false,
+		"":               false,
+	}
+
+	for value, valid := range tests {
+		req := validator.StringRequest{
+			Path:        path.Root("proxies"),
+			ConfigValue: types.StringValue(value),
+		}
+		resp := &validator.StringResponse{}
+
+		ipOrCIDR{}.ValidateString(context.Background(), req, resp)
+
+		if resp.Diagnostics.HasError() == valid {
+			t.Errorf("Expected %q valid=%t, got diagnostics %v", value, valid, resp.Diagnostics)
+		}
+	}
+}
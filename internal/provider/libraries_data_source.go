@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// mixedCollectionType is the count_by_type key for libraries without a content type.
+const mixedCollectionType = "mixed"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &LibrariesDataSource{}
+
+func NewLibrariesDataSource() datasource.DataSource {
+	return &LibrariesDataSource{}
+}
+
+// LibrariesDataSource defines the data source implementation.
+type LibrariesDataSource struct {
+	client *client.Client
+}
+
+// LibrariesDataSourceModel describes the data source data model.
+type LibrariesDataSourceModel struct {
+	Libraries   []LibrariesEntryModel `tfsdk:"libraries"`
+	Count       types.Int64           `tfsdk:"library_count"`
+	CountByType types.Map             `tfsdk:"count_by_type"`
+}
+
+// LibrariesEntryModel describes a single library in the list.
+type LibrariesEntryModel struct {
+	Name           types.String `tfsdk:"name"`
+	CollectionType types.String `tfsdk:"collection_type"`
+	ItemID         types.String `tfsdk:"item_id"`
+	Locations      types.List   `tfsdk:"locations"`
+}
+
+func (d *LibrariesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_libraries"
+}
+
+func (d *LibrariesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists every library on the server, along with how many there are of each content type.",
+
+		Attributes: map[string]schema.Attribute{
+			"libraries": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The libraries, in the order returned by the server.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the library.",
+						},
+						"collection_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The content type of the library (e.g., `movies`, `tvshows`, `music`), or an empty string for mixed content.",
+						},
+						"item_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The item ID of the library.",
+						},
+						"locations": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The filesystem paths included in the library.",
+						},
+					},
+				},
+			},
+			"library_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of libraries.",
+			},
+			"count_by_type": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.Int64Type,
+				MarkdownDescription: fmt.Sprintf("The number of libraries of each content type (e.g., `{ movies = 2, tvshows = 1 }`). "+
+					"Libraries with mixed content are counted under `%s`.", mixedCollectionType),
+			},
+		},
+	}
+}
+
+func (d *LibrariesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *LibrariesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data LibrariesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	folders, err := d.client.GetVirtualFolders(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list libraries: %s", err))
+		return
+	}
+
+	data.Libraries = make([]LibrariesEntryModel, 0, len(folders))
+	for _, folder := range folders {
+		locations, diags := types.ListValueFrom(ctx, types.StringType, folder.Locations)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.Libraries = append(data.Libraries, LibrariesEntryModel{
+			Name:           types.StringValue(folder.Name),
+			CollectionType: types.StringValue(folder.CollectionType),
+			ItemID:         types.StringValue(folder.ItemId),
+			Locations:      locations,
+		})
+	}
+
+	countByType, diags := types.MapValueFrom(ctx, types.Int64Type, countLibrariesByType(folders))
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Count = types.Int64Value(int64(len(folders)))
+	data.CountByType = countByType
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// countLibrariesByType counts libraries per content type, counting those without one
+// under mixedCollectionType.
+func countLibrariesByType(folders []client.VirtualFolder) map[string]int64 {
+	counts := make(map[string]int64)
+
+	for _, folder := range folders {
+		collectionType := folder.CollectionType
+		if collectionType == "" {
+			collectionType = mixedCollectionType
+		}
+		counts[collectionType]++
+	}
+
+	return counts
+}
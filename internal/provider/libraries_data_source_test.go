@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLibrariesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t); testAccPreCheckLibrary(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLibrariesDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.jellyfin_libraries.test", "library_count"),
+					resource.TestCheckResourceAttrSet("data.jellyfin_libraries.test", "libraries.0.name"),
+					resource.TestCheckResourceAttrSet("data.jellyfin_libraries.test", "libraries.0.item_id"),
+					resource.TestCheckResourceAttrSet("data.jellyfin_libraries.test", "count_by_type.%"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLibrariesDataSourceConfig() string {
+	return `
+data "jellyfin_libraries" "test" {}
+`
+}
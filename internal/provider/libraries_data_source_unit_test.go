@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"maps"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestLibrariesDataSource_Metadata(t *testing.T) {
+	ds := &LibrariesDataSource{}
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_libraries"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestLibrariesDataSource_Schema(t *testing.T) {
+	ds := &LibrariesDataSource{}
+	req := datasource.SchemaRequest{}
+	resp := &datasource.SchemaResponse{}
+
+	ds.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check libraries attribute
+	librariesAttr, ok := resp.Schema.Attributes["libraries"]
+	if !ok {
+		t.Error("Expected 'libraries' attribute in schema")
+	} else {
+		if !librariesAttr.IsComputed() {
+			t.Error("Expected 'libraries' attribute to be computed")
+		}
+	}
+
+	// Check library_count attribute
+	countAttr, ok := resp.Schema.Attributes["library_count"]
+	if !ok {
+		t.Error("Expected 'library_count' attribute in schema")
+	} else {
+		if !countAttr.IsComputed() {
+			t.Error("Expected 'library_count' attribute to be computed")
+		}
+	}
+
+	// Check count_by_type attribute
+	countByTypeAttr, ok := resp.Schema.Attributes["count_by_type"]
+	if !ok {
+		t.Error("Expected 'count_by_type' attribute in schema")
+	} else {
+		if !countByTypeAttr.IsComputed() {
+			t.Error("Expected 'count_by_type' attribute to be computed")
+		}
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestLibrariesDataSource_Configure_nilProviderData(t *testing.T) {
+	ds := &LibrariesDataSource{}
+	req := datasource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestLibrariesDataSource_Configure_wrongType(t *testing.T) {
+	ds := &LibrariesDataSource{}
+	req := datasource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestLibrariesDataSource_Configure_success(t *testing.T) {
+	ds := &LibrariesDataSource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := datasource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if ds.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewLibrariesDataSource(t *testing.T) {
+	ds := NewLibrariesDataSource()
+	if ds == nil {
+		t.Error("Expected data source to be instantiated")
+	}
+
+	_, ok := ds.(*LibrariesDataSource)
+	if !ok {
+		t.Error("Expected data source to be *LibrariesDataSource")
+	}
+}
+
+func TestCountLibrariesByType(t *testing.T) {
+	counts := countLibrariesByType([]client.VirtualFolder{
+		{Name: "Movies", CollectionType: "movies"},
+		{Name: "Kids Movies", CollectionType: "movies"},
+		{Name: "Shows", CollectionType: "tvshows"},
+		{Name: "Home Videos"},
+	})
+
+	expected := map[string]int64{"movies": 2, "tvshows": 1, "mixed": 1}
+	if !maps.Equal(counts, expected) {
+		t.Errorf("Expected %v, got %v", expected, counts)
+	}
+
+	if counts := countLibrariesByType(nil); len(counts) != 0 {
+		t.Errorf("Expected no counts for no libraries, got %v", counts)
+	}
+}
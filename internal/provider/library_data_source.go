@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// defaultSizeItemLimit caps how many items are inspected when computing a library's size.
+const defaultSizeItemLimit = 1000
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &LibraryDataSource{}
+
+func NewLibraryDataSource() datasource.DataSource {
+	return &LibraryDataSource{}
+}
+
+// LibraryDataSource defines the data source implementation.
+type LibraryDataSource struct {
+	client *client.Client
+}
+
+// LibraryDataSourceModel describes the data source data model.
+type LibraryDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	ItemID         types.String `tfsdk:"item_id"`
+	Name           types.String `tfsdk:"name"`
+	CollectionType types.String `tfsdk:"collection_type"`
+	Locations      types.List   `tfsdk:"locations"`
+	SizeItemLimit  types.Int64  `tfsdk:"size_item_limit"`
+	SizeBytes      types.Int64  `tfsdk:"size_bytes"`
+	SizeTruncated  types.Bool   `tfsdk:"size_truncated"`
+}
+
+func (d *LibraryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_library"
+}
+
+func (d *LibraryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves information about a Jellyfin library, including its total media size.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The item ID of the library.",
+			},
+			"item_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The item ID of the library, for use as `library_id` in other resources. Same as `id`, and named to match the entries of `jellyfin_libraries`.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the library.",
+			},
+			"collection_type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The content type of the library (e.g., `movies`, `tvshows`, `music`).",
+			},
+			"locations": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The filesystem paths included in the library.",
+			},
+			"size_item_limit": schema.Int64Attribute{
+				Optional: true,
+				MarkdownDescription: fmt.Sprintf("The maximum number of items to inspect when computing `size_bytes`. "+
+					"Defaults to `%d`.", defaultSizeItemLimit),
+			},
+			"size_bytes": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The total size in bytes of the media files in the library, summed from the items' media sources.",
+			},
+			"size_truncated": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the library has more items than `size_item_limit`, in which case `size_bytes` is a lower bound.",
+			},
+		},
+	}
+}
+
+func (d *LibraryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *LibraryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data LibraryDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limit := int64(defaultSizeItemLimit)
+	if !data.SizeItemLimit.IsNull() && !data.SizeItemLimit.IsUnknown() {
+		limit = data.SizeItemLimit.ValueInt64()
+	}
+
+	if limit < 1 {
+		resp.Diagnostics.AddError(
+			"Invalid Attribute Value",
+			"'size_item_limit' must be at least 1.",
+		)
+		return
+	}
+
+	folder, err := d.client.FindVirtualFolderByName(ctx, data.Name.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read library: %s", err))
+		return
+	}
+
+	if folder == nil {
+		resp.Diagnostics.AddError(
+			"Library Not Found",
+			fmt.Sprintf("No library named %q was found.", data.Name.ValueString()),
+		)
+		return
+	}
+
+	size, truncated, err := d.client.GetLibrarySize(ctx, folder.ItemId, int(limit))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to compute library size: %s", err))
+		return
+	}
+
+	locations, diags := types.ListValueFrom(ctx, types.StringType, folder.Locations)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(folder.ItemId)
+	data.ItemID = types.StringValue(folder.ItemId)
+	data.Name = types.StringValue(folder.Name)
+	data.CollectionType = types.StringValue(folder.CollectionType)
+	data.Locations = locations
+	data.SizeBytes = types.Int64Value(size)
+	data.SizeTruncated = types.BoolValue(truncated)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
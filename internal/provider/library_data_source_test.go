@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLibraryDataSource_byName(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t); testAccPreCheckLibrary(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLibraryDataSourceConfig(os.Getenv("JELLYFIN_TEST_LIBRARY_NAME")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.jellyfin_library.test", "name", os.Getenv("JELLYFIN_TEST_LIBRARY_NAME")),
+					resource.TestCheckResourceAttrSet("data.jellyfin_library.test", "id"),
+					resource.TestCheckResourceAttrPair("data.jellyfin_library.test", "item_id", "data.jellyfin_library.test", "id"),
+					resource.TestCheckResourceAttrSet("data.jellyfin_library.test", "size_bytes"),
+					resource.TestCheckResourceAttrSet("data.jellyfin_library.test", "size_truncated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLibraryDataSource_notFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLibraryDataSourceConfig("non-existent-library-12345"),
+				ExpectError: regexp.MustCompile("Library Not Found"),
+			},
+		},
+	})
+}
+
+func testAccLibraryDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+data "jellyfin_library" "test" {
+  name = %[1]q
+}
+`, name)
+}
@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestLibraryDataSource_Metadata(t *testing.T) {
+	ds := &LibraryDataSource{}
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_library"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestLibraryDataSource_Schema(t *testing.T) {
+	ds := &LibraryDataSource{}
+	req := datasource.SchemaRequest{}
+	resp := &datasource.SchemaResponse{}
+
+	ds.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check id attribute
+	idAttr, ok := resp.Schema.Attributes["id"]
+	if !ok {
+		t.Error("Expected 'id' attribute in schema")
+	} else {
+		if !idAttr.IsComputed() {
+			t.Error("Expected 'id' attribute to be computed")
+		}
+	}
+
+	// Check item_id attribute
+	itemIDAttr, ok := resp.Schema.Attributes["item_id"]
+	if !ok {
+		t.Error("Expected 'item_id' attribute in schema")
+	} else {
+		if !itemIDAttr.IsComputed() {
+			t.Error("Expected 'item_id' attribute to be computed")
+		}
+	}
+
+	// Check name attribute
+	nameAttr, ok := resp.Schema.Attributes["name"]
+	if !ok {
+		t.Error("Expected 'name' attribute in schema")
+	} else {
+		if !nameAttr.IsRequired() {
+			t.Error("Expected 'name' attribute to be required")
+		}
+	}
+
+	// Check collection_type attribute
+	collectionTypeAttr, ok := resp.Schema.Attributes["collection_type"]
+	if !ok {
+		t.Error("Expected 'collection_type' attribute in schema")
+	} else {
+		if !collectionTypeAttr.IsComputed() {
+			t.Error("Expected 'collection_type' attribute to be computed")
+		}
+	}
+
+	// Check locations attribute
+	locationsAttr, ok := resp.Schema.Attributes["locations"]
+	if !ok {
+		t.Error("Expected 'locations' attribute in schema")
+	} else {
+		if !locationsAttr.IsComputed() {
+			t.Error("Expected 'locations' attribute to be computed")
+		}
+	}
+
+	// Check size_item_limit attribute
+	sizeItemLimitAttr, ok := resp.Schema.Attributes["size_item_limit"]
+	if !ok {
+		t.Error("Expected 'size_item_limit' attribute in schema")
+	} else {
+		if !sizeItemLimitAttr.IsOptional() {
+			t.Error("Expected 'size_item_limit' attribute to be optional")
+		}
+	}
+
+	// Check size_bytes attribute
+	sizeBytesAttr, ok := resp.Schema.Attributes["size_bytes"]
+	if !ok {
+		t.Error("Expected 'size_bytes' attribute in schema")
+	} else {
+		if !sizeBytesAttr.IsComputed() {
+			t.Error("Expected 'size_bytes' attribute to be computed")
+		}
+	}
+
+	// Check size_truncated attribute
+	sizeTruncatedAttr, ok := resp.Schema.Attributes["size_truncated"]
+	if !ok {
+		t.Error("Expected 'size_truncated' attribute in schema")
+	} else {
+		if !sizeTruncatedAttr.IsComputed() {
+			t.Error("Expected 'size_truncated' attribute to be computed")
+		}
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestLibraryDataSource_Configure_nilProviderData(t *testing.T) {
+	ds := &LibraryDataSource{}
+	req := datasource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestLibraryDataSource_Configure_wrongType(t *testing.T) {
+	ds := &LibraryDataSource{}
+	req := datasource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestLibraryDataSource_Configure_success(t *testing.T) {
+	ds := &LibraryDataSource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := datasource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if ds.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewLibraryDataSource(t *testing.T) {
+	ds := NewLibraryDataSource()
+	if ds == nil {
+		t.Error("Expected data source to be instantiated")
+	}
+
+	_, ok := ds.(*LibraryDataSource)
+	if !ok {
+		t.Error("Expected data source to be *LibraryDataSource")
+	}
+}
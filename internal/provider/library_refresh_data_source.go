@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &LibraryRefreshDataSource{}
+
+func NewLibraryRefreshDataSource() datasource.DataSource {
+	return &LibraryRefreshDataSource{}
+}
+
+// LibraryRefreshDataSource defines the data source implementation.
+type LibraryRefreshDataSource struct {
+	client *client.Client
+}
+
+// LibraryRefreshDataSourceModel describes the data source data model.
+type LibraryRefreshDataSourceModel struct {
+	ItemID          types.String  `tfsdk:"item_id"`
+	RefreshProgress types.Float64 `tfsdk:"refresh_progress"`
+	RefreshStatus   types.String  `tfsdk:"refresh_status"`
+}
+
+func (d *LibraryRefreshDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_library_refresh"
+}
+
+func (d *LibraryRefreshDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the refresh progress of a single library, " +
+			"so modules can wait for that library's scan to finish rather than the global library scan task.",
+
+		Attributes: map[string]schema.Attribute{
+			"item_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The item ID of the library, such as the `id` of the `jellyfin_library` data source.",
+			},
+			"refresh_progress": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The refresh progress from 0 to 100, or null when no refresh is running.",
+			},
+			"refresh_status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The refresh state reported by the server (e.g., `Idle` or `Active`). Empty if the item reports none.",
+			},
+		},
+	}
+}
+
+func (d *LibraryRefreshDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *LibraryRefreshDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data LibraryRefreshDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status, err := d.client.GetItemRefreshStatus(ctx, data.ItemID.ValueString())
+
+	if client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Item Not Found",
+			fmt.Sprintf("No item with ID %q was found.", data.ItemID.ValueString()),
+		)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read refresh status: %s", err))
+		return
+	}
+
+	data.RefreshStatus = types.StringValue(status.Status)
+	data.RefreshProgress = types.Float64PointerValue(status.Progress)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLibraryRefreshDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t); testAccPreCheckLibrary(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLibraryRefreshDataSourceConfig(os.Getenv("JELLYFIN_TEST_LIBRARY_NAME")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.jellyfin_library_refresh.test", "item_id", "data.jellyfin_library.test", "id"),
+					resource.TestCheckResourceAttrSet("data.jellyfin_library_refresh.test", "refresh_status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLibraryRefreshDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+data "jellyfin_library" "test" {
+  name = %[1]q
+}
+
+data "jellyfin_library_refresh" "test" {
+  item_id = data.jellyfin_library.test.id
+}
+`, name)
+}
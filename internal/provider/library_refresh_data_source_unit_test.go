@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestLibraryRefreshDataSource_Metadata(t *testing.T) {
+	ds := &LibraryRefreshDataSource{}
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_library_refresh"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestLibraryRefreshDataSource_Schema(t *testing.T) {
+	ds := &LibraryRefreshDataSource{}
+	req := datasource.SchemaRequest{}
+	resp := &datasource.SchemaResponse{}
+
+	ds.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check item_id attribute
+	itemIdAttr, ok := resp.Schema.Attributes["item_id"]
+	if !ok {
+		t.Error("Expected 'item_id' attribute in schema")
+	} else {
+		if !itemIdAttr.IsRequired() {
+			t.Error("Expected 'item_id' attribute to be required")
+		}
+	}
+
+	// Check refresh_progress attribute
+	refreshProgressAttr, ok := resp.Schema.Attributes["refresh_progress"]
+	if !ok {
+		t.Error("Expected 'refresh_progress' attribute in schema")
+	} else {
+		if !refreshProgressAttr.IsComputed() {
+			t.Error("Expected 'refresh_progress' attribute to be computed")
+		}
+	}
+
+	// Check refresh_status attribute
+	refreshStatusAttr, ok := resp.Schema.Attributes["refresh_status"]
+	if !ok {
+		t.Error("Expected 'refresh_status' attribute in schema")
+	} else {
+		if !refreshStatusAttr.IsComputed() {
+			t.Error("Expected 'refresh_status' attribute to be computed")
+		}
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestLibraryRefreshDataSource_Configure_nilProviderData(t *testing.T) {
+	ds := &LibraryRefreshDataSource{}
+	req := datasource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestLibraryRefreshDataSource_Configure_wrongType(t *testing.T) {
+	ds := &LibraryRefreshDataSource{}
+	req := datasource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestLibraryRefreshDataSource_Configure_success(t *testing.T) {
+	ds := &LibraryRefreshDataSource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := datasource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if ds.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewLibraryRefreshDataSource(t *testing.T) {
+	ds := NewLibraryRefreshDataSource()
+	if ds == nil {
+		t.Error("Expected data source to be instantiated")
+	}
+
+	_, ok := ds.(*LibraryRefreshDataSource)
+	if !ok {
+		t.Error("Expected data source to be *LibraryRefreshDataSource")
+	}
+}
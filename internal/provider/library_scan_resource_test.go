@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLibraryScanResource_basic(t *testing.T) {
+	rfc3339 := regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z$`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLibraryScanResourceConfig("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("jellyfin_library_scan.test", "last_run", rfc3339),
+					resource.TestCheckResourceAttrPair("jellyfin_library_scan.test", "id", "jellyfin_library_scan.test", "last_run"),
+					resource.TestCheckResourceAttr("jellyfin_library_scan.test", "triggers.run", "1"),
+				),
+			},
+			// Changing triggers scans again.
+			{
+				Config: testAccLibraryScanResourceConfig("2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("jellyfin_library_scan.test", "last_run", rfc3339),
+					resource.TestCheckResourceAttr("jellyfin_library_scan.test", "triggers.run", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLibraryScanResourceConfig(run string) string {
+	return fmt.Sprintf(`
+resource "jellyfin_library_scan" "test" {
+  triggers = {
+    run = %[1]q
+  }
+}
+`, run)
+}
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &MeDataSource{}
+
+func NewMeDataSource() datasource.DataSource {
+	return &MeDataSource{}
+}
+
+// MeDataSource defines the data source implementation.
+type MeDataSource struct {
+	client *client.Client
+}
+
+// MeDataSourceModel describes the data source data model.
+type MeDataSourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	LastActivityDate types.String `tfsdk:"last_activity_date"`
+}
+
+func (d *MeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_me"
+}
+
+func (d *MeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the user the provider is authenticated as. " +
+			"Requires username and password authentication, since API keys aren't tied to a user.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the authenticated user.",
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the authenticated user.",
+			},
+			"last_activity_date": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the user was last active, in RFC 3339 format (UTC). Null if the server doesn't report it.",
+			},
+		},
+	}
+}
+
+func (d *MeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *MeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data MeDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := d.client.GetCurrentUser(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read the authenticated user: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(user.Id)
+	data.Name = types.StringValue(user.Name)
+	data.LastActivityDate = normalizeJellyfinDate(user.LastActivityDate)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// normalizeJellyfinDate converts a Jellyfin timestamp to RFC 3339 in UTC, returning
+// null for empty, unparseable or zero values.
+func normalizeJellyfinDate(value string) types.String {
+	t, ok := parseJellyfinDate(value)
+	if !ok || t.IsZero() {
+		return types.StringNull()
+	}
+	return types.StringValue(t.UTC().Format(time.RFC3339))
+}
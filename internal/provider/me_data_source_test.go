@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMeDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMeDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.jellyfin_me.test", "id"),
+					resource.TestCheckResourceAttr("data.jellyfin_me.test", "name", os.Getenv("JELLYFIN_USERNAME")),
+					resource.TestCheckResourceAttrSet("data.jellyfin_me.test", "last_activity_date"),
+				),
+			},
+		},
+	})
+}
+
+const testAccMeDataSourceConfig = `
+data "jellyfin_me" "test" {}
+`
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestMeDataSource_Metadata(t *testing.T) {
+	ds := &MeDataSource{}
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_me"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestMeDataSource_Schema(t *testing.T) {
+	ds := &MeDataSource{}
+	req := datasource.SchemaRequest{}
+	resp := &datasource.SchemaResponse{}
+
+	ds.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check id attribute
+	idAttr, ok := resp.Schema.Attributes["id"]
+	if !ok {
+		t.Error("Expected 'id' attribute in schema")
+	} else {
+		if !idAttr.IsComputed() {
+			t.Error("Expected 'id' attribute to be computed")
+		}
+	}
+
+	// Check name attribute
+	nameAttr, ok := resp.Schema.Attributes["name"]
+	if !ok {
+		t.Error("Expected 'name' attribute in schema")
+	} else {
+		if !nameAttr.IsComputed() {
+			t.Error("Expected 'name' attribute to be computed")
+		}
+	}
+
+	// Check last_activity_date attribute
+	lastActivityDateAttr, ok := resp.Schema.Attributes["last_activity_date"]
+	if !ok {
+		t.Error("Expected 'last_activity_date' attribute in schema")
+	} else {
+		if !lastActivityDateAttr.IsComputed() {
+			t.Error("Expected 'last_activity_date' attribute to be computed")
+		}
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestMeDataSource_Configure_nilProviderData(t *testing.T) {
+	ds := &MeDataSource{}
+	req := datasource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestMeDataSource_Configure_wrongType(t *testing.T) {
+	ds := &MeDataSource{}
+	req := datasource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestMeDataSource_Configure_success(t *testing.T) {
+	ds := &MeDataSource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := datasource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if ds.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewMeDataSource(t *testing.T) {
+	ds := NewMeDataSource()
+	if ds == nil {
+		t.Error("Expected data source to be instantiated")
+	}
+
+	_, ok := ds.(*MeDataSource)
+	if !ok {
+		t.Error("Expected data source to be *MeDataSource")
+	}
+}
+
+func TestNormalizeJellyfinDate(t *testing.T) {
+	tests := map[string]string{
+		"2024-05-01T12:00:00.1234567Z":      "2024-05-01T12:00:00Z",
+		"2024-05-01T12:00:00.1234567":       "2024-05-01T12:00:00Z",
+		"2024-05-01T14:00:00.1234567+02:00": "2024-05-01T12:00:00Z",
+	}
+
+	for value, expected := range tests {
+		if got := normalizeJellyfinDate(value); got.ValueString() != expected {
+			t.Errorf("Expected %q to normalize to %q, got %q", value, expected, got.ValueString())
+		}
+	}
+
+	for _, value := range []string{"", "yesterday", "0001-01-01T00:00:00"} {
+		if got := normalizeJellyfinDate(value); !got.IsNull() {
+			t.Errorf("Expected %q to normalize to null, got %q", value, got.ValueString())
+		}
+	}
+}
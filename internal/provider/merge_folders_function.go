@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &MergeFoldersFunction{}
+
+func NewMergeFoldersFunction() function.Function {
+	return &MergeFoldersFunction{}
+}
+
+// MergeFoldersFunction defines the function implementation.
+type MergeFoldersFunction struct{}
+
+func (f *MergeFoldersFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "merge_folders"
+}
+
+func (f *MergeFoldersFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Merge lists of library IDs",
+		MarkdownDescription: "Combines any number of lists of library IDs into a single list with duplicates and empty strings removed, " +
+			"sorted so the result is stable regardless of input order. Useful when composing a user's `enabled_folders` from several modules.",
+		VariadicParameter: function.ListParameter{
+			ElementType:         types.StringType,
+			Name:                "lists",
+			MarkdownDescription: "Lists of library IDs to merge.",
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *MergeFoldersFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var lists [][]string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &lists))
+
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, mergeFolders(lists...)))
+}
+
+// mergeFolders returns the sorted union of the given lists, skipping empty IDs.
+func mergeFolders(lists ...[]string) []string {
+	seen := make(map[string]bool)
+	merged := []string{}
+
+	for _, list := range lists {
+		for _, id := range list {
+			if id == "" || seen[id] {
+				continue
+			}
+			seen[id] = true
+			merged = append(merged, id)
+		}
+	}
+
+	sort.Strings(merged)
+
+	return merged
+}
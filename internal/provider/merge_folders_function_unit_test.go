@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMergeFoldersFunction_Metadata(t *testing.T) {
+	f := &MergeFoldersFunction{}
+	resp := &function.MetadataResponse{}
+
+	f.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "merge_folders" {
+		t.Errorf("Expected Name 'merge_folders', got %q", resp.Name)
+	}
+}
+
+func TestMergeFoldersFunction_Definition(t *testing.T) {
+	f := &MergeFoldersFunction{}
+	resp := &function.DefinitionResponse{}
+
+	f.Definition(context.Background(), function.DefinitionRequest{}, resp)
+
+	if resp.Definition.VariadicParameter == nil {
+		t.Error("Expected a variadic parameter")
+	}
+
+	if len(resp.Definition.Parameters) != 0 {
+		t.Errorf("Expected 0 positional parameters, got %d", len(resp.Definition.Parameters))
+	}
+
+	if resp.Definition.Return == nil {
+		t.Error("Expected a return definition")
+	}
+}
+
+func TestMergeFoldersFunction_Run(t *testing.T) {
+	testCases := []struct {
+		name     string
+		lists    [][]string
+		expected []string
+	}{
+		{
+			name:     "no lists",
+			lists:    nil,
+			expected: []string{},
+		},
+		{
+			name:     "single list sorted",
+			lists:    [][]string{{"c", "a", "b"}},
+			expected: []string{"a", "b", "c"},
+		},
+		{
+			name:     "overlapping lists deduplicated",
+			lists:    [][]string{{"b", "a"}, {"c", "a"}, {"b"}},
+			expected: []string{"a", "b", "c"},
+		},
+		{
+			name:     "empty ids dropped",
+			lists:    [][]string{{"", "a"}, {}},
+			expected: []string{"a"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			args := make([]attr.Value, 0, len(tc.lists))
+			argTypes := make([]attr.Type, 0, len(tc.lists))
+			for _, list := range tc.lists {
+				value, diags := types.ListValueFrom(ctx, types.StringType, list)
+				if diags.HasError() {
+					t.Fatalf("Unexpected error building argument: %v", diags)
+				}
+				args = append(args, value)
+				argTypes = append(argTypes, types.ListType{ElemType: types.StringType})
+			}
+
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.TupleValueMust(argTypes, args),
+				}),
+			}
+			resp := &function.RunResponse{
+				Result: function.NewResultData(types.ListUnknown(types.StringType)),
+			}
+
+			(&MergeFoldersFunction{}).Run(ctx, req, resp)
+
+			if resp.Error != nil {
+				t.Fatalf("Unexpected error: %s", resp.Error)
+			}
+
+			expected, _ := types.ListValueFrom(ctx, types.StringType, tc.expected)
+			if !resp.Result.Value().Equal(expected) {
+				t.Errorf("Expected %s, got %s", expected, resp.Result.Value())
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NetworkConfigDataSource{}
+
+func NewNetworkConfigDataSource() datasource.DataSource {
+	return &NetworkConfigDataSource{}
+}
+
+// NetworkConfigDataSource defines the data source implementation.
+type NetworkConfigDataSource struct {
+	client *client.Client
+}
+
+// NetworkConfigDataSourceModel describes the data source data model.
+type NetworkConfigDataSourceModel struct {
+	EnableRemoteAccess types.Bool `tfsdk:"enable_remote_access"`
+}
+
+func (d *NetworkConfigDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_config"
+}
+
+func (d *NetworkConfigDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the server's network configuration, for example to assert that remote access is disabled. " +
+			"Use `jellyfin_network_ports` to change it.",
+
+		Attributes: map[string]schema.Attribute{
+			"enable_remote_access": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the server accepts connections from outside its local network.",
+			},
+		},
+	}
+}
+
+func (d *NetworkConfigDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *NetworkConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NetworkConfigDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := d.client.GetConfiguration(ctx, networkConfigurationKey)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read network configuration: %s", err))
+		return
+	}
+
+	data.EnableRemoteAccess = types.BoolValue(config.Bool(remoteAccessField))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
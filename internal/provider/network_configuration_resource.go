@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// networkConfigurationID is the fixed identifier of the singleton network configuration resource.
+const networkConfigurationID = "network_configuration"
+
+const (
+	// baseURLField is the network configuration field holding the URL path prefix.
+	baseURLField = "BaseUrl"
+
+	// enableHTTPSField is the network configuration field that turns on the HTTPS listener.
+	enableHTTPSField = "EnableHttps"
+)
+
+// baseURLRegexp matches an empty base URL or a path such as "/jellyfin" without a trailing slash.
+var baseURLRegexp = regexp.MustCompile(`^(/[^/\s]+)*$`)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NetworkConfigurationResource{}
+var _ resource.ResourceWithImportState = &NetworkConfigurationResource{}
+
+func NewNetworkConfigurationResource() resource.Resource {
+	return &NetworkConfigurationResource{}
+}
+
+// NetworkConfigurationResource defines the resource implementation.
+type NetworkConfigurationResource struct {
+	client *client.Client
+}
+
+// NetworkConfigurationResourceModel describes the resource data model.
+type NetworkConfigurationResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	BaseURL     types.String `tfsdk:"base_url"`
+	EnableHTTPS types.Bool   `tfsdk:"enable_https"`
+}
+
+func (r *NetworkConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_configuration"
+}
+
+func (r *NetworkConfigurationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the server's base URL and HTTPS listener. " +
+			"Use `jellyfin_network_ports` for ports and remote access, and `jellyfin_known_proxies` for trusted proxies. " +
+			"Only the attributes set in the configuration are written; all other network settings are preserved. " +
+			"Changes take effect after a server restart, and may require updating the provider's `endpoint`. " +
+			"There is one network configuration per server, and destroying this resource leaves the current settings in place.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the network configuration. Always `" + networkConfigurationID + "`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"base_url": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The URL path the server is served under, such as `/jellyfin` when behind a reverse proxy. Empty to serve from the root.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(baseURLRegexp, "must be empty or a path such as \"/jellyfin\" without a trailing slash"),
+				},
+			},
+			"enable_https": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether the server listens for HTTPS connections. Requires a certificate to be configured on the server.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *NetworkConfigurationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NetworkConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NetworkConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Created network configuration resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NetworkConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.GetConfiguration(ctx, networkConfigurationKey)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read network configuration: %s", err))
+		return
+	}
+
+	setNetworkConfigurationModel(&data, config)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data NetworkConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The network configuration can't be deleted; removing the resource from state
+	// simply stops Terraform from managing it.
+	tflog.Trace(ctx, "Deleted network configuration resource (no-op)")
+}
+
+func (r *NetworkConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply writes the configured settings to the server and refreshes the model from the result.
+// It warns when a setting changes, since the server's address changes after it restarts.
+func (r *NetworkConfigurationResource) apply(ctx context.Context, data *NetworkConfigurationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	current, err := r.client.GetConfiguration(ctx, networkConfigurationKey)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read network configuration: %s", err))
+		return diags
+	}
+
+	fields := networkConfigurationFields(data)
+
+	tflog.Debug(ctx, "Updating network configuration", map[string]interface{}{
+		"fields": len(fields),
+	})
+
+	config, err := r.client.PatchConfiguration(ctx, networkConfigurationKey, fields)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update network configuration: %s", err))
+		return diags
+	}
+
+	setNetworkConfigurationModel(data, config)
+
+	if current.String(baseURLField) != config.String(baseURLField) || current.Bool(enableHTTPSField) != config.Bool(enableHTTPSField) {
+		diags.AddWarning(
+			"Server Address Changing",
+			"The Jellyfin server serves the new base URL or HTTPS setting after it restarts. "+
+				"Update the provider's endpoint to match, or later runs, including this provider, will no longer be able to reach it.",
+		)
+	}
+
+	return diags
+}
+
+// networkConfigurationFields returns the network configuration fields set in the model.
+func networkConfigurationFields(data *NetworkConfigurationResourceModel) map[string]interface{} {
+	fields := map[string]interface{}{}
+
+	if !data.BaseURL.IsNull() && !data.BaseURL.IsUnknown() {
+		fields[baseURLField] = data.BaseURL.ValueString()
+	}
+
+	if !data.EnableHTTPS.IsNull() && !data.EnableHTTPS.IsUnknown() {
+		fields[enableHTTPSField] = data.EnableHTTPS.ValueBool()
+	}
+
+	return fields
+}
+
+// setNetworkConfigurationModel copies the managed settings from a network configuration into the model.
+func setNetworkConfigurationModel(data *NetworkConfigurationResourceModel, config client.ServerConfiguration) {
+	data.ID = types.StringValue(networkConfigurationID)
+	data.BaseURL = types.StringValue(config.String(baseURLField))
+	data.EnableHTTPS = types.BoolValue(config.Bool(enableHTTPSField))
+}
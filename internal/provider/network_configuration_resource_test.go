@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNetworkConfigurationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Write the defaults so the test server stays reachable.
+			{
+				Config: `
+resource "jellyfin_network_configuration" "test" {
+  base_url     = ""
+  enable_https = false
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_network_configuration.test", "id", "network_configuration"),
+					resource.TestCheckResourceAttr("jellyfin_network_configuration.test", "base_url", ""),
+					resource.TestCheckResourceAttr("jellyfin_network_configuration.test", "enable_https", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "jellyfin_network_configuration.test",
+				ImportState:       true,
+				ImportStateId:     "network_configuration",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetworkConfigurationResource_invalidBaseURL(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "jellyfin_network_configuration" "test" {
+  base_url = "jellyfin/"
+}
+`,
+				ExpectError: regexp.MustCompile("without a trailing slash"),
+			},
+		},
+	})
+}
@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestNetworkConfigurationResource_Metadata(t *testing.T) {
+	r := &NetworkConfigurationResource{}
+	req := resource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &resource.MetadataResponse{}
+
+	r.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_network_configuration"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestNetworkConfigurationResource_Schema(t *testing.T) {
+	r := &NetworkConfigurationResource{}
+	req := resource.SchemaRequest{}
+	resp := &resource.SchemaResponse{}
+
+	r.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check id attribute
+	idAttr, ok := resp.Schema.Attributes["id"]
+	if !ok {
+		t.Error("Expected 'id' attribute in schema")
+	} else {
+		if !idAttr.IsComputed() {
+			t.Error("Expected 'id' attribute to be computed")
+		}
+	}
+
+	// Check base_url attribute
+	baseUrlAttr, ok := resp.Schema.Attributes["base_url"]
+	if !ok {
+		t.Error("Expected 'base_url' attribute in schema")
+	} else {
+		if !baseUrlAttr.IsOptional() {
+			t.Error("Expected 'base_url' attribute to be optional")
+		}
+		if !baseUrlAttr.IsComputed() {
+			t.Error("Expected 'base_url' attribute to be computed")
+		}
+	}
+
+	// Check enable_https attribute
+	enableHttpsAttr, ok := resp.Schema.Attributes["enable_https"]
+	if !ok {
+		t.Error("Expected 'enable_https' attribute in schema")
+	} else {
+		if !enableHttpsAttr.IsOptional() {
+			t.Error("Expected 'enable_https' attribute to be optional")
+		}
+		if !enableHttpsAttr.IsComputed() {
+			t.Error("Expected 'enable_https' attribute to be computed")
+		}
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestNetworkConfigurationResource_Configure_nilProviderData(t *testing.T) {
+	r := &NetworkConfigurationResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestNetworkConfigurationResource_Configure_wrongType(t *testing.T) {
+	r := &NetworkConfigurationResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestNetworkConfigurationResource_Configure_success(t *testing.T) {
+	r := &NetworkConfigurationResource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := resource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if r.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewNetworkConfigurationResource(t *testing.T) {
+	r := NewNetworkConfigurationResource()
+	if r == nil {
+		t.Error("Expected resource to be instantiated")
+	}
+
+	_, ok := r.(*NetworkConfigurationResource)
+	if !ok {
+		t.Error("Expected resource to be *NetworkConfigurationResource")
+	}
+}
+
+func TestNetworkConfigurationFields(t *testing.T) {
+	got := networkConfigurationFields(&NetworkConfigurationResourceModel{
+		BaseURL:     types.StringValue("/jellyfin"),
+		EnableHTTPS: types.BoolValue(true),
+	})
+	expected := map[string]interface{}{"BaseUrl": "/jellyfin", "EnableHttps": true}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+
+	// Unset attributes leave the server's settings alone.
+	got = networkConfigurationFields(&NetworkConfigurationResourceModel{
+		BaseURL:     types.StringUnknown(),
+		EnableHTTPS: types.BoolNull(),
+	})
+
+	if len(got) != 0 {
+		t.Errorf("Expected no fields, got %v", got)
+	}
+}
+
+func TestSetNetworkConfigurationModel(t *testing.T) {
+	var config client.ServerConfiguration
+	if err := json.Unmarshal([]byte(`{"BaseUrl":"/jellyfin","EnableHttps":true,"InternalHttpPort":8096}`), &config); err != nil {
+		t.Fatalf("Failed to decode configuration: %v", err)
+	}
+
+	var data NetworkConfigurationResourceModel
+	setNetworkConfigurationModel(&data, config)
+
+	if data.ID.ValueString() != networkConfigurationID {
+		t.Errorf("Expected id %q, got %s", networkConfigurationID, data.ID)
+	}
+
+	if data.BaseURL.ValueString() != "/jellyfin" || !data.EnableHTTPS.ValueBool() {
+		t.Errorf("Expected base URL /jellyfin with HTTPS enabled, got %s and %s", data.BaseURL, data.EnableHTTPS)
+	}
+}
+
+func TestBaseURLRegexp(t *testing.T) {
+	for _, value := range []string{"", "/jellyfin", "/media/jellyfin"} {
+		if !baseURLRegexp.MatchString(value) {
+			t.Errorf("Expected %q to be a valid base URL", value)
+		}
+	}
+
+	for _, value := range []string{"/", "jellyfin", "/jellyfin/", "//jellyfin", "/jelly fin"} {
+		if baseURLRegexp.MatchString(value) {
+			t.Errorf("Expected %q to be an invalid base URL", value)
+		}
+	}
+}
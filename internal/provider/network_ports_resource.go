@@ -0,0 +1,315 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// networkPortsID is the fixed identifier of the singleton network ports resource.
+const networkPortsID = "network_ports"
+
+// networkConfigurationKey is the configuration section holding the network settings.
+const networkConfigurationKey = "network"
+
+// remoteAccessField is the network configuration field that allows connections from
+// outside the local network.
+const remoteAccessField = "EnableRemoteAccess"
+
+// networkPortField maps a port attribute to its network configuration field. Jellyfin
+// 10.9 renamed the fields, so the legacy name is used when the server still reports it.
+type networkPortField struct {
+	attribute string
+	current   string
+	legacy    string
+}
+
+var networkPortFields = []networkPortField{
+	{attribute: "http_port", current: "InternalHttpPort", legacy: "HttpServerPortNumber"},
+	{attribute: "https_port", current: "InternalHttpsPort", legacy: "HttpsPortNumber"},
+	{attribute: "public_http_port", current: "PublicHttpPort", legacy: "PublicPort"},
+	{attribute: "public_https_port", current: "PublicHttpsPort", legacy: "PublicHttpsPort"},
+}
+
+// name returns the field name the server uses in config.
+func (f networkPortField) name(config client.ServerConfiguration) string {
+	if !config.Has(f.current) && config.Has(f.legacy) {
+		return f.legacy
+	}
+	return f.current
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NetworkPortsResource{}
+var _ resource.ResourceWithImportState = &NetworkPortsResource{}
+
+func NewNetworkPortsResource() resource.Resource {
+	return &NetworkPortsResource{}
+}
+
+// NetworkPortsResource defines the resource implementation.
+type NetworkPortsResource struct {
+	client *client.Client
+}
+
+// NetworkPortsResourceModel describes the resource data model.
+type NetworkPortsResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	HTTPPort        types.Int64  `tfsdk:"http_port"`
+	HTTPSPort       types.Int64  `tfsdk:"https_port"`
+	PublicHTTPPort  types.Int64  `tfsdk:"public_http_port"`
+	PublicHTTPSPort types.Int64  `tfsdk:"public_https_port"`
+	RemoteAccess    types.Bool   `tfsdk:"enable_remote_access"`
+}
+
+// ports returns the model's port attributes keyed by attribute name.
+func (m *NetworkPortsResourceModel) ports() map[string]*types.Int64 {
+	return map[string]*types.Int64{
+		"http_port":         &m.HTTPPort,
+		"https_port":        &m.HTTPSPort,
+		"public_http_port":  &m.PublicHTTPPort,
+		"public_https_port": &m.PublicHTTPSPort,
+	}
+}
+
+func (r *NetworkPortsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_ports"
+}
+
+func (r *NetworkPortsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	portAttribute := func(description string) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: description,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+			Validators: []validator.Int64{
+				int64validator.Between(1, 65535),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the ports the Jellyfin server listens on and advertises, and whether it accepts remote connections. " +
+			"Only the attributes set in the configuration are written; all other network settings are preserved. " +
+			"The server only binds new ports after a restart, and changing the port the provider's `endpoint` uses breaks the provider's own connection until the endpoint is updated. " +
+			"There is one network configuration per server, and destroying this resource leaves the current ports in place.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the network ports. Always `" + networkPortsID + "`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"http_port":         portAttribute("The local HTTP port the server listens on."),
+			"https_port":        portAttribute("The local HTTPS port the server listens on."),
+			"public_http_port":  portAttribute("The public HTTP port advertised to remote clients, e.g. when behind port forwarding."),
+			"public_https_port": portAttribute("The public HTTPS port advertised to remote clients, e.g. when behind port forwarding."),
+			"enable_remote_access": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "Whether the server accepts connections from outside its local network. " +
+					"Disabling it also blocks the provider itself when Terraform runs from a remote network.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *NetworkPortsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NetworkPortsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NetworkPortsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Created network ports resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkPortsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NetworkPortsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.GetConfiguration(ctx, networkConfigurationKey)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read network configuration: %s", err))
+		return
+	}
+
+	setNetworkPortsModel(&data, config)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkPortsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data NetworkPortsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkPortsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The network configuration can't be deleted; removing the resource from state
+	// simply stops Terraform from managing it.
+	tflog.Trace(ctx, "Deleted network ports resource (no-op)")
+}
+
+func (r *NetworkPortsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply writes the configured ports to the server and refreshes the model from the result.
+// It warns when a port changes, since the server only picks up new ports on restart.
+func (r *NetworkPortsResource) apply(ctx context.Context, data *NetworkPortsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	current, err := r.client.GetConfiguration(ctx, networkConfigurationKey)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read network configuration: %s", err))
+		return diags
+	}
+
+	ports := data.ports()
+	fields := map[string]interface{}{}
+	var changed []string
+
+	for _, field := range networkPortFields {
+		value := ports[field.attribute]
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		name := field.name(current)
+		fields[name] = value.ValueInt64()
+
+		if current.Int64(name) != value.ValueInt64() {
+			changed = append(changed, field.attribute)
+		}
+	}
+
+	disablingRemoteAccess := false
+	if !data.RemoteAccess.IsNull() && !data.RemoteAccess.IsUnknown() {
+		fields[remoteAccessField] = data.RemoteAccess.ValueBool()
+		disablingRemoteAccess = current.Bool(remoteAccessField) && !data.RemoteAccess.ValueBool()
+	}
+
+	tflog.Debug(ctx, "Updating network ports", map[string]interface{}{
+		"fields":  len(fields),
+		"changed": changed,
+	})
+
+	config, err := r.client.PatchConfiguration(ctx, networkConfigurationKey, fields)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update network configuration: %s", err))
+		return diags
+	}
+
+	setNetworkPortsModel(data, config)
+
+	if len(changed) > 0 {
+		diags.Append(portsChangedWarning(changed))
+	}
+
+	if disablingRemoteAccess {
+		diags.AddWarning(
+			"Remote Access Disabled",
+			"The Jellyfin server now rejects connections from outside its local network. "+
+				"Terraform runs from a remote network, including this provider, will no longer be able to reach it.",
+		)
+	}
+
+	return diags
+}
+
+// portsChangedWarning warns that changed ports only apply after a restart, and that the
+// provider loses its connection if its endpoint uses one of them.
+func portsChangedWarning(changed []string) diag.Diagnostic {
+	return diag.NewWarningDiagnostic(
+		"Server Restart Required",
+		fmt.Sprintf("The Jellyfin server must be restarted before changes to %s take effect. ", strings.Join(changed, ", "))+
+			"If the provider's endpoint uses a changed port, update it to match, or later runs, including this provider, will no longer be able to reach the server.",
+	)
+}
+
+// setNetworkPortsModel copies the ports from a network configuration into the model.
+func setNetworkPortsModel(data *NetworkPortsResourceModel, config client.ServerConfiguration) {
+	ports := data.ports()
+
+	data.ID = types.StringValue(networkPortsID)
+
+	for _, field := range networkPortFields {
+		*ports[field.attribute] = types.Int64Value(config.Int64(field.name(config)))
+	}
+
+	data.RemoteAccess = types.BoolValue(config.Bool(remoteAccessField))
+}
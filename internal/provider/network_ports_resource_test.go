@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNetworkPortsResource_publicPorts(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccNetworkPortsResourceConfig(8096, 8920),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_network_ports.test", "id", "network_ports"),
+					resource.TestCheckResourceAttr("jellyfin_network_ports.test", "public_http_port", "8096"),
+					resource.TestCheckResourceAttr("jellyfin_network_ports.test", "public_https_port", "8920"),
+					resource.TestCheckResourceAttrSet("jellyfin_network_ports.test", "http_port"),
+					resource.TestCheckResourceAttrSet("jellyfin_network_ports.test", "enable_remote_access"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "jellyfin_network_ports.test",
+				ImportState:       true,
+				ImportStateId:     "network_ports",
+				ImportStateVerify: true,
+			},
+			// Update testing
+			{
+				Config: testAccNetworkPortsResourceConfig(18096, 18920),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_network_ports.test", "public_http_port", "18096"),
+					resource.TestCheckResourceAttr("jellyfin_network_ports.test", "public_https_port", "18920"),
+				),
+			},
+			// Restore the defaults
+			{
+				Config: testAccNetworkPortsResourceConfig(8096, 8920),
+			},
+		},
+	})
+}
+
+func TestAccNetworkPortsResource_invalidPort(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccNetworkPortsResourceConfig(0, 8920),
+				ExpectError: regexp.MustCompile("must be between 1 and 65535"),
+			},
+			{
+				Config:      testAccNetworkPortsResourceConfig(8096, 70000),
+				ExpectError: regexp.MustCompile("must be between 1 and 65535"),
+			},
+		},
+	})
+}
+
+func testAccNetworkPortsResourceConfig(publicHTTPPort, publicHTTPSPort int) string {
+	return fmt.Sprintf(`
+resource "jellyfin_network_ports" "test" {
+  public_http_port  = %[1]d
+  public_https_port = %[2]d
+}
+`, publicHTTPPort, publicHTTPSPort)
+}
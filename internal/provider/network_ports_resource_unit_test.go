@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestNetworkPortsResource_Metadata(t *testing.T) {
+	r := &NetworkPortsResource{}
+	req := resource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &resource.MetadataResponse{}
+
+	r.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_network_ports"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestNetworkPortsResource_Schema(t *testing.T) {
+	r := &NetworkPortsResource{}
+	req := resource.SchemaRequest{}
+	resp := &resource.SchemaResponse{}
+
+	r.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check id attribute
+	idAttr, ok := resp.Schema.Attributes["id"]
+	if !ok {
+		t.Error("Expected 'id' attribute in schema")
+	} else {
+		if !idAttr.IsComputed() {
+			t.Error("Expected 'id' attribute to be computed")
+		}
+	}
+
+	// Check http_port attribute
+	httpPortAttr, ok := resp.Schema.Attributes["http_port"]
+	if !ok {
+		t.Error("Expected 'http_port' attribute in schema")
+	} else {
+		if !httpPortAttr.IsOptional() {
+			t.Error("Expected 'http_port' attribute to be optional")
+		}
+		if !httpPortAttr.IsComputed() {
+			t.Error("Expected 'http_port' attribute to be computed")
+		}
+	}
+
+	// Check https_port attribute
+	httpsPortAttr, ok := resp.Schema.Attributes["https_port"]
+	if !ok {
+		t.Error("Expected 'https_port' attribute in schema")
+	} else {
+		if !httpsPortAttr.IsOptional() {
+			t.Error("Expected 'https_port' attribute to be optional")
+		}
+		if !httpsPortAttr.IsComputed() {
+			t.Error("Expected 'https_port' attribute to be computed")
+		}
+	}
+
+	// Check public_http_port attribute
+	publicHttpPortAttr, ok := resp.Schema.Attributes["public_http_port"]
+	if !ok {
+		t.Error("Expected 'public_http_port' attribute in schema")
+	} else {
+		if !publicHttpPortAttr.IsOptional() {
+			t.Error("Expected 'public_http_port' attribute to be optional")
+		}
+		if !publicHttpPortAttr.IsComputed() {
+			t.Error("Expected 'public_http_port' attribute to be computed")
+		}
+	}
+
+	// Check public_https_port attribute
+	publicHttpsPortAttr, ok := resp.Schema.Attributes["public_https_port"]
+	if !ok {
+		t.Error("Expected 'public_https_port' attribute in schema")
+	} else {
+		if !publicHttpsPortAttr.IsOptional() {
+			t.Error("Expected 'public_https_port' attribute to be optional")
+		}
+		if !publicHttpsPortAttr.IsComputed() {
+			t.Error("Expected 'public_https_port' attribute to be computed")
+		}
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestNetworkPortsResource_Configure_nilProviderData(t *testing.T) {
+	r := &NetworkPortsResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestNetworkPortsResource_Configure_wrongType(t *testing.T) {
+	r := &NetworkPortsResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestNetworkPortsResource_Configure_success(t *testing.T) {
+	r := &NetworkPortsResource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := resource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if r.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewNetworkPortsResource(t *testing.T) {
+	r := NewNetworkPortsResource()
+	if r == nil {
+		t.Error("Expected resource to be instantiated")
+	}
+
+	_, ok := r.(*NetworkPortsResource)
+	if !ok {
+		t.Error("Expected resource to be *NetworkPortsResource")
+	}
+}
+
+func TestSetNetworkPortsModel(t *testing.T) {
+	tests := map[string]client.ServerConfiguration{
+		"current": {
+			"InternalHttpPort":   json.RawMessage(`8096`),
+			"InternalHttpsPort":  json.RawMessage(`8920`),
+			"PublicHttpPort":     json.RawMessage(`80`),
+			"PublicHttpsPort":    json.RawMessage(`443`),
+			"EnableRemoteAccess": json.RawMessage(`true`),
+		},
+		"legacy": {
+			"HttpServerPortNumber": json.RawMessage(`8096`),
+			"HttpsPortNumber":      json.RawMessage(`8920`),
+			"PublicPort":           json.RawMessage(`80`),
+			"PublicHttpsPort":      json.RawMessage(`443`),
+			"EnableRemoteAccess":   json.RawMessage(`true`),
+		},
+	}
+
+	for name, config := range tests {
+		t.Run(name, func(t *testing.T) {
+			var data NetworkPortsResourceModel
+			setNetworkPortsModel(&data, config)
+
+			if data.ID.ValueString() != networkPortsID {
+				t.Errorf("Expected ID %q, got %q", networkPortsID, data.ID.ValueString())
+			}
+			if data.HTTPPort.ValueInt64() != 8096 {
+				t.Errorf("Expected http_port 8096, got %d", data.HTTPPort.ValueInt64())
+			}
+			if data.HTTPSPort.ValueInt64() != 8920 {
+				t.Errorf("Expected https_port 8920, got %d", data.HTTPSPort.ValueInt64())
+			}
+			if data.PublicHTTPPort.ValueInt64() != 80 {
+				t.Errorf("Expected public_http_port 80, got %d", data.PublicHTTPPort.ValueInt64())
+			}
+			if data.PublicHTTPSPort.ValueInt64() != 443 {
+				t.Errorf("Expected public_https_port 443, got %d", data.PublicHTTPSPort.ValueInt64())
+			}
+			if !data.RemoteAccess.ValueBool() {
+				t.Error("Expected enable_remote_access to be true")
+			}
+		})
+	}
+}
+
+func TestPortsChangedWarning(t *testing.T) {
+	d := portsChangedWarning([]string{"http_port", "public_http_port"})
+
+	if d.Severity() != diag.SeverityWarning {
+		t.Errorf("Expected a warning, got %s", d.Severity())
+	}
+
+	if !strings.Contains(d.Detail(), "http_port, public_http_port") {
+		t.Errorf("Expected the changed ports to be listed, got %s", d.Detail())
+	}
+
+	if !strings.Contains(d.Detail(), "provider's endpoint") {
+		t.Errorf("Expected a warning about the provider's own connection, got %s", d.Detail())
+	}
+}
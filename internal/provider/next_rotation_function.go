@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// jellyfinDateLayouts are the timestamp formats Jellyfin uses for fields such as
+// DateCreated, which carry seven fractional digits and may omit the zone (UTC).
+var jellyfinDateLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.9999999",
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &NextRotationFunction{}
+
+func NewNextRotationFunction() function.Function {
+	return &NextRotationFunction{}
+}
+
+// NextRotationFunction defines the function implementation.
+type NextRotationFunction struct{}
+
+func (f *NextRotationFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "next_rotation"
+}
+
+func (f *NextRotationFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Compute when a key is due for rotation",
+		MarkdownDescription: "Adds a rotation interval to a Jellyfin creation timestamp, such as the `date_created` of a `jellyfin_api_key`, " +
+			"and returns the resulting time in RFC 3339 format (UTC).",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "created",
+				MarkdownDescription: "The creation timestamp as returned by Jellyfin (e.g., `2024-01-01T00:00:00.0000000Z`).",
+			},
+			function.StringParameter{
+				Name:                "interval",
+				MarkdownDescription: "The rotation interval as a duration string (e.g., `720h` for 30 days).",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *NextRotationFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var created, interval string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &created, &interval))
+
+	if resp.Error != nil {
+		return
+	}
+
+	createdAt, ok := parseJellyfinDate(created)
+	if !ok {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0,
+			fmt.Sprintf("Expected a timestamp such as \"2024-01-01T00:00:00.0000000Z\", got %q.", created)))
+		return
+	}
+
+	every, err := time.ParseDuration(interval)
+	if err != nil || every <= 0 {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1,
+			fmt.Sprintf("Expected a positive duration such as \"720h\", got %q.", interval)))
+		return
+	}
+
+	next := createdAt.Add(every).UTC().Format(time.RFC3339)
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, next))
+}
+
+// parseJellyfinDate parses a Jellyfin timestamp, treating one without a zone as UTC.
+func parseJellyfinDate(value string) (time.Time, bool) {
+	for _, layout := range jellyfinDateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
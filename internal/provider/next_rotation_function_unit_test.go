@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNextRotationFunction_Metadata(t *testing.T) {
+	f := &NextRotationFunction{}
+	resp := &function.MetadataResponse{}
+
+	f.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "next_rotation" {
+		t.Errorf("Expected Name 'next_rotation', got %q", resp.Name)
+	}
+}
+
+func TestNextRotationFunction_Definition(t *testing.T) {
+	f := &NextRotationFunction{}
+	resp := &function.DefinitionResponse{}
+
+	f.Definition(context.Background(), function.DefinitionRequest{}, resp)
+
+	if len(resp.Definition.Parameters) != 2 {
+		t.Errorf("Expected 2 parameters, got %d", len(resp.Definition.Parameters))
+	}
+
+	if resp.Definition.Return == nil {
+		t.Error("Expected a return definition")
+	}
+}
+
+func TestNextRotationFunction_Run(t *testing.T) {
+	testCases := []struct {
+		name      string
+		created   string
+		interval  string
+		expected  string
+		expectErr bool
+	}{
+		{
+			name:     "seven digit fraction",
+			created:  "2024-01-01T00:00:00.1234567Z",
+			interval: "720h",
+			expected: "2024-01-31T00:00:00Z",
+		},
+		{
+			name:     "no zone treated as UTC",
+			created:  "2024-01-01T06:30:00.0000000",
+			interval: "1h30m",
+			expected: "2024-01-01T08:00:00Z",
+		},
+		{
+			name:     "offset converted to UTC",
+			created:  "2024-01-01T00:00:00+02:00",
+			interval: "24h",
+			expected: "2024-01-01T22:00:00Z",
+		},
+		{
+			name:      "invalid timestamp",
+			created:   "yesterday",
+			interval:  "24h",
+			expectErr: true,
+		},
+		{
+			name:      "invalid interval",
+			created:   "2024-01-01T00:00:00.0000000Z",
+			interval:  "30 days",
+			expectErr: true,
+		},
+		{
+			name:      "non-positive interval",
+			created:   "2024-01-01T00:00:00.0000000Z",
+			interval:  "0s",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.StringValue(tc.created),
+					types.StringValue(tc.interval),
+				}),
+			}
+			resp := &function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+			}
+
+			(&NextRotationFunction{}).Run(ctx, req, resp)
+
+			if tc.expectErr {
+				if resp.Error == nil {
+					t.Error("Expected an error")
+				}
+				return
+			}
+
+			if resp.Error != nil {
+				t.Fatalf("Unexpected error: %s", resp.Error)
+			}
+
+			if !resp.Result.Value().Equal(types.StringValue(tc.expected)) {
+				t.Errorf("Expected %s, got %s", tc.expected, resp.Result.Value())
+			}
+		})
+	}
+}
@@ -0,0 +1,518 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// webhookPluginName is the name of the Jellyfin plugin that stores notification targets.
+const webhookPluginName = "Webhook"
+
+// webhookNameField is the field identifying a target within the plugin configuration.
+const webhookNameField = "WebhookName"
+
+// notificationTargetLists maps each target type to the plugin configuration list holding it.
+var notificationTargetLists = map[string]string{
+	"discord":      "DiscordOptions",
+	"generic":      "GenericOptions",
+	"generic_form": "GenericFormOptions",
+	"gotify":       "GotifyOptions",
+	"mqtt":         "MqttOptions",
+	"pushbullet":   "PushbulletOptions",
+	"pushover":     "PushoverOptions",
+	"slack":        "SlackOptions",
+	"smtp":         "SmtpOptions",
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NotificationTargetResource{}
+var _ resource.ResourceWithImportState = &NotificationTargetResource{}
+
+func NewNotificationTargetResource() resource.Resource {
+	return &NotificationTargetResource{}
+}
+
+// NotificationTargetResource defines the resource implementation.
+type NotificationTargetResource struct {
+	client *client.Client
+}
+
+// NotificationTargetResourceModel describes the resource data model.
+type NotificationTargetResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Type         types.String `tfsdk:"type"`
+	SettingsJSON types.String `tfsdk:"settings_json"`
+}
+
+func (r *NotificationTargetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_target"
+}
+
+func (r *NotificationTargetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a notification target (e.g. a Discord or generic webhook) in the configuration of the Jellyfin " +
+			"[Webhook plugin](https://github.com/jellyfin/jellyfin-plugin-webhook), which must already be installed. " +
+			"Only the target with the given name is modified; other targets are preserved.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for this resource, in the form `type/name`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the target (`WebhookName` in the plugin configuration). Must be unique per type.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The kind of target. One of `" + strings.Join(notificationTargetTypes(), "`, `") + "`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(notificationTargetTypes()...),
+				},
+			},
+			"settings_json": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: "The target's settings as a JSON object using the plugin's field names " +
+					"(e.g. `jsonencode({ WebhookUri = \"https://...\", EnableMovies = true })`). " +
+					"Fields not listed keep their current values, and only the listed fields are checked for drift.",
+			},
+		},
+	}
+}
+
+func (r *NotificationTargetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NotificationTargetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NotificationTargetResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := decodeTargetSettings(data.SettingsJSON.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("settings_json"), "Invalid Settings JSON", err.Error())
+		return
+	}
+
+	plugin, diags := r.findWebhookPlugin(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listKey := notificationTargetLists[data.Type.ValueString()]
+	name := data.Name.ValueString()
+
+	tflog.Debug(ctx, "Creating notification target", map[string]interface{}{
+		"name": name,
+		"type": data.Type.ValueString(),
+	})
+
+	var exists bool
+	_, err = r.client.PatchPluginConfiguration(ctx, plugin.Id, func(config json.RawMessage) (json.RawMessage, error) {
+		existing, err := findNotificationTarget(config, listKey, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse configuration: %w", err)
+		}
+
+		if existing != nil {
+			exists = true
+			return nil, nil
+		}
+
+		return upsertNotificationTarget(config, listKey, name, settings)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update webhook plugin configuration: %s", err))
+		return
+	}
+
+	if exists {
+		resp.Diagnostics.AddError(
+			"Notification Target Already Exists",
+			fmt.Sprintf("A %s target named %q already exists. Import it with: terraform import <address> %s/%s", data.Type.ValueString(), name, data.Type.ValueString(), name),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(data.Type.ValueString() + "/" + name)
+
+	tflog.Trace(ctx, "Created notification target resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NotificationTargetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NotificationTargetResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plugin, err := r.client.FindPluginByName(ctx, webhookPluginName)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list plugins: %s", err))
+		return
+	}
+
+	// Without the plugin the target no longer exists
+	if plugin == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	config, err := r.client.GetPluginConfiguration(ctx, plugin.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read webhook plugin configuration: %s", err))
+		return
+	}
+
+	entry, err := findNotificationTarget(config, notificationTargetLists[data.Type.ValueString()], data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse webhook plugin configuration: %s", err))
+		return
+	}
+
+	if entry == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Only report the fields the configuration manages, so server-side defaults don't show as drift
+	var managed map[string]json.RawMessage
+	if !data.SettingsJSON.IsNull() && data.SettingsJSON.ValueString() != "" {
+		managed, _ = decodeTargetSettings(data.SettingsJSON.ValueString())
+	}
+
+	settings, err := projectTargetSettings(entry, managed)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to encode notification target settings: %s", err))
+		return
+	}
+
+	// Keep the configured formatting when the settings are semantically unchanged
+	if prior, err := normalizeJSON(data.SettingsJSON.ValueString()); err != nil || prior != settings {
+		data.SettingsJSON = types.StringValue(settings)
+	}
+
+	data.ID = types.StringValue(data.Type.ValueString() + "/" + data.Name.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NotificationTargetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data NotificationTargetResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := decodeTargetSettings(data.SettingsJSON.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("settings_json"), "Invalid Settings JSON", err.Error())
+		return
+	}
+
+	plugin, diags := r.findWebhookPlugin(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err = r.client.PatchPluginConfiguration(ctx, plugin.Id, func(config json.RawMessage) (json.RawMessage, error) {
+		return upsertNotificationTarget(config, notificationTargetLists[data.Type.ValueString()], data.Name.ValueString(), settings)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update webhook plugin configuration: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NotificationTargetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NotificationTargetResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plugin, err := r.client.FindPluginByName(ctx, webhookPluginName)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list plugins: %s", err))
+		return
+	}
+
+	// Nothing to remove if the plugin is already gone
+	if plugin == nil {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting notification target", map[string]interface{}{
+		"name": data.Name.ValueString(),
+		"type": data.Type.ValueString(),
+	})
+
+	_, err = r.client.PatchPluginConfiguration(ctx, plugin.Id, func(config json.RawMessage) (json.RawMessage, error) {
+		updated, removed, err := removeNotificationTarget(config, notificationTargetLists[data.Type.ValueString()], data.Name.ValueString())
+		if err != nil || !removed {
+			return nil, err
+		}
+		return updated, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update webhook plugin configuration: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted notification target resource")
+}
+
+func (r *NotificationTargetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	targetType, name, ok := strings.Cut(req.ID, "/")
+
+	if !ok || name == "" || notificationTargetLists[targetType] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in the form 'type/name' where type is one of %s, got: %q", strings.Join(notificationTargetTypes(), ", "), req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), targetType)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+// findWebhookPlugin looks up the Webhook plugin, returning an error diagnostic if it isn't installed.
+func (r *NotificationTargetResource) findWebhookPlugin(ctx context.Context) (*client.Plugin, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	plugin, err := r.client.FindPluginByName(ctx, webhookPluginName)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list plugins: %s", err))
+		return nil, diags
+	}
+
+	if plugin == nil {
+		diags.AddError(
+			"Webhook Plugin Not Installed",
+			"Notification targets are stored by the Jellyfin Webhook plugin, which is not installed on this server. "+
+				"Install it from the plugin catalog (for example with the jellyfin_plugin resource) and restart the server before managing notification targets.",
+		)
+		return nil, diags
+	}
+
+	return plugin, diags
+}
+
+// notificationTargetTypes returns the supported target types in sorted order.
+func notificationTargetTypes() []string {
+	targetTypes := make([]string, 0, len(notificationTargetLists))
+	for targetType := range notificationTargetLists {
+		targetTypes = append(targetTypes, targetType)
+	}
+	sort.Strings(targetTypes)
+	return targetTypes
+}
+
+// decodeTargetSettings parses settings_json, which must be a JSON object.
+func decodeTargetSettings(raw string) (map[string]json.RawMessage, error) {
+	var settings map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(raw), &settings); err != nil || settings == nil {
+		return nil, fmt.Errorf("settings_json must be a JSON object")
+	}
+	delete(settings, webhookNameField)
+	return settings, nil
+}
+
+// decodeTargetList returns the plugin configuration and the target list stored under listKey.
+func decodeTargetList(config json.RawMessage, listKey string) (map[string]json.RawMessage, []map[string]json.RawMessage, error) {
+	fields := map[string]json.RawMessage{}
+	if len(config) > 0 {
+		if err := json.Unmarshal(config, &fields); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var targets []map[string]json.RawMessage
+	if raw, ok := fields[listKey]; ok && string(raw) != "null" {
+		if err := json.Unmarshal(raw, &targets); err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", listKey, err)
+		}
+	}
+
+	return fields, targets, nil
+}
+
+// targetName returns the WebhookName of a target entry.
+func targetName(target map[string]json.RawMessage) string {
+	var name string
+	_ = json.Unmarshal(target[webhookNameField], &name)
+	return name
+}
+
+// findNotificationTarget returns the target named name from the list, or nil if there is none.
+func findNotificationTarget(config json.RawMessage, listKey, name string) (map[string]json.RawMessage, error) {
+	_, targets, err := decodeTargetList(config, listKey)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, target := range targets {
+		if targetName(target) == name {
+			return target, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// upsertNotificationTarget merges settings into the target named name, appending a new
+// target if none exists, and returns the complete plugin configuration.
+func upsertNotificationTarget(config json.RawMessage, listKey, name string, settings map[string]json.RawMessage) (json.RawMessage, error) {
+	fields, targets, err := decodeTargetList(config, listKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var target map[string]json.RawMessage
+	for _, existing := range targets {
+		if targetName(existing) == name {
+			target = existing
+			break
+		}
+	}
+
+	if target == nil {
+		target = map[string]json.RawMessage{}
+		targets = append(targets, target)
+	}
+
+	for key, value := range settings {
+		target[key] = value
+	}
+
+	encodedName, err := json.Marshal(name)
+	if err != nil {
+		return nil, err
+	}
+	target[webhookNameField] = encodedName
+
+	return encodeTargetList(fields, listKey, targets)
+}
+
+// removeNotificationTarget drops the target named name and returns the complete plugin
+// configuration, reporting whether anything was removed.
+func removeNotificationTarget(config json.RawMessage, listKey, name string) (json.RawMessage, bool, error) {
+	fields, targets, err := decodeTargetList(config, listKey)
+	if err != nil {
+		return nil, false, err
+	}
+
+	kept := make([]map[string]json.RawMessage, 0, len(targets))
+	for _, target := range targets {
+		if targetName(target) != name {
+			kept = append(kept, target)
+		}
+	}
+
+	if len(kept) == len(targets) {
+		return config, false, nil
+	}
+
+	updated, err := encodeTargetList(fields, listKey, kept)
+	return updated, true, err
+}
+
+// encodeTargetList stores targets under listKey and encodes the plugin configuration.
+func encodeTargetList(fields map[string]json.RawMessage, listKey string, targets []map[string]json.RawMessage) (json.RawMessage, error) {
+	encodedTargets, err := json.Marshal(targets)
+	if err != nil {
+		return nil, err
+	}
+	fields[listKey] = encodedTargets
+
+	return json.Marshal(fields)
+}
+
+// projectTargetSettings encodes a target's settings as normalized JSON. When managed is
+// non-nil only those keys are included; WebhookName is always omitted.
+func projectTargetSettings(target, managed map[string]json.RawMessage) (string, error) {
+	projected := map[string]json.RawMessage{}
+	for key, value := range target {
+		if key == webhookNameField {
+			continue
+		}
+		if managed != nil {
+			if _, ok := managed[key]; !ok {
+				continue
+			}
+		}
+		projected[key] = value
+	}
+
+	encoded, err := json.Marshal(projected)
+	if err != nil {
+		return "", err
+	}
+
+	return normalizeJSON(string(encoded))
+}
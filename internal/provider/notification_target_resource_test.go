@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNotificationTargetResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if os.Getenv("JELLYFIN_TEST_WEBHOOK_PLUGIN") == "" {
+				t.Skip("JELLYFIN_TEST_WEBHOOK_PLUGIN must be set when the Webhook plugin is installed")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccNotificationTargetResourceConfig("https://hooks.example.com/one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_notification_target.test", "id", "generic/tf-acc-test"),
+					resource.TestCheckResourceAttr("jellyfin_notification_target.test", "type", "generic"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "jellyfin_notification_target.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"settings_json"},
+			},
+			// Update testing
+			{
+				Config: testAccNotificationTargetResourceConfig("https://hooks.example.com/two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_notification_target.test", "name", "tf-acc-test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNotificationTargetResourceConfig(uri string) string {
+	return fmt.Sprintf(`
+resource "jellyfin_notification_target" "test" {
+  name = "tf-acc-test"
+  type = "generic"
+  settings_json = jsonencode({
+    WebhookUri   = %[1]q
+    EnableMovies = true
+  })
+}
+`, uri)
+}
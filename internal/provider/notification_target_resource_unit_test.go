@@ -0,0 +1,352 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestNotificationTargetResource_Metadata(t *testing.T) {
+	r := &NotificationTargetResource{}
+	req := resource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &resource.MetadataResponse{}
+
+	r.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_notification_target"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestNotificationTargetResource_Schema(t *testing.T) {
+	r := &NotificationTargetResource{}
+	req := resource.SchemaRequest{}
+	resp := &resource.SchemaResponse{}
+
+	r.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check id attribute
+	idAttr, ok := resp.Schema.Attributes["id"]
+	if !ok {
+		t.Error("Expected 'id' attribute in schema")
+	} else {
+		if !idAttr.IsComputed() {
+			t.Error("Expected 'id' attribute to be computed")
+		}
+	}
+
+	// Check name attribute
+	nameAttr, ok := resp.Schema.Attributes["name"]
+	if !ok {
+		t.Error("Expected 'name' attribute in schema")
+	} else {
+		if !nameAttr.IsRequired() {
+			t.Error("Expected 'name' attribute to be required")
+		}
+	}
+
+	// Check type attribute
+	typeAttr, ok := resp.Schema.Attributes["type"]
+	if !ok {
+		t.Error("Expected 'type' attribute in schema")
+	} else {
+		if !typeAttr.IsRequired() {
+			t.Error("Expected 'type' attribute to be required")
+		}
+	}
+
+	// Check settings_json attribute
+	settingsJsonAttr, ok := resp.Schema.Attributes["settings_json"]
+	if !ok {
+		t.Error("Expected 'settings_json' attribute in schema")
+	} else {
+		if !settingsJsonAttr.IsRequired() {
+			t.Error("Expected 'settings_json' attribute to be required")
+		}
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestNotificationTargetResource_Configure_nilProviderData(t *testing.T) {
+	r := &NotificationTargetResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestNotificationTargetResource_Configure_wrongType(t *testing.T) {
+	r := &NotificationTargetResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestNotificationTargetResource_Configure_success(t *testing.T) {
+	r := &NotificationTargetResource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := resource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if r.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewNotificationTargetResource(t *testing.T) {
+	r := NewNotificationTargetResource()
+	if r == nil {
+		t.Error("Expected resource to be instantiated")
+	}
+
+	_, ok := r.(*NotificationTargetResource)
+	if !ok {
+		t.Error("Expected resource to be *NotificationTargetResource")
+	}
+}
+
+const testWebhookConfig = `{
+  "ServerUrl": "http://jellyfin:8096",
+  "DiscordOptions": [
+    {"WebhookName": "ops", "WebhookUri": "https://discord.example/ops", "EnableMovies": true, "AvatarUrl": "x"},
+    {"WebhookName": "family", "WebhookUri": "https://discord.example/family"}
+  ],
+  "GenericOptions": []
+}`
+
+func TestUpsertNotificationTarget_updatesExisting(t *testing.T) {
+	settings, err := decodeTargetSettings(`{"WebhookUri": "https://discord.example/new", "EnableMovies": false}`)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	updated, err := upsertNotificationTarget([]byte(testWebhookConfig), "DiscordOptions", "ops", settings)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	target, err := findNotificationTarget(updated, "DiscordOptions", "ops")
+	if err != nil || target == nil {
+		t.Fatalf("Expected target to be found, got %v (err %v)", target, err)
+	}
+
+	if string(target["WebhookUri"]) != `"https://discord.example/new"` {
+		t.Errorf("Expected WebhookUri to be updated, got %s", target["WebhookUri"])
+	}
+
+	if string(target["EnableMovies"]) != "false" {
+		t.Errorf("Expected EnableMovies to be updated, got %s", target["EnableMovies"])
+	}
+
+	// Fields not in the settings are left alone
+	if string(target["AvatarUrl"]) != `"x"` {
+		t.Errorf("Expected AvatarUrl to be preserved, got %s", target["AvatarUrl"])
+	}
+
+	// Other targets and settings are untouched
+	other, _ := findNotificationTarget(updated, "DiscordOptions", "family")
+	if other == nil {
+		t.Error("Expected other target to be preserved")
+	}
+
+	normalized, _ := normalizeJSON(string(updated))
+	if !strings.Contains(normalized, `"ServerUrl":"http://jellyfin:8096"`) {
+		t.Errorf("Expected ServerUrl to be preserved, got %s", normalized)
+	}
+}
+
+func TestUpsertNotificationTarget_appendsNew(t *testing.T) {
+	settings, _ := decodeTargetSettings(`{"WebhookUri": "https://hooks.example/generic"}`)
+
+	updated, err := upsertNotificationTarget([]byte(testWebhookConfig), "GenericOptions", "alerts", settings)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	target, _ := findNotificationTarget(updated, "GenericOptions", "alerts")
+	if target == nil {
+		t.Fatal("Expected new target to be added")
+	}
+
+	if string(target["WebhookName"]) != `"alerts"` {
+		t.Errorf("Expected WebhookName to be set, got %s", target["WebhookName"])
+	}
+
+	// A list missing entirely from the configuration is created
+	updated, err = upsertNotificationTarget([]byte(`{}`), "SlackOptions", "chat", settings)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if target, _ := findNotificationTarget(updated, "SlackOptions", "chat"); target == nil {
+		t.Error("Expected target to be added to a new list")
+	}
+}
+
+// TestUpsertNotificationTarget_concurrent adds targets in parallel, as several
+// jellyfin_notification_target resources in one apply do. Run it with -race.
+func TestUpsertNotificationTarget_concurrent(t *testing.T) {
+	var mu sync.Mutex
+	stored := []byte(testWebhookConfig)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			mu.Lock()
+			body := stored
+			mu.Unlock()
+
+			// Hold the read so an unserialized upsert would overlap with another.
+			time.Sleep(10 * time.Millisecond)
+
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(body)
+		case http.MethodPost:
+			body, _ := io.ReadAll(r.Body)
+
+			mu.Lock()
+			stored = body
+			mu.Unlock()
+
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "test-api-key")
+	settings, _ := decodeTargetSettings(`{"WebhookUri": "https://hooks.example/generic"}`)
+
+	const targets = 10
+
+	var wg sync.WaitGroup
+	for i := 0; i < targets; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := c.PatchPluginConfiguration(context.Background(), "webhook", func(config json.RawMessage) (json.RawMessage, error) {
+				return upsertNotificationTarget(config, "GenericOptions", "target-"+strconv.Itoa(i), settings)
+			})
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	for i := 0; i < targets; i++ {
+		name := "target-" + strconv.Itoa(i)
+		if target, _ := findNotificationTarget(stored, "GenericOptions", name); target == nil {
+			t.Errorf("Expected target %s to be kept", name)
+		}
+	}
+
+	if target, _ := findNotificationTarget(stored, "DiscordOptions", "ops"); target == nil {
+		t.Error("Expected existing targets to be preserved")
+	}
+}
+
+func TestRemoveNotificationTarget(t *testing.T) {
+	updated, removed, err := removeNotificationTarget([]byte(testWebhookConfig), "DiscordOptions", "ops")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !removed {
+		t.Error("Expected target to be removed")
+	}
+
+	if target, _ := findNotificationTarget(updated, "DiscordOptions", "ops"); target != nil {
+		t.Error("Expected removed target to be absent")
+	}
+
+	if target, _ := findNotificationTarget(updated, "DiscordOptions", "family"); target == nil {
+		t.Error("Expected other target to be preserved")
+	}
+
+	_, removed, err = removeNotificationTarget([]byte(testWebhookConfig), "DiscordOptions", "missing")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if removed {
+		t.Error("Expected nothing to be removed for an unknown target")
+	}
+}
+
+func TestProjectTargetSettings(t *testing.T) {
+	target, _ := findNotificationTarget([]byte(testWebhookConfig), "DiscordOptions", "ops")
+	managed, _ := decodeTargetSettings(`{"WebhookUri": "", "EnableMovies": false}`)
+
+	projected, err := projectTargetSettings(target, managed)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := `{"EnableMovies":true,"WebhookUri":"https://discord.example/ops"}`
+	if projected != expected {
+		t.Errorf("Expected %s, got %s", expected, projected)
+	}
+
+	all, _ := projectTargetSettings(target, nil)
+	if !strings.Contains(all, `"AvatarUrl":"x"`) || strings.Contains(all, "WebhookName") {
+		t.Errorf("Expected all fields except WebhookName, got %s", all)
+	}
+}
+
+func TestDecodeTargetSettings_invalid(t *testing.T) {
+	for _, raw := range []string{`[]`, `"text"`, `not json`, `null`} {
+		if _, err := decodeTargetSettings(raw); err == nil {
+			t.Errorf("Expected error for %s", raw)
+		}
+	}
+}
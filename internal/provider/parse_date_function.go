@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ParseDateFunction{}
+
+func NewParseDateFunction() function.Function {
+	return &ParseDateFunction{}
+}
+
+// ParseDateFunction defines the function implementation.
+type ParseDateFunction struct{}
+
+func (f *ParseDateFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_date"
+}
+
+func (f *ParseDateFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Convert a Jellyfin timestamp to RFC 3339",
+		MarkdownDescription: "Returns a timestamp as returned by Jellyfin, such as an API key's `date_created`, in RFC 3339 format in UTC " +
+			"(e.g., `2024-01-01T00:00:00Z`), so it can be used with `timeadd`, `timecmp` and `formatdate`. " +
+			"Jellyfin's seven fractional digits are dropped, and a timestamp without a zone is taken to be UTC.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "timestamp",
+				MarkdownDescription: "The timestamp as returned by Jellyfin (e.g., `2024-01-01T00:00:00.0000000Z`).",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ParseDateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var timestamp string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &timestamp))
+
+	if resp.Error != nil {
+		return
+	}
+
+	t, ok := parseJellyfinDate(timestamp)
+	if !ok {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0,
+			fmt.Sprintf("Expected a timestamp such as \"2024-01-01T00:00:00.0000000Z\", got %q.", timestamp)))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, t.UTC().Format(time.RFC3339)))
+}
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseDateFunction_Metadata(t *testing.T) {
+	f := &ParseDateFunction{}
+	resp := &function.MetadataResponse{}
+
+	f.Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "parse_date" {
+		t.Errorf("Expected Name 'parse_date', got %q", resp.Name)
+	}
+}
+
+func TestParseDateFunction_Definition(t *testing.T) {
+	f := &ParseDateFunction{}
+	resp := &function.DefinitionResponse{}
+
+	f.Definition(context.Background(), function.DefinitionRequest{}, resp)
+
+	if len(resp.Definition.Parameters) != 1 {
+		t.Errorf("Expected 1 parameter, got %d", len(resp.Definition.Parameters))
+	}
+
+	if resp.Definition.Return == nil {
+		t.Error("Expected a return definition")
+	}
+}
+
+func TestParseDateFunction_Run(t *testing.T) {
+	testCases := []struct {
+		name      string
+		timestamp string
+		expected  string
+		expectErr bool
+	}{
+		{
+			name:      "seven fractional digits",
+			timestamp: "2024-01-01T00:00:00.0000000Z",
+			expected:  "2024-01-01T00:00:00Z",
+		},
+		{
+			name:      "fraction dropped",
+			timestamp: "2024-03-15T12:34:56.7891234Z",
+			expected:  "2024-03-15T12:34:56Z",
+		},
+		{
+			name:      "no fraction",
+			timestamp: "2024-03-15T12:34:56Z",
+			expected:  "2024-03-15T12:34:56Z",
+		},
+		{
+			name:      "no zone",
+			timestamp: "2024-03-15T12:34:56.1234567",
+			expected:  "2024-03-15T12:34:56Z",
+		},
+		{
+			name:      "no zone or fraction",
+			timestamp: "2024-03-15T12:34:56",
+			expected:  "2024-03-15T12:34:56Z",
+		},
+		{
+			name:      "offset converted to UTC",
+			timestamp: "2024-03-15T12:34:56.0000000+02:00",
+			expected:  "2024-03-15T10:34:56Z",
+		},
+		{
+			name:      "unparseable",
+			timestamp: "yesterday",
+			expectErr: true,
+		},
+		{
+			name:      "empty",
+			timestamp: "",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.StringValue(tc.timestamp),
+				}),
+			}
+			resp := &function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+			}
+
+			(&ParseDateFunction{}).Run(ctx, req, resp)
+
+			if tc.expectErr {
+				if resp.Error == nil {
+					t.Error("Expected an error")
+				}
+				return
+			}
+
+			if resp.Error != nil {
+				t.Fatalf("Unexpected error: %s", resp.Error)
+			}
+
+			if !resp.Result.Value().Equal(types.StringValue(tc.expected)) {
+				t.Errorf("Expected %s, got %s", tc.expected, resp.Result.Value())
+			}
+		})
+	}
+}
@@ -0,0 +1,243 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PluginCatalogDataSource{}
+
+func NewPluginCatalogDataSource() datasource.DataSource {
+	return &PluginCatalogDataSource{}
+}
+
+// PluginCatalogDataSource defines the data source implementation.
+type PluginCatalogDataSource struct {
+	client *client.Client
+}
+
+// PluginCatalogDataSourceModel describes the data source data model.
+type PluginCatalogDataSourceModel struct {
+	Installed        types.Bool                `tfsdk:"installed"`
+	Plugins          []PluginCatalogEntryModel `tfsdk:"plugins"`
+	UpdatesAvailable types.Int64               `tfsdk:"updates_available"`
+}
+
+// PluginCatalogEntryModel describes a single plugin in the catalog.
+type PluginCatalogEntryModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Installed        types.Bool   `tfsdk:"installed"`
+	InstalledVersion types.String `tfsdk:"installed_version"`
+	LatestVersion    types.String `tfsdk:"latest_version"`
+	UpdateAvailable  types.Bool   `tfsdk:"update_available"`
+}
+
+func (d *PluginCatalogDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_plugin_catalog"
+}
+
+func (d *PluginCatalogDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists installed plugins together with the packages available from the server's plugin repositories, " +
+			"so outdated plugins can be detected in a single lookup.",
+
+		Attributes: map[string]schema.Attribute{
+			"installed": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "When set, only plugins whose installed state matches this value are returned. Omit to list both installed and available plugins.",
+			},
+			"plugins": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The plugins in the catalog, sorted by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The GUID of the plugin.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the plugin.",
+						},
+						"installed": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the plugin is installed on the server.",
+						},
+						"installed_version": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The installed version, or null if the plugin is not installed.",
+						},
+						"latest_version": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The newest version offered by the plugin repositories, or null if no repository offers the plugin.",
+						},
+						"update_available": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the plugin is installed and a newer version is available.",
+						},
+					},
+				},
+			},
+			"updates_available": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of installed plugins with a newer version available.",
+			},
+		},
+	}
+}
+
+func (d *PluginCatalogDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PluginCatalogDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PluginCatalogDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plugins, err := d.client.GetPlugins(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list installed plugins: %s", err))
+		return
+	}
+
+	packages, err := d.client.GetPackages(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list available packages: %s", err))
+		return
+	}
+
+	tflog.Debug(ctx, "Building plugin catalog", map[string]interface{}{
+		"installed": len(plugins),
+		"packages":  len(packages),
+	})
+
+	entries := buildPluginCatalog(plugins, packages)
+
+	data.Plugins = []PluginCatalogEntryModel{}
+	updates := int64(0)
+
+	for _, entry := range entries {
+		if entry.UpdateAvailable.ValueBool() {
+			updates++
+		}
+
+		if !data.Installed.IsNull() && entry.Installed.ValueBool() != data.Installed.ValueBool() {
+			continue
+		}
+
+		data.Plugins = append(data.Plugins, entry)
+	}
+
+	data.UpdatesAvailable = types.Int64Value(updates)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// buildPluginCatalog joins installed plugins with repository packages by GUID, falling
+// back to a case-insensitive name match, and returns the entries sorted by name.
+func buildPluginCatalog(plugins []client.Plugin, packages []client.Package) []PluginCatalogEntryModel {
+	matched := make([]bool, len(packages))
+	entries := make([]PluginCatalogEntryModel, 0, len(plugins)+len(packages))
+
+	for _, plugin := range plugins {
+		entry := PluginCatalogEntryModel{
+			ID:               types.StringValue(plugin.Id),
+			Name:             types.StringValue(plugin.Name),
+			Installed:        types.BoolValue(true),
+			InstalledVersion: types.StringValue(plugin.Version),
+			LatestVersion:    types.StringNull(),
+			UpdateAvailable:  types.BoolValue(false),
+		}
+
+		if i := findPackageForPlugin(plugin, packages); i >= 0 {
+			matched[i] = true
+
+			if latest := packages[i].LatestVersion(); latest != "" {
+				entry.LatestVersion = types.StringValue(latest)
+				entry.UpdateAvailable = types.BoolValue(client.CompareVersions(latest, plugin.Version) > 0)
+			}
+		}
+
+		entries = append(entries, entry)
+	}
+
+	for i, pkg := range packages {
+		if matched[i] {
+			continue
+		}
+
+		entry := PluginCatalogEntryModel{
+			ID:               types.StringValue(pkg.Guid),
+			Name:             types.StringValue(pkg.Name),
+			Installed:        types.BoolValue(false),
+			InstalledVersion: types.StringNull(),
+			LatestVersion:    types.StringNull(),
+			UpdateAvailable:  types.BoolValue(false),
+		}
+
+		if latest := pkg.LatestVersion(); latest != "" {
+			entry.LatestVersion = types.StringValue(latest)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return strings.ToLower(entries[i].Name.ValueString()) < strings.ToLower(entries[j].Name.ValueString())
+	})
+
+	return entries
+}
+
+// findPackageForPlugin returns the index of the package matching an installed plugin, or -1.
+func findPackageForPlugin(plugin client.Plugin, packages []client.Package) int {
+	for i, pkg := range packages {
+		if pkg.Guid != "" && client.NormalizeGuid(pkg.Guid) == client.NormalizeGuid(plugin.Id) {
+			return i
+		}
+	}
+
+	for i, pkg := range packages {
+		if strings.EqualFold(pkg.Name, plugin.Name) {
+			return i
+		}
+	}
+
+	return -1
+}
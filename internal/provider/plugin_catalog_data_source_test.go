@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPluginCatalogDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPluginCatalogDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.jellyfin_plugin_catalog.test", "updates_available"),
+					resource.TestCheckResourceAttrSet("data.jellyfin_plugin_catalog.test", "plugins.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccPluginCatalogDataSourceConfig = `
+data "jellyfin_plugin_catalog" "test" {}
+`
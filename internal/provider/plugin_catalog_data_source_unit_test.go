@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestPluginCatalogDataSource_Metadata(t *testing.T) {
+	ds := &PluginCatalogDataSource{}
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_plugin_catalog"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestPluginCatalogDataSource_Schema(t *testing.T) {
+	ds := &PluginCatalogDataSource{}
+	req := datasource.SchemaRequest{}
+	resp := &datasource.SchemaResponse{}
+
+	ds.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check installed attribute
+	installedAttr, ok := resp.Schema.Attributes["installed"]
+	if !ok {
+		t.Error("Expected 'installed' attribute in schema")
+	} else {
+		if !installedAttr.IsOptional() {
+			t.Error("Expected 'installed' attribute to be optional")
+		}
+	}
+
+	// Check plugins attribute
+	pluginsAttr, ok := resp.Schema.Attributes["plugins"]
+	if !ok {
+		t.Error("Expected 'plugins' attribute in schema")
+	} else {
+		if !pluginsAttr.IsComputed() {
+			t.Error("Expected 'plugins' attribute to be computed")
+		}
+	}
+
+	// Check updates_available attribute
+	updatesAvailableAttr, ok := resp.Schema.Attributes["updates_available"]
+	if !ok {
+		t.Error("Expected 'updates_available' attribute in schema")
+	} else {
+		if !updatesAvailableAttr.IsComputed() {
+			t.Error("Expected 'updates_available' attribute to be computed")
+		}
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestPluginCatalogDataSource_Configure_nilProviderData(t *testing.T) {
+	ds := &PluginCatalogDataSource{}
+	req := datasource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestPluginCatalogDataSource_Configure_wrongType(t *testing.T) {
+	ds := &PluginCatalogDataSource{}
+	req := datasource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestPluginCatalogDataSource_Configure_success(t *testing.T) {
+	ds := &PluginCatalogDataSource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := datasource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if ds.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewPluginCatalogDataSource(t *testing.T) {
+	ds := NewPluginCatalogDataSource()
+	if ds == nil {
+		t.Error("Expected data source to be instantiated")
+	}
+
+	_, ok := ds.(*PluginCatalogDataSource)
+	if !ok {
+		t.Error("Expected data source to be *PluginCatalogDataSource")
+	}
+}
+
+func TestBuildPluginCatalog(t *testing.T) {
+	plugins := []client.Plugin{
+		{Id: "71552a5a5c5c4350a2aeebe451a30173", Name: "Webhook", Version: "14.0.0.0"},
+		{Id: "a4df60c5695848d3b2e6c83ab6f0a3e0", Name: "TMDb Box Sets", Version: "10.0.0.0"},
+		{Id: "00000000000000000000000000000001", Name: "Local Plugin", Version: "1.0.0.0"},
+	}
+	packages := []client.Package{
+		{Name: "Webhook", Guid: "71552A5A-5C5C-4350-A2AE-EBE451A30173", Versions: []client.PackageVersion{{Version: "15.0.0.0"}, {Version: "14.0.0.0"}}},
+		{Name: "tmdb box sets", Guid: "", Versions: []client.PackageVersion{{Version: "10.0.0.0"}}},
+		{Name: "Anime", Guid: "a3b1f1e6-4b4b-4c5a-9d4c-2b7e0e0c0f3a", Versions: []client.PackageVersion{{Version: "12.0.0.0"}}},
+	}
+
+	entries := buildPluginCatalog(plugins, packages)
+
+	if len(entries) != 4 {
+		t.Fatalf("Expected 4 entries, got %d", len(entries))
+	}
+
+	byName := map[string]PluginCatalogEntryModel{}
+	for _, entry := range entries {
+		byName[entry.Name.ValueString()] = entry
+	}
+
+	if entries[0].Name.ValueString() != "Anime" {
+		t.Errorf("Expected entries sorted by name, got %s first", entries[0].Name.ValueString())
+	}
+
+	webhook := byName["Webhook"]
+	if !webhook.Installed.ValueBool() || webhook.LatestVersion.ValueString() != "15.0.0.0" || !webhook.UpdateAvailable.ValueBool() {
+		t.Errorf("Expected Webhook to be installed with update 15.0.0.0, got %+v", webhook)
+	}
+
+	boxSets := byName["TMDb Box Sets"]
+	if boxSets.UpdateAvailable.ValueBool() || boxSets.LatestVersion.ValueString() != "10.0.0.0" {
+		t.Errorf("Expected TMDb Box Sets to be matched by name and up to date, got %+v", boxSets)
+	}
+
+	local := byName["Local Plugin"]
+	if !local.LatestVersion.IsNull() || local.UpdateAvailable.ValueBool() {
+		t.Errorf("Expected Local Plugin to have no repository version, got %+v", local)
+	}
+
+	anime := byName["Anime"]
+	if anime.Installed.ValueBool() || !anime.InstalledVersion.IsNull() || anime.LatestVersion.ValueString() != "12.0.0.0" {
+		t.Errorf("Expected Anime to be available but not installed, got %+v", anime)
+	}
+}
@@ -0,0 +1,282 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PluginConfigurationResource{}
+var _ resource.ResourceWithImportState = &PluginConfigurationResource{}
+
+func NewPluginConfigurationResource() resource.Resource {
+	return &PluginConfigurationResource{}
+}
+
+// PluginConfigurationResource defines the resource implementation.
+type PluginConfigurationResource struct {
+	client *client.Client
+}
+
+// PluginConfigurationResourceModel describes the resource data model.
+type PluginConfigurationResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	PluginID          types.String `tfsdk:"plugin_id"`
+	ConfigurationJSON types.String `tfsdk:"configuration_json"`
+}
+
+func (r *PluginConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_plugin_configuration"
+}
+
+func (r *PluginConfigurationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages any plugin's configuration as raw JSON, for plugins the provider doesn't model. " +
+			"The configuration is sent to the plugin as written, replacing its current configuration, so fields left out fall back to the plugin's defaults. " +
+			"Only the top-level fields in `configuration_json` are checked for drift, and differences in key order or whitespace are ignored. " +
+			"Destroying this resource leaves the current configuration in place.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of this resource. Same as `plugin_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"plugin_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The GUID of the installed plugin.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"configuration_json": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: "The plugin's configuration as a JSON object using the plugin's field names " +
+					"(e.g. `jsonencode({ ApiKey = \"...\", EnableSync = true })`).",
+			},
+		},
+	}
+}
+
+func (r *PluginConfigurationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PluginConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PluginConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Created plugin configuration resource", map[string]interface{}{
+		"plugin_id": data.PluginID.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PluginConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PluginConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.client.GetPluginConfiguration(ctx, data.PluginID.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read plugin configuration: %s", err))
+		return
+	}
+
+	if current == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	configuration, err := pluginConfigurationState(data.ConfigurationJSON.ValueString(), current)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to decode plugin configuration: %s", err))
+		return
+	}
+
+	data.ID = data.PluginID
+	data.ConfigurationJSON = types.StringValue(configuration)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PluginConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PluginConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PluginConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// A plugin configuration can't be deleted; removing the resource from state
+	// simply stops Terraform from managing it.
+	tflog.Trace(ctx, "Deleted plugin configuration resource (no-op)")
+}
+
+func (r *PluginConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("plugin_id"), req.ID)...)
+}
+
+// apply sends the configured JSON to the plugin as written.
+func (r *PluginConfigurationResource) apply(ctx context.Context, data *PluginConfigurationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	pluginID := data.PluginID.ValueString()
+	configuration := data.ConfigurationJSON.ValueString()
+
+	if _, err := decodeJSONObject(configuration); err != nil {
+		diags.AddAttributeError(path.Root("configuration_json"), "Invalid Configuration JSON", err.Error())
+		return diags
+	}
+
+	// Check the plugin exists first, since Jellyfin answers a write for an unknown plugin
+	// with an error that doesn't say so.
+	current, err := r.client.GetPluginConfiguration(ctx, pluginID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read plugin configuration: %s", err))
+		return diags
+	}
+
+	if current == nil {
+		diags.AddAttributeError(
+			path.Root("plugin_id"),
+			"Plugin Not Found",
+			fmt.Sprintf("No plugin with ID %q is installed, or it has no configuration.", pluginID),
+		)
+		return diags
+	}
+
+	tflog.Debug(ctx, "Updating plugin configuration", map[string]interface{}{
+		"plugin_id": pluginID,
+	})
+
+	if err := r.client.UpdatePluginConfiguration(ctx, pluginID, json.RawMessage(configuration)); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update plugin configuration: %s", err))
+		return diags
+	}
+
+	data.ID = types.StringValue(pluginID)
+
+	return diags
+}
+
+// decodeJSONObject decodes a JSON object into its top-level fields.
+func decodeJSONObject(raw string) (map[string]json.RawMessage, error) {
+	if _, err := normalizeJSON(raw); err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(raw), &fields); err != nil || fields == nil {
+		return nil, errors.New("expected a JSON object")
+	}
+
+	return fields, nil
+}
+
+// pluginConfigurationState returns the value to store for a plugin's current
+// configuration. With a prior value, only the top-level fields it contains are kept, so
+// defaults the plugin fills in don't show as drift, and the prior value is returned
+// unchanged when it is semantically equal. Without one, as after import, the whole
+// configuration is returned.
+func pluginConfigurationState(prior string, current json.RawMessage) (string, error) {
+	if prior == "" {
+		return normalizeJSON(string(current))
+	}
+
+	managed, err := decodeJSONObject(prior)
+	if err != nil {
+		return normalizeJSON(string(current))
+	}
+
+	fields, err := decodeJSONObject(string(current))
+	if err != nil {
+		return "", err
+	}
+
+	projected := make(map[string]json.RawMessage, len(managed))
+	for name := range managed {
+		if value, ok := fields[name]; ok {
+			projected[name] = value
+		}
+	}
+
+	encoded, err := json.Marshal(projected)
+	if err != nil {
+		return "", err
+	}
+
+	configuration, err := normalizeJSON(string(encoded))
+	if err != nil {
+		return "", err
+	}
+
+	if normalized, err := normalizeJSON(prior); err == nil && normalized == configuration {
+		return prior, nil
+	}
+
+	return configuration, nil
+}
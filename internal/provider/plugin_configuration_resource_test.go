@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPluginConfigurationResource_notInstalled(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "jellyfin_plugin_configuration" "test" {
+  plugin_id          = "00000000000000000000000000000000"
+  configuration_json = jsonencode({ Enabled = true })
+}
+`,
+				ExpectError: regexp.MustCompile("Plugin Not Found"),
+			},
+		},
+	})
+}
+
+func TestAccPluginConfigurationResource_invalidJSON(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "jellyfin_plugin_configuration" "test" {
+  plugin_id          = "00000000000000000000000000000000"
+  configuration_json = "[1, 2]"
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Configuration JSON"),
+			},
+		},
+	})
+}
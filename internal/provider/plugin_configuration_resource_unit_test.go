@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestPluginConfigurationResource_Metadata(t *testing.T) {
+	r := &PluginConfigurationResource{}
+	req := resource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &resource.MetadataResponse{}
+
+	r.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_plugin_configuration"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestPluginConfigurationResource_Schema(t *testing.T) {
+	r := &PluginConfigurationResource{}
+	req := resource.SchemaRequest{}
+	resp := &resource.SchemaResponse{}
+
+	r.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check id attribute
+	idAttr, ok := resp.Schema.Attributes["id"]
+	if !ok {
+		t.Error("Expected 'id' attribute in schema")
+	} else {
+		if !idAttr.IsComputed() {
+			t.Error("Expected 'id' attribute to be computed")
+		}
+	}
+
+	// Check plugin_id attribute
+	pluginIdAttr, ok := resp.Schema.Attributes["plugin_id"]
+	if !ok {
+		t.Error("Expected 'plugin_id' attribute in schema")
+	} else {
+		if !pluginIdAttr.IsRequired() {
+			t.Error("Expected 'plugin_id' attribute to be required")
+		}
+	}
+
+	// Check configuration_json attribute
+	configurationJsonAttr, ok := resp.Schema.Attributes["configuration_json"]
+	if !ok {
+		t.Error("Expected 'configuration_json' attribute in schema")
+	} else {
+		if !configurationJsonAttr.IsRequired() {
+			t.Error("Expected 'configuration_json' attribute to be required")
+		}
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestPluginConfigurationResource_Configure_nilProviderData(t *testing.T) {
+	r := &PluginConfigurationResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestPluginConfigurationResource_Configure_wrongType(t *testing.T) {
+	r := &PluginConfigurationResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestPluginConfigurationResource_Configure_success(t *testing.T) {
+	r := &PluginConfigurationResource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := resource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if r.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewPluginConfigurationResource(t *testing.T) {
+	r := NewPluginConfigurationResource()
+	if r == nil {
+		t.Error("Expected resource to be instantiated")
+	}
+
+	_, ok := r.(*PluginConfigurationResource)
+	if !ok {
+		t.Error("Expected resource to be *PluginConfigurationResource")
+	}
+}
+
+func TestPluginConfigurationState(t *testing.T) {
+	current := json.RawMessage(`{"ApiKey":"secret","EnableSync":true,"Servers":["a","b"],"RetryCount":3}`)
+
+	testCases := []struct {
+		name     string
+		prior    string
+		expected string
+	}{
+		{
+			name:     "import stores the whole configuration",
+			expected: `{"ApiKey":"secret","EnableSync":true,"RetryCount":3,"Servers":["a","b"]}`,
+		},
+		{
+			name:     "semantically equal keeps the prior formatting",
+			prior:    "{\n  \"EnableSync\": true,\n  \"ApiKey\": \"secret\"\n}",
+			expected: "{\n  \"EnableSync\": true,\n  \"ApiKey\": \"secret\"\n}",
+		},
+		{
+			name:     "changed value is drift",
+			prior:    `{"ApiKey":"secret","EnableSync":false}`,
+			expected: `{"ApiKey":"secret","EnableSync":true}`,
+		},
+		{
+			name:     "reordered list is drift",
+			prior:    `{"Servers":["b","a"]}`,
+			expected: `{"Servers":["a","b"]}`,
+		},
+		{
+			name:     "field missing on the server is drift",
+			prior:    `{"ApiKey":"secret","Removed":1}`,
+			expected: `{"ApiKey":"secret"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := pluginConfigurationState(tc.prior, current)
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if got != tc.expected {
+				t.Errorf("Expected %s, got %s", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestDecodeJSONObject(t *testing.T) {
+	fields, err := decodeJSONObject(`{"a": 1, "b": {"c": true}}`)
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(fields) != 2 || string(fields["b"]) != `{"c": true}` {
+		t.Errorf("Expected fields a and b, got %v", fields)
+	}
+
+	for _, input := range []string{`[1, 2]`, `"text"`, `null`, `{"a": 1`, `{"a": 1} {}`} {
+		if _, err := decodeJSONObject(input); err == nil {
+			t.Errorf("Expected error for %s", input)
+		}
+	}
+}
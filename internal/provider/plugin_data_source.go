@@ -0,0 +1,256 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PluginDataSource{}
+
+func NewPluginDataSource() datasource.DataSource {
+	return &PluginDataSource{}
+}
+
+// PluginDataSource defines the data source implementation.
+type PluginDataSource struct {
+	client *client.Client
+}
+
+// PluginDataSourceModel describes the data source data model.
+type PluginDataSourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Installed        types.Bool   `tfsdk:"installed"`
+	InstalledVersion types.String `tfsdk:"installed_version"`
+	LatestVersion    types.String `tfsdk:"latest_version"`
+	UpdateAvailable  types.Bool   `tfsdk:"update_available"`
+	Status           types.String `tfsdk:"status"`
+	Description      types.String `tfsdk:"description"`
+	CanUninstall     types.Bool   `tfsdk:"can_uninstall"`
+}
+
+func (d *PluginDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_plugin"
+}
+
+func (d *PluginDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a single plugin by name or ID and reports whether a newer version is available from the server's plugin repositories. " +
+			"A plugin that isn't installed is reported with `installed = false` rather than an error.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The name of the plugin (case-insensitive). Either name or id must be provided. " +
+					"When looking up by id, null if neither the server nor its repositories know the plugin.",
+			},
+			"id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The GUID of the plugin, with or without dashes. Either name or id must be provided. " +
+					"When looking up by name, null if neither the server nor its repositories know the plugin.",
+			},
+			"installed": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the plugin is installed on the server.",
+			},
+			"installed_version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The installed version, or null if the plugin is not installed.",
+			},
+			"latest_version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The newest version offered by the plugin repositories, or null if no repository offers the plugin.",
+			},
+			"update_available": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the plugin is installed and a newer version is available.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The status of the installed plugin (e.g., `Active`, `Restart`, `Disabled`), or null if the plugin is not installed.",
+			},
+			"description": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The description of the installed plugin, or null if the plugin is not installed.",
+			},
+			"can_uninstall": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the installed plugin can be uninstalled, or null if the plugin is not installed.",
+			},
+		},
+	}
+}
+
+func (d *PluginDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PluginDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PluginDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasName := !data.Name.IsNull() && !data.Name.IsUnknown()
+	hasID := !data.ID.IsNull() && !data.ID.IsUnknown()
+
+	if !hasName && !hasID {
+		resp.Diagnostics.AddError(
+			"Missing Required Attribute",
+			"Either 'name' or 'id' must be provided to look up a plugin.",
+		)
+		return
+	}
+
+	plugins, err := d.client.GetPlugins(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list installed plugins: %s", err))
+		return
+	}
+
+	packages, err := d.client.GetPackages(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list available packages: %s", err))
+		return
+	}
+
+	catalog := buildPluginCatalog(plugins, packages)
+
+	var entry PluginCatalogEntryModel
+	if hasID {
+		entry = findCatalogEntryByID(catalog, data.ID.ValueString())
+	} else {
+		entry = findCatalogEntry(catalog, data.Name.ValueString())
+	}
+
+	tflog.Debug(ctx, "Looked up plugin", map[string]interface{}{
+		"name":      entry.Name.ValueString(),
+		"id":        entry.ID.ValueString(),
+		"installed": entry.Installed.ValueBool(),
+	})
+
+	data.ID = entry.ID
+	data.Name = entry.Name
+	data.Installed = entry.Installed
+	data.InstalledVersion = entry.InstalledVersion
+	data.LatestVersion = entry.LatestVersion
+	data.UpdateAvailable = entry.UpdateAvailable
+	data.Status = types.StringNull()
+	data.Description = types.StringNull()
+	data.CanUninstall = types.BoolNull()
+
+	if entry.Installed.ValueBool() {
+		if plugin := findInstalledPlugin(plugins, entry.ID.ValueString()); plugin != nil {
+			data.Status = types.StringValue(plugin.Status)
+			data.Description = optionalString(plugin.Description)
+			data.CanUninstall = types.BoolValue(plugin.CanUninstall)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// findCatalogEntry returns the catalog entry whose name matches case-insensitively,
+// preferring an installed plugin. If none matches, it returns an entry for a plugin
+// that is neither installed nor offered by any repository.
+func findCatalogEntry(entries []PluginCatalogEntryModel, name string) PluginCatalogEntryModel {
+	var found *PluginCatalogEntryModel
+
+	for i := range entries {
+		if !strings.EqualFold(entries[i].Name.ValueString(), name) {
+			continue
+		}
+
+		if found == nil || (!found.Installed.ValueBool() && entries[i].Installed.ValueBool()) {
+			found = &entries[i]
+		}
+	}
+
+	if found != nil {
+		return *found
+	}
+
+	return PluginCatalogEntryModel{
+		ID:               types.StringNull(),
+		Name:             types.StringValue(name),
+		Installed:        types.BoolValue(false),
+		InstalledVersion: types.StringNull(),
+		LatestVersion:    types.StringNull(),
+		UpdateAvailable:  types.BoolValue(false),
+	}
+}
+
+// findCatalogEntryByID returns the catalog entry whose GUID matches, ignoring case and
+// dashes and preferring an installed plugin. If none matches, it returns an entry for a
+// plugin that is neither installed nor offered by any repository.
+func findCatalogEntryByID(entries []PluginCatalogEntryModel, id string) PluginCatalogEntryModel {
+	var found *PluginCatalogEntryModel
+
+	for i := range entries {
+		if client.NormalizeGuid(entries[i].ID.ValueString()) != client.NormalizeGuid(id) {
+			continue
+		}
+
+		if found == nil || (!found.Installed.ValueBool() && entries[i].Installed.ValueBool()) {
+			found = &entries[i]
+		}
+	}
+
+	if found != nil {
+		return *found
+	}
+
+	return PluginCatalogEntryModel{
+		ID:               types.StringValue(id),
+		Name:             types.StringNull(),
+		Installed:        types.BoolValue(false),
+		InstalledVersion: types.StringNull(),
+		LatestVersion:    types.StringNull(),
+		UpdateAvailable:  types.BoolValue(false),
+	}
+}
+
+// findInstalledPlugin returns the installed plugin with the given GUID, or nil.
+func findInstalledPlugin(plugins []client.Plugin, id string) *client.Plugin {
+	for i := range plugins {
+		if client.NormalizeGuid(plugins[i].Id) == client.NormalizeGuid(id) {
+			return &plugins[i]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPluginDataSource_notInstalled(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPluginDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.jellyfin_plugin.test", "installed", "false"),
+					resource.TestCheckResourceAttr("data.jellyfin_plugin.test", "update_available", "false"),
+					resource.TestCheckNoResourceAttr("data.jellyfin_plugin.test", "installed_version"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPluginDataSource_byIDNotInstalled(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "jellyfin_plugin" "test" {
+  id = "00000000-0000-0000-0000-000000000000"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.jellyfin_plugin.test", "installed", "false"),
+					resource.TestCheckNoResourceAttr("data.jellyfin_plugin.test", "name"),
+					resource.TestCheckNoResourceAttr("data.jellyfin_plugin.test", "status"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPluginDataSource_missingLookup(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      `data "jellyfin_plugin" "test" {}`,
+				ExpectError: regexp.MustCompile("Missing Required Attribute"),
+			},
+		},
+	})
+}
+
+const testAccPluginDataSourceConfig = `
+data "jellyfin_plugin" "test" {
+  name = "terraform-acceptance-missing-plugin"
+}
+`
@@ -0,0 +1,285 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestPluginDataSource_Metadata(t *testing.T) {
+	ds := &PluginDataSource{}
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_plugin"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestPluginDataSource_Schema(t *testing.T) {
+	ds := &PluginDataSource{}
+	req := datasource.SchemaRequest{}
+	resp := &datasource.SchemaResponse{}
+
+	ds.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check name attribute
+	nameAttr, ok := resp.Schema.Attributes["name"]
+	if !ok {
+		t.Error("Expected 'name' attribute in schema")
+	} else {
+		if !nameAttr.IsOptional() {
+			t.Error("Expected 'name' attribute to be optional")
+		}
+		if !nameAttr.IsComputed() {
+			t.Error("Expected 'name' attribute to be computed")
+		}
+	}
+
+	// Check id attribute
+	idAttr, ok := resp.Schema.Attributes["id"]
+	if !ok {
+		t.Error("Expected 'id' attribute in schema")
+	} else {
+		if !idAttr.IsOptional() {
+			t.Error("Expected 'id' attribute to be optional")
+		}
+		if !idAttr.IsComputed() {
+			t.Error("Expected 'id' attribute to be computed")
+		}
+	}
+
+	// Check installed attribute
+	installedAttr, ok := resp.Schema.Attributes["installed"]
+	if !ok {
+		t.Error("Expected 'installed' attribute in schema")
+	} else {
+		if !installedAttr.IsComputed() {
+			t.Error("Expected 'installed' attribute to be computed")
+		}
+	}
+
+	// Check installed_version attribute
+	installedVersionAttr, ok := resp.Schema.Attributes["installed_version"]
+	if !ok {
+		t.Error("Expected 'installed_version' attribute in schema")
+	} else {
+		if !installedVersionAttr.IsComputed() {
+			t.Error("Expected 'installed_version' attribute to be computed")
+		}
+	}
+
+	// Check latest_version attribute
+	latestVersionAttr, ok := resp.Schema.Attributes["latest_version"]
+	if !ok {
+		t.Error("Expected 'latest_version' attribute in schema")
+	} else {
+		if !latestVersionAttr.IsComputed() {
+			t.Error("Expected 'latest_version' attribute to be computed")
+		}
+	}
+
+	// Check update_available attribute
+	updateAvailableAttr, ok := resp.Schema.Attributes["update_available"]
+	if !ok {
+		t.Error("Expected 'update_available' attribute in schema")
+	} else {
+		if !updateAvailableAttr.IsComputed() {
+			t.Error("Expected 'update_available' attribute to be computed")
+		}
+	}
+
+	// Check status attribute
+	statusAttr, ok := resp.Schema.Attributes["status"]
+	if !ok {
+		t.Error("Expected 'status' attribute in schema")
+	} else {
+		if !statusAttr.IsComputed() {
+			t.Error("Expected 'status' attribute to be computed")
+		}
+	}
+
+	// Check description attribute
+	descriptionAttr, ok := resp.Schema.Attributes["description"]
+	if !ok {
+		t.Error("Expected 'description' attribute in schema")
+	} else {
+		if !descriptionAttr.IsComputed() {
+			t.Error("Expected 'description' attribute to be computed")
+		}
+	}
+
+	// Check can_uninstall attribute
+	canUninstallAttr, ok := resp.Schema.Attributes["can_uninstall"]
+	if !ok {
+		t.Error("Expected 'can_uninstall' attribute in schema")
+	} else {
+		if !canUninstallAttr.IsComputed() {
+			t.Error("Expected 'can_uninstall' attribute to be computed")
+		}
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestPluginDataSource_Configure_nilProviderData(t *testing.T) {
+	ds := &PluginDataSource{}
+	req := datasource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestPluginDataSource_Configure_wrongType(t *testing.T) {
+	ds := &PluginDataSource{}
+	req := datasource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestPluginDataSource_Configure_success(t *testing.T) {
+	ds := &PluginDataSource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := datasource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if ds.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewPluginDataSource(t *testing.T) {
+	ds := NewPluginDataSource()
+	if ds == nil {
+		t.Error("Expected data source to be instantiated")
+	}
+
+	_, ok := ds.(*PluginDataSource)
+	if !ok {
+		t.Error("Expected data source to be *PluginDataSource")
+	}
+}
+
+func TestFindCatalogEntry(t *testing.T) {
+	entries := []PluginCatalogEntryModel{
+		{
+			ID:               types.StringValue("a3b1f1e64b4b4c5a9d4c2b7e0e0c0f3a"),
+			Name:             types.StringValue("Anime"),
+			Installed:        types.BoolValue(false),
+			InstalledVersion: types.StringNull(),
+			LatestVersion:    types.StringValue("12.0.0.0"),
+			UpdateAvailable:  types.BoolValue(false),
+		},
+		{
+			ID:               types.StringValue("71552a5a5c5c4350a2aeebe451a30173"),
+			Name:             types.StringValue("Webhook"),
+			Installed:        types.BoolValue(true),
+			InstalledVersion: types.StringValue("14.0.0.0"),
+			LatestVersion:    types.StringValue("15.0.0.0"),
+			UpdateAvailable:  types.BoolValue(true),
+		},
+	}
+
+	webhook := findCatalogEntry(entries, "webhook")
+	if !webhook.Installed.ValueBool() || !webhook.UpdateAvailable.ValueBool() {
+		t.Errorf("Expected webhook to match case-insensitively with an update, got %+v", webhook)
+	}
+
+	anime := findCatalogEntry(entries, "Anime")
+	if anime.Installed.ValueBool() || anime.LatestVersion.ValueString() != "12.0.0.0" {
+		t.Errorf("Expected Anime to be available but not installed, got %+v", anime)
+	}
+
+	missing := findCatalogEntry(entries, "Missing")
+	if missing.Installed.ValueBool() || !missing.ID.IsNull() || !missing.LatestVersion.IsNull() || missing.UpdateAvailable.ValueBool() {
+		t.Errorf("Expected unknown plugin to be reported as not installed, got %+v", missing)
+	}
+}
+
+func TestFindCatalogEntryByID(t *testing.T) {
+	entries := []PluginCatalogEntryModel{
+		{
+			ID:               types.StringValue("71552a5a-5c5c-4350-a2ae-ebe451a30173"),
+			Name:             types.StringValue("Webhook"),
+			Installed:        types.BoolValue(false),
+			InstalledVersion: types.StringNull(),
+			LatestVersion:    types.StringValue("15.0.0.0"),
+			UpdateAvailable:  types.BoolValue(false),
+		},
+		{
+			ID:               types.StringValue("71552a5a5c5c4350a2aeebe451a30173"),
+			Name:             types.StringValue("Webhook"),
+			Installed:        types.BoolValue(true),
+			InstalledVersion: types.StringValue("14.0.0.0"),
+			LatestVersion:    types.StringValue("15.0.0.0"),
+			UpdateAvailable:  types.BoolValue(true),
+		},
+	}
+
+	webhook := findCatalogEntryByID(entries, "71552A5A-5C5C-4350-A2AE-EBE451A30173")
+	if !webhook.Installed.ValueBool() || webhook.Name.ValueString() != "Webhook" {
+		t.Errorf("Expected the installed webhook to match regardless of dashes and case, got %+v", webhook)
+	}
+
+	missing := findCatalogEntryByID(entries, "00000000000000000000000000000000")
+	if missing.Installed.ValueBool() || !missing.Name.IsNull() || missing.ID.ValueString() != "00000000000000000000000000000000" {
+		t.Errorf("Expected unknown plugin to be reported as not installed, got %+v", missing)
+	}
+}
+
+func TestFindInstalledPlugin(t *testing.T) {
+	plugins := []client.Plugin{
+		{Id: "71552a5a5c5c4350a2aeebe451a30173", Name: "Webhook", Status: "Active", CanUninstall: true},
+	}
+
+	plugin := findInstalledPlugin(plugins, "71552a5a-5c5c-4350-a2ae-ebe451a30173")
+	if plugin == nil || plugin.Status != "Active" {
+		t.Errorf("Expected the webhook plugin, got %+v", plugin)
+	}
+
+	if plugin := findInstalledPlugin(plugins, "missing"); plugin != nil {
+		t.Errorf("Expected no plugin, got %+v", plugin)
+	}
+}
@@ -0,0 +1,288 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PluginRepositoryResource{}
+var _ resource.ResourceWithImportState = &PluginRepositoryResource{}
+
+func NewPluginRepositoryResource() resource.Resource {
+	return &PluginRepositoryResource{}
+}
+
+// PluginRepositoryResource defines the resource implementation.
+type PluginRepositoryResource struct {
+	client *client.Client
+}
+
+// PluginRepositoryResourceModel describes the resource data model.
+type PluginRepositoryResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	URL     types.String `tfsdk:"url"`
+	Enabled types.Bool   `tfsdk:"enabled"`
+}
+
+func (r *PluginRepositoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_plugin_repository"
+}
+
+func (r *PluginRepositoryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Adds a plugin repository to the server, so `jellyfin_plugin` can install its packages. " +
+			"Only this repository is managed; repositories added outside Terraform are left alone. " +
+			"Destroying this resource removes the repository.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the repository. Same as `name`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the repository. Must be unique on the server.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The URL of the repository's plugin manifest.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether the server offers packages from the repository. Defaults to `true`.",
+			},
+		},
+	}
+}
+
+func (r *PluginRepositoryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PluginRepositoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PluginRepositoryResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+
+	var exists bool
+	err := r.client.PatchRepositories(ctx, func(repositories []client.Repository) ([]client.Repository, bool) {
+		if findRepository(repositories, name) != nil {
+			exists = true
+			return nil, false
+		}
+		return upsertRepository(repositories, pluginRepository(data)), true
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add plugin repository: %s", err))
+		return
+	}
+
+	if exists {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Repository Already Exists",
+			fmt.Sprintf("The server already has a plugin repository named %q. Import it with its name to manage it with Terraform.", name),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(name)
+
+	tflog.Trace(ctx, "Created plugin repository", map[string]interface{}{
+		"name": name,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PluginRepositoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PluginRepositoryResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repositories, err := r.client.GetRepositories(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read plugin repositories: %s", err))
+		return
+	}
+
+	repository := findRepository(repositories, data.Name.ValueString())
+
+	if repository == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.StringValue(repository.Name)
+	data.URL = types.StringValue(repository.Url)
+	data.Enabled = types.BoolValue(repository.Enabled)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PluginRepositoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PluginRepositoryResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.PatchRepositories(ctx, func(repositories []client.Repository) ([]client.Repository, bool) {
+		return upsertRepository(repositories, pluginRepository(data)), true
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update plugin repository: %s", err))
+		return
+	}
+
+	data.ID = data.Name
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PluginRepositoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PluginRepositoryResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+
+	err := r.client.PatchRepositories(ctx, func(repositories []client.Repository) ([]client.Repository, bool) {
+		if findRepository(repositories, name) == nil {
+			tflog.Debug(ctx, "Plugin repository already removed", map[string]interface{}{
+				"name": name,
+			})
+			return nil, false
+		}
+		return removeRepository(repositories, name), true
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove plugin repository: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted plugin repository", map[string]interface{}{
+		"name": name,
+	})
+}
+
+func (r *PluginRepositoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+}
+
+// pluginRepository builds the repository entry described by the model.
+func pluginRepository(data PluginRepositoryResourceModel) client.Repository {
+	return client.Repository{
+		Name:    data.Name.ValueString(),
+		Url:     data.URL.ValueString(),
+		Enabled: data.Enabled.ValueBool(),
+	}
+}
+
+// findRepository returns the repository with the given name, or nil if there is none.
+func findRepository(repositories []client.Repository, name string) *client.Repository {
+	for i := range repositories {
+		if repositories[i].Name == name {
+			return &repositories[i]
+		}
+	}
+	return nil
+}
+
+// upsertRepository returns repositories with the entry of the same name replaced by
+// repository in place, or with repository appended if there is none.
+func upsertRepository(repositories []client.Repository, repository client.Repository) []client.Repository {
+	result := make([]client.Repository, 0, len(repositories)+1)
+	replaced := false
+
+	for _, existing := range repositories {
+		if existing.Name == repository.Name {
+			if !replaced {
+				result = append(result, repository)
+				replaced = true
+			}
+			continue
+		}
+		result = append(result, existing)
+	}
+
+	if !replaced {
+		result = append(result, repository)
+	}
+
+	return result
+}
+
+// removeRepository returns repositories without the entries with the given name,
+// keeping the order of the others.
+func removeRepository(repositories []client.Repository, name string) []client.Repository {
+	result := make([]client.Repository, 0, len(repositories))
+
+	for _, existing := range repositories {
+		if existing.Name != name {
+			result = append(result, existing)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPluginRepositoryResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccPluginRepositoryResourceConfig("https://example.com/tf-acc/manifest.json", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_plugin_repository.test", "id", "tf-acc-repository"),
+					resource.TestCheckResourceAttr("jellyfin_plugin_repository.test", "url", "https://example.com/tf-acc/manifest.json"),
+					resource.TestCheckResourceAttr("jellyfin_plugin_repository.test", "enabled", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "jellyfin_plugin_repository.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update testing
+			{
+				Config: testAccPluginRepositoryResourceConfig("https://example.com/tf-acc/other.json", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_plugin_repository.test", "url", "https://example.com/tf-acc/other.json"),
+					resource.TestCheckResourceAttr("jellyfin_plugin_repository.test", "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPluginRepositoryResourceConfig(url string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "jellyfin_plugin_repository" "test" {
+  name    = "tf-acc-repository"
+  url     = %[1]q
+  enabled = %[2]t
+}
+`, url, enabled)
+}
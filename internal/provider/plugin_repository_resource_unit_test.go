@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestPluginRepositoryResource_Metadata(t *testing.T) {
+	r := &PluginRepositoryResource{}
+	req := resource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &resource.MetadataResponse{}
+
+	r.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_plugin_repository"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestPluginRepositoryResource_Schema(t *testing.T) {
+	r := &PluginRepositoryResource{}
+	req := resource.SchemaRequest{}
+	resp := &resource.SchemaResponse{}
+
+	r.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check id attribute
+	idAttr, ok := resp.Schema.Attributes["id"]
+	if !ok {
+		t.Error("Expected 'id' attribute in schema")
+	} else {
+		if !idAttr.IsComputed() {
+			t.Error("Expected 'id' attribute to be computed")
+		}
+	}
+
+	// Check name attribute
+	nameAttr, ok := resp.Schema.Attributes["name"]
+	if !ok {
+		t.Error("Expected 'name' attribute in schema")
+	} else {
+		if !nameAttr.IsRequired() {
+			t.Error("Expected 'name' attribute to be required")
+		}
+	}
+
+	// Check url attribute
+	urlAttr, ok := resp.Schema.Attributes["url"]
+	if !ok {
+		t.Error("Expected 'url' attribute in schema")
+	} else {
+		if !urlAttr.IsRequired() {
+			t.Error("Expected 'url' attribute to be required")
+		}
+	}
+
+	// Check enabled attribute
+	enabledAttr, ok := resp.Schema.Attributes["enabled"]
+	if !ok {
+		t.Error("Expected 'enabled' attribute in schema")
+	} else {
+		if !enabledAttr.IsOptional() {
+			t.Error("Expected 'enabled' attribute to be optional")
+		}
+		if !enabledAttr.IsComputed() {
+			t.Error("Expected 'enabled' attribute to be computed")
+		}
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestPluginRepositoryResource_Configure_nilProviderData(t *testing.T) {
+	r := &PluginRepositoryResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestPluginRepositoryResource_Configure_wrongType(t *testing.T) {
+	r := &PluginRepositoryResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestPluginRepositoryResource_Configure_success(t *testing.T) {
+	r := &PluginRepositoryResource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := resource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if r.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewPluginRepositoryResource(t *testing.T) {
+	r := NewPluginRepositoryResource()
+	if r == nil {
+		t.Error("Expected resource to be instantiated")
+	}
+
+	_, ok := r.(*PluginRepositoryResource)
+	if !ok {
+		t.Error("Expected resource to be *PluginRepositoryResource")
+	}
+}
+
+func TestUpsertRepository(t *testing.T) {
+	stable := client.Repository{Name: "Jellyfin Stable", Url: "https://repo.jellyfin.org/files/plugin/manifest.json", Enabled: true}
+	other := client.Repository{Name: "Other", Url: "https://example.com/other.json", Enabled: false}
+
+	testCases := []struct {
+		name       string
+		current    []client.Repository
+		repository client.Repository
+		expected   []client.Repository
+	}{
+		{
+			name:       "appends new repository",
+			current:    []client.Repository{stable, other},
+			repository: client.Repository{Name: "Mine", Url: "https://example.com/mine.json", Enabled: true},
+			expected:   []client.Repository{stable, other, {Name: "Mine", Url: "https://example.com/mine.json", Enabled: true}},
+		},
+		{
+			name:       "replaces existing repository in place",
+			current:    []client.Repository{stable, other},
+			repository: client.Repository{Name: "Jellyfin Stable", Url: "https://mirror.example.com/manifest.json", Enabled: true},
+			expected:   []client.Repository{{Name: "Jellyfin Stable", Url: "https://mirror.example.com/manifest.json", Enabled: true}, other},
+		},
+		{
+			name:       "empty list",
+			repository: other,
+			expected:   []client.Repository{other},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := upsertRepository(tc.current, tc.repository)
+
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestRemoveRepository(t *testing.T) {
+	stable := client.Repository{Name: "Jellyfin Stable", Url: "https://repo.jellyfin.org/files/plugin/manifest.json", Enabled: true}
+	mine := client.Repository{Name: "Mine", Url: "https://example.com/mine.json", Enabled: true}
+	other := client.Repository{Name: "Other", Url: "https://example.com/other.json"}
+
+	got := removeRepository([]client.Repository{stable, mine, other}, "Mine")
+	expected := []client.Repository{stable, other}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+
+	got = removeRepository(expected, "Missing")
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected removing a missing repository to keep %v, got %v", expected, got)
+	}
+}
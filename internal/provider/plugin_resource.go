@@ -0,0 +1,298 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+const (
+	// pluginInstallTimeout is how long to wait for an installed plugin to appear.
+	pluginInstallTimeout = 5 * time.Minute
+
+	// pluginInstallInterval is how often to check whether an installed plugin has appeared.
+	pluginInstallInterval = 2 * time.Second
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PluginResource{}
+var _ resource.ResourceWithImportState = &PluginResource{}
+
+func NewPluginResource() resource.Resource {
+	return &PluginResource{}
+}
+
+// PluginResource defines the resource implementation.
+type PluginResource struct {
+	client *client.Client
+}
+
+// PluginResourceModel describes the resource data model.
+type PluginResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Version types.String `tfsdk:"version"`
+	Status  types.String `tfsdk:"status"`
+}
+
+func (r *PluginResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_plugin"
+}
+
+func (r *PluginResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Installs a plugin from the server's plugin repositories. " +
+			"Jellyfin only loads new, updated or removed plugins after a server restart. " +
+			"Destroying this resource uninstalls the plugin.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The GUID of the installed plugin.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the package to install, as listed by `jellyfin_plugin_catalog`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The version to install. Defaults to the latest version compatible with the server. " +
+					"Changing it installs the new version in place.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The status of the plugin (e.g., `Active`, or `Restart` while the server needs a restart to load it).",
+			},
+		},
+	}
+}
+
+func (r *PluginResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PluginResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PluginResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.install(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Installed plugin", map[string]interface{}{
+		"name":    data.Name.ValueString(),
+		"version": data.Version.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PluginResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PluginResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plugins, err := r.client.GetPlugins(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read plugins: %s", err))
+		return
+	}
+
+	plugin := installedPlugin(plugins, data.Name.ValueString())
+
+	if plugin == nil {
+		tflog.Debug(ctx, "Plugin is no longer installed, removing from state", map[string]interface{}{
+			"name": data.Name.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	setPluginModel(&data, plugin)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PluginResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PluginResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.install(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PluginResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PluginResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UninstallPlugin(ctx, data.ID.ValueString())
+
+	if client.IsNotFound(err) {
+		tflog.Debug(ctx, "Plugin already uninstalled", map[string]interface{}{
+			"name": data.Name.ValueString(),
+		})
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to uninstall plugin: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "Uninstalled plugin", map[string]interface{}{
+		"name": data.Name.ValueString(),
+	})
+}
+
+func (r *PluginResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by name, since that's what the configuration uses.
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+// install installs the configured plugin version, waits for the server to report it
+// and refreshes the model from the result.
+func (r *PluginResource) install(ctx context.Context, data *PluginResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	name := data.Name.ValueString()
+	version := ""
+	if !data.Version.IsNull() && !data.Version.IsUnknown() {
+		version = data.Version.ValueString()
+	}
+
+	tflog.Debug(ctx, "Installing plugin", map[string]interface{}{
+		"name":    name,
+		"version": version,
+	})
+
+	if err := r.client.InstallPackage(ctx, name, version); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to install plugin %s: %s", name, err))
+		return diags
+	}
+
+	plugin, err := r.client.WaitForPlugin(ctx, name, version, pluginInstallInterval, pluginInstallTimeout)
+
+	if errors.Is(err, client.ErrPollTimeout) {
+		diags.AddError(
+			"Timed Out Waiting for Plugin",
+			fmt.Sprintf("Plugin %q was not installed within %s. Check the server log for download errors and that the name matches a package in jellyfin_plugin_catalog.", name, pluginInstallTimeout),
+		)
+		return diags
+	}
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read plugins: %s", err))
+		return diags
+	}
+
+	setPluginModel(data, plugin)
+
+	if plugin.Status == "Restart" {
+		diags.AddWarning(
+			"Server Restart Required",
+			fmt.Sprintf("The Jellyfin server must be restarted before plugin %s %s is loaded.", plugin.Name, plugin.Version),
+		)
+	}
+
+	return diags
+}
+
+// installedPlugin returns the newest installed version of the named plugin (case-insensitive),
+// ignoring versions that are being removed or were replaced, or nil if there is none.
+func installedPlugin(plugins []client.Plugin, name string) *client.Plugin {
+	var found *client.Plugin
+
+	for i := range plugins {
+		plugin := &plugins[i]
+
+		if !strings.EqualFold(plugin.Name, name) || plugin.Status == "Deleted" || plugin.Status == "Superceded" {
+			continue
+		}
+
+		if found == nil || client.CompareVersions(plugin.Version, found.Version) > 0 {
+			found = plugin
+		}
+	}
+
+	return found
+}
+
+// setPluginModel copies an installed plugin into the model, keeping the configured name
+// and the configured spelling of an equal version (e.g., "15.0" for "15.0.0.0").
+func setPluginModel(data *PluginResourceModel, plugin *client.Plugin) {
+	data.ID = types.StringValue(plugin.Id)
+	data.Status = types.StringValue(plugin.Status)
+
+	if data.Version.IsNull() || data.Version.IsUnknown() || client.CompareVersions(data.Version.ValueString(), plugin.Version) != 0 {
+		data.Version = types.StringValue(plugin.Version)
+	}
+
+	if data.Name.IsNull() || data.Name.IsUnknown() {
+		data.Name = types.StringValue(plugin.Name)
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPluginResource_unknownPackage(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Installing requires a package offered by the server's repositories.
+			{
+				Config: `
+resource "jellyfin_plugin" "test" {
+  name = "tf-acc-missing-plugin"
+}
+`,
+				ExpectError: regexp.MustCompile("Unable to install plugin"),
+			},
+		},
+	})
+}
@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestPluginResource_Metadata(t *testing.T) {
+	r := &PluginResource{}
+	req := resource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &resource.MetadataResponse{}
+
+	r.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_plugin"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestPluginResource_Schema(t *testing.T) {
+	r := &PluginResource{}
+	req := resource.SchemaRequest{}
+	resp := &resource.SchemaResponse{}
+
+	r.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check id attribute
+	idAttr, ok := resp.Schema.Attributes["id"]
+	if !ok {
+		t.Error("Expected 'id' attribute in schema")
+	} else {
+		if !idAttr.IsComputed() {
+			t.Error("Expected 'id' attribute to be computed")
+		}
+	}
+
+	// Check name attribute
+	nameAttr, ok := resp.Schema.Attributes["name"]
+	if !ok {
+		t.Error("Expected 'name' attribute in schema")
+	} else {
+		if !nameAttr.IsRequired() {
+			t.Error("Expected 'name' attribute to be required")
+		}
+	}
+
+	// Check version attribute
+	versionAttr, ok := resp.Schema.Attributes["version"]
+	if !ok {
+		t.Error("Expected 'version' attribute in schema")
+	} else {
+		if !versionAttr.IsOptional() {
+			t.Error("Expected 'version' attribute to be optional")
+		}
+		if !versionAttr.IsComputed() {
+			t.Error("Expected 'version' attribute to be computed")
+		}
+	}
+
+	// Check status attribute
+	statusAttr, ok := resp.Schema.Attributes["status"]
+	if !ok {
+		t.Error("Expected 'status' attribute in schema")
+	} else {
+		if !statusAttr.IsComputed() {
+			t.Error("Expected 'status' attribute to be computed")
+		}
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestPluginResource_Configure_nilProviderData(t *testing.T) {
+	r := &PluginResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestPluginResource_Configure_wrongType(t *testing.T) {
+	r := &PluginResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestPluginResource_Configure_success(t *testing.T) {
+	r := &PluginResource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := resource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if r.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewPluginResource(t *testing.T) {
+	r := NewPluginResource()
+	if r == nil {
+		t.Error("Expected resource to be instantiated")
+	}
+
+	_, ok := r.(*PluginResource)
+	if !ok {
+		t.Error("Expected resource to be *PluginResource")
+	}
+}
+
+func TestInstalledPlugin(t *testing.T) {
+	plugins := []client.Plugin{
+		{Id: "a", Name: "Webhook", Version: "14.0.0.0", Status: "Superceded"},
+		{Id: "a", Name: "Webhook", Version: "15.0.0.0", Status: "Restart"},
+		{Id: "b", Name: "TMDb", Version: "10.9.0.0", Status: "Deleted"},
+		{Id: "c", Name: "Open Subtitles", Version: "20.0.0.0", Status: "Active"},
+	}
+
+	testCases := []struct {
+		name            string
+		plugin          string
+		expectedVersion string
+	}{
+		{name: "newest version", plugin: "Webhook", expectedVersion: "15.0.0.0"},
+		{name: "case-insensitive", plugin: "open subtitles", expectedVersion: "20.0.0.0"},
+		{name: "being removed", plugin: "TMDb"},
+		{name: "not installed", plugin: "Trakt"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			plugin := installedPlugin(plugins, tc.plugin)
+
+			if tc.expectedVersion == "" {
+				if plugin != nil {
+					t.Errorf("Expected no plugin, got %+v", plugin)
+				}
+				return
+			}
+
+			if plugin == nil || plugin.Version != tc.expectedVersion {
+				t.Errorf("Expected version %s, got %+v", tc.expectedVersion, plugin)
+			}
+		})
+	}
+}
+
+func TestSetPluginModel(t *testing.T) {
+	plugin := &client.Plugin{Id: "71552a5a5c5c4350a2aeebe451a30173", Name: "Webhook", Version: "15.0.0.0", Status: "Active"}
+
+	data := PluginResourceModel{Name: types.StringValue("webhook"), Version: types.StringValue("15.0")}
+	setPluginModel(&data, plugin)
+
+	if data.ID.ValueString() != plugin.Id || data.Status.ValueString() != "Active" {
+		t.Errorf("Expected id and status to be copied, got %s and %s", data.ID, data.Status)
+	}
+
+	if data.Name.ValueString() != "webhook" {
+		t.Errorf("Expected the configured name to be kept, got %s", data.Name)
+	}
+
+	if data.Version.ValueString() != "15.0" {
+		t.Errorf("Expected the configured spelling of an equal version to be kept, got %s", data.Version)
+	}
+
+	data = PluginResourceModel{Name: types.StringValue("Webhook"), Version: types.StringValue("14.0.0.0")}
+	setPluginModel(&data, plugin)
+
+	if data.Version.ValueString() != "15.0.0.0" {
+		t.Errorf("Expected the installed version to be reported, got %s", data.Version)
+	}
+}
@@ -0,0 +1,256 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// pluginDisabledStatus is the status Jellyfin reports for a disabled plugin.
+const pluginDisabledStatus = "Disabled"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PluginStateResource{}
+var _ resource.ResourceWithImportState = &PluginStateResource{}
+
+func NewPluginStateResource() resource.Resource {
+	return &PluginStateResource{}
+}
+
+// PluginStateResource defines the resource implementation.
+type PluginStateResource struct {
+	client *client.Client
+}
+
+// PluginStateResourceModel describes the resource data model.
+type PluginStateResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	PluginID types.String `tfsdk:"plugin_id"`
+	Enabled  types.Bool   `tfsdk:"enabled"`
+	Version  types.String `tfsdk:"version"`
+	Status   types.String `tfsdk:"status"`
+}
+
+func (r *PluginStateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_plugin_state"
+}
+
+func (r *PluginStateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Enables or disables an installed plugin without uninstalling it. " +
+			"Jellyfin only loads or unloads the plugin after a server restart. " +
+			"When several versions are installed, the newest one is managed. " +
+			"Destroying this resource leaves the plugin in its current state.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of this resource. Same as `plugin_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"plugin_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The GUID of the installed plugin, with or without dashes.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Required:            true,
+				MarkdownDescription: "Whether the plugin is enabled.",
+			},
+			"version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The version of the plugin being managed.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The status of the plugin (e.g., `Active`, `Disabled`, or `Restart` while the server needs a restart).",
+			},
+		},
+	}
+}
+
+func (r *PluginStateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PluginStateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PluginStateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Created plugin state resource", map[string]interface{}{
+		"plugin_id": data.PluginID.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PluginStateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PluginStateResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plugin, err := r.client.FindPluginByID(ctx, data.PluginID.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read plugin: %s", err))
+		return
+	}
+
+	if plugin == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	setPluginStateModel(&data, plugin)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PluginStateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PluginStateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PluginStateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Removing the resource leaves the plugin enabled or disabled as it is.
+	tflog.Trace(ctx, "Deleted plugin state resource (no-op)")
+}
+
+func (r *PluginStateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("plugin_id"), req.ID)...)
+}
+
+// apply enables or disables the plugin as configured and refreshes the model from the result.
+func (r *PluginStateResource) apply(ctx context.Context, data *PluginStateResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	pluginID := data.PluginID.ValueString()
+	enabled := data.Enabled.ValueBool()
+
+	plugin, err := r.client.FindPluginByID(ctx, pluginID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read plugin: %s", err))
+		return diags
+	}
+
+	if plugin == nil {
+		diags.AddAttributeError(
+			path.Root("plugin_id"),
+			"Plugin Not Found",
+			fmt.Sprintf("No plugin with ID %q is installed. Install it first, for example with jellyfin_plugin.", pluginID),
+		)
+		return diags
+	}
+
+	if pluginEnabled(plugin) != enabled {
+		tflog.Debug(ctx, "Updating plugin state", map[string]interface{}{
+			"plugin_id": pluginID,
+			"version":   plugin.Version,
+			"enabled":   enabled,
+		})
+
+		if err := r.client.SetPluginEnabled(ctx, plugin.Id, plugin.Version, enabled); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to update plugin state: %s", err))
+			return diags
+		}
+
+		plugin, err = r.client.FindPluginByID(ctx, pluginID)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read plugin: %s", err))
+			return diags
+		}
+
+		if plugin == nil {
+			diags.AddError("Client Error", fmt.Sprintf("Plugin %s disappeared while updating its state", pluginID))
+			return diags
+		}
+
+		action := "unloaded"
+		if enabled {
+			action = "loaded"
+		}
+
+		diags.AddWarning(
+			"Server Restart Required",
+			fmt.Sprintf("The Jellyfin server must be restarted before plugin %s is %s.", plugin.Name, action),
+		)
+	}
+
+	setPluginStateModel(data, plugin)
+
+	return diags
+}
+
+// pluginEnabled reports whether the plugin is enabled, which is any status but Disabled.
+func pluginEnabled(plugin *client.Plugin) bool {
+	return plugin.Status != pluginDisabledStatus
+}
+
+// setPluginStateModel copies the plugin's state into the model, keeping the configured plugin ID.
+func setPluginStateModel(data *PluginStateResourceModel, plugin *client.Plugin) {
+	data.ID = data.PluginID
+	data.Enabled = types.BoolValue(pluginEnabled(plugin))
+	data.Version = types.StringValue(plugin.Version)
+	data.Status = types.StringValue(plugin.Status)
+}
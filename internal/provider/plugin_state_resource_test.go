@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPluginStateResource_notInstalled(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Only installed plugins can be enabled or disabled.
+			{
+				Config: `
+resource "jellyfin_plugin_state" "test" {
+  plugin_id = "00000000000000000000000000000000"
+  enabled   = false
+}
+`,
+				ExpectError: regexp.MustCompile("Plugin Not Found"),
+			},
+		},
+	})
+}
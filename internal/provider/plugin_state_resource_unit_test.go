@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestPluginStateResource_Metadata(t *testing.T) {
+	r := &PluginStateResource{}
+	req := resource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &resource.MetadataResponse{}
+
+	r.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_plugin_state"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestPluginStateResource_Schema(t *testing.T) {
+	r := &PluginStateResource{}
+	req := resource.SchemaRequest{}
+	resp := &resource.SchemaResponse{}
+
+	r.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check id attribute
+	idAttr, ok := resp.Schema.Attributes["id"]
+	if !ok {
+		t.Error("Expected 'id' attribute in schema")
+	} else {
+		if !idAttr.IsComputed() {
+			t.Error("Expected 'id' attribute to be computed")
+		}
+	}
+
+	// Check plugin_id attribute
+	pluginIdAttr, ok := resp.Schema.Attributes["plugin_id"]
+	if !ok {
+		t.Error("Expected 'plugin_id' attribute in schema")
+	} else {
+		if !pluginIdAttr.IsRequired() {
+			t.Error("Expected 'plugin_id' attribute to be required")
+		}
+	}
+
+	// Check enabled attribute
+	enabledAttr, ok := resp.Schema.Attributes["enabled"]
+	if !ok {
+		t.Error("Expected 'enabled' attribute in schema")
+	} else {
+		if !enabledAttr.IsRequired() {
+			t.Error("Expected 'enabled' attribute to be required")
+		}
+	}
+
+	// Check version attribute
+	versionAttr, ok := resp.Schema.Attributes["version"]
+	if !ok {
+		t.Error("Expected 'version' attribute in schema")
+	} else {
+		if !versionAttr.IsComputed() {
+			t.Error("Expected 'version' attribute to be computed")
+		}
+	}
+
+	// Check status attribute
+	statusAttr, ok := resp.Schema.Attributes["status"]
+	if !ok {
+		t.Error("Expected 'status' attribute in schema")
+	} else {
+		if !statusAttr.IsComputed() {
+			t.Error("Expected 'status' attribute to be computed")
+		}
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestPluginStateResource_Configure_nilProviderData(t *testing.T) {
+	r := &PluginStateResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestPluginStateResource_Configure_wrongType(t *testing.T) {
+	r := &PluginStateResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestPluginStateResource_Configure_success(t *testing.T) {
+	r := &PluginStateResource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := resource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if r.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewPluginStateResource(t *testing.T) {
+	r := NewPluginStateResource()
+	if r == nil {
+		t.Error("Expected resource to be instantiated")
+	}
+
+	_, ok := r.(*PluginStateResource)
+	if !ok {
+		t.Error("Expected resource to be *PluginStateResource")
+	}
+}
+
+func TestSetPluginStateModel(t *testing.T) {
+	testCases := []struct {
+		status          string
+		expectedEnabled bool
+	}{
+		{status: "Active", expectedEnabled: true},
+		{status: "Restart", expectedEnabled: true},
+		{status: "Disabled", expectedEnabled: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.status, func(t *testing.T) {
+			data := PluginStateResourceModel{PluginID: types.StringValue("71552A5A-5C5C-4350-A2AE-EBE451A30173")}
+
+			setPluginStateModel(&data, &client.Plugin{
+				Id:      "71552a5a5c5c4350a2aeebe451a30173",
+				Name:    "Webhook",
+				Version: "15.0.0.0",
+				Status:  tc.status,
+			})
+
+			if data.Enabled.ValueBool() != tc.expectedEnabled {
+				t.Errorf("Expected enabled %t, got %s", tc.expectedEnabled, data.Enabled)
+			}
+
+			if data.ID.ValueString() != "71552A5A-5C5C-4350-A2AE-EBE451A30173" {
+				t.Errorf("Expected the configured plugin ID to be kept, got %s", data.ID)
+			}
+
+			if data.Version.ValueString() != "15.0.0.0" || data.Status.ValueString() != tc.status {
+				t.Errorf("Expected version and status to be copied, got %s and %s", data.Version, data.Status)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PluginsDataSource{}
+
+func NewPluginsDataSource() datasource.DataSource {
+	return &PluginsDataSource{}
+}
+
+// PluginsDataSource defines the data source implementation.
+type PluginsDataSource struct {
+	client *client.Client
+}
+
+// PluginsDataSourceModel describes the data source data model.
+type PluginsDataSourceModel struct {
+	Plugins []PluginsEntryModel `tfsdk:"plugins"`
+}
+
+// PluginsEntryModel describes a single installed plugin in the list.
+type PluginsEntryModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Version      types.String `tfsdk:"version"`
+	Status       types.String `tfsdk:"status"`
+	Description  types.String `tfsdk:"description"`
+	CanUninstall types.Bool   `tfsdk:"can_uninstall"`
+}
+
+func (d *PluginsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_plugins"
+}
+
+func (d *PluginsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the plugins installed on the Jellyfin server. " +
+			"Use `jellyfin_plugin_catalog` to also see the plugins available from the server's plugin repositories.",
+
+		Attributes: map[string]schema.Attribute{
+			"plugins": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The installed plugins, in the order returned by the server.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The GUID of the plugin.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the plugin.",
+						},
+						"version": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The installed version.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The status of the plugin (e.g., `Active`, `Restart`, `Disabled`).",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The description of the plugin, if any.",
+						},
+						"can_uninstall": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the plugin can be uninstalled.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PluginsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PluginsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PluginsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plugins, err := d.client.GetPlugins(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list installed plugins: %s", err))
+		return
+	}
+
+	data.Plugins = make([]PluginsEntryModel, 0, len(plugins))
+	for _, plugin := range plugins {
+		data.Plugins = append(data.Plugins, PluginsEntryModel{
+			ID:           types.StringValue(plugin.Id),
+			Name:         types.StringValue(plugin.Name),
+			Version:      types.StringValue(plugin.Version),
+			Status:       types.StringValue(plugin.Status),
+			Description:  optionalString(plugin.Description),
+			CanUninstall: types.BoolValue(plugin.CanUninstall),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
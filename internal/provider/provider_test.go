@@ -32,3 +32,25 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("JELLYFIN_PASSWORD must be set for acceptance tests")
 	}
 }
+
+// testAccPreCheckItem skips tests that operate on existing media, which needs a
+// populated library that cannot be created from scratch by the test suite.
+func testAccPreCheckItem(t *testing.T) {
+	if os.Getenv("JELLYFIN_TEST_USER_ID") == "" || os.Getenv("JELLYFIN_TEST_ITEM_ID") == "" {
+		t.Skip("JELLYFIN_TEST_USER_ID and JELLYFIN_TEST_ITEM_ID must be set for item acceptance tests")
+	}
+}
+
+// testAccPreCheckLibrary skips tests that read an existing library.
+func testAccPreCheckLibrary(t *testing.T) {
+	if os.Getenv("JELLYFIN_TEST_LIBRARY_NAME") == "" {
+		t.Skip("JELLYFIN_TEST_LIBRARY_NAME must be set for library acceptance tests")
+	}
+}
+
+// testAccPreCheckUser skips tests that modify an existing user.
+func testAccPreCheckUser(t *testing.T) {
+	if os.Getenv("JELLYFIN_TEST_USER_ID") == "" {
+		t.Skip("JELLYFIN_TEST_USER_ID must be set for user acceptance tests")
+	}
+}
@@ -5,9 +5,16 @@ package provider
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 func TestJellyfinProvider_Metadata(t *testing.T) {
@@ -71,6 +78,20 @@ func TestJellyfinProvider_Schema(t *testing.T) {
 		}
 	}
 
+	if headersAttr, ok := resp.Schema.Attributes["headers"]; ok && !headersAttr.IsSensitive() {
+		t.Error("Expected 'headers' attribute to be sensitive")
+	}
+
+	// Check optional connection attributes
+	for _, name := range []string{"username_file", "password_file", "api_key_file", "retry_wait_min", "retry_wait_max", "request_timeout", "ca_file", "ca_dir", "trust_system_cas", "insecure_skip_verify", "max_response_bytes", "key_name_prefix", "require_key_import", "upload_expect_continue", "require_healthy", "require_startup_wizard_completed", "health_check_timeout", "auth_method", "quick_connect_timeout", "headers", "proxy_url", "requests_per_second"} {
+		attr, ok := resp.Schema.Attributes[name]
+		if !ok {
+			t.Errorf("Expected '%s' attribute in schema", name)
+		} else if !attr.IsOptional() {
+			t.Errorf("Expected '%s' attribute to be optional", name)
+		}
+	}
+
 	// Check schema has a description
 	if resp.Schema.MarkdownDescription == "" {
 		t.Error("Expected schema to have a markdown description")
@@ -81,8 +102,8 @@ func TestJellyfinProvider_Resources(t *testing.T) {
 	p := &JellyfinProvider{}
 	resources := p.Resources(context.Background())
 
-	if len(resources) != 1 {
-		t.Errorf("Expected 1 resource, got %d", len(resources))
+	if len(resources) != 27 {
+		t.Errorf("Expected 27 resources, got %d", len(resources))
 	}
 
 	// Verify the resource can be instantiated
@@ -96,8 +117,8 @@ func TestJellyfinProvider_DataSources(t *testing.T) {
 	p := &JellyfinProvider{}
 	dataSources := p.DataSources(context.Background())
 
-	if len(dataSources) != 1 {
-		t.Errorf("Expected 1 data source, got %d", len(dataSources))
+	if len(dataSources) != 21 {
+		t.Errorf("Expected 21 data sources, got %d", len(dataSources))
 	}
 
 	// Verify the data source can be instantiated
@@ -111,8 +132,14 @@ func TestJellyfinProvider_Functions(t *testing.T) {
 	p := &JellyfinProvider{}
 	functions := p.Functions(context.Background())
 
-	if len(functions) != 0 {
-		t.Errorf("Expected 0 functions, got %d", len(functions))
+	if len(functions) != 5 {
+		t.Errorf("Expected 5 functions, got %d", len(functions))
+	}
+
+	// Verify the function can be instantiated
+	f := functions[0]()
+	if f == nil {
+		t.Error("Expected function to be instantiated")
 	}
 }
 
@@ -161,3 +188,156 @@ func TestNew_differentVersions(t *testing.T) {
 		})
 	}
 }
+
+func TestParseRequestTimeout(t *testing.T) {
+	testCases := []struct {
+		name      string
+		attribute types.String
+		env       string
+		expected  time.Duration
+		expectErr bool
+	}{
+		{"unset", types.StringNull(), "", 0, false},
+		{"environment", types.StringNull(), "45s", 45 * time.Second, false},
+		{"attribute overrides environment", types.StringValue("2m"), "45s", 2 * time.Minute, false},
+		{"malformed environment", types.StringNull(), "soon", 0, true},
+		{"non-positive environment", types.StringNull(), "0s", 0, true},
+		{"malformed attribute", types.StringValue("soon"), "", 0, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("JELLYFIN_REQUEST_TIMEOUT", tc.env)
+			resp := &provider.ConfigureResponse{}
+
+			got := parseRequestTimeout(tc.attribute, resp)
+
+			if resp.Diagnostics.HasError() != tc.expectErr {
+				t.Errorf("Expected error %v, got diagnostics %v", tc.expectErr, resp.Diagnostics)
+			}
+			if got != tc.expected {
+				t.Errorf("Expected timeout %s, got %s", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestResolveCredential(t *testing.T) {
+	dir := t.TempDir()
+
+	attributeFile := filepath.Join(dir, "attribute")
+	if err := os.WriteFile(attributeFile, []byte("from-attribute-file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	envFile := filepath.Join(dir, "env")
+	if err := os.WriteFile(envFile, []byte("  from-env-file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name     string
+		value    types.String
+		file     types.String
+		env      string
+		envFile  string
+		expected string
+	}{
+		{"unset", types.StringNull(), types.StringNull(), "", "", ""},
+		{"inline attribute", types.StringValue("inline"), types.StringValue(attributeFile), "from-env", envFile, "inline"},
+		{"file attribute", types.StringNull(), types.StringValue(attributeFile), "from-env", envFile, "from-attribute-file"},
+		{"environment", types.StringNull(), types.StringNull(), "from-env", envFile, "from-env"},
+		{"environment file", types.StringNull(), types.StringNull(), "", envFile, "from-env-file"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("JELLYFIN_PASSWORD", tc.env)
+			t.Setenv("JELLYFIN_PASSWORD_FILE", tc.envFile)
+			resp := &provider.ConfigureResponse{}
+
+			got := resolveCredential(tc.value, tc.file, path.Root("password_file"), "JELLYFIN_PASSWORD", resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Errorf("Expected no errors, got %v", resp.Diagnostics)
+			}
+			if got != tc.expected {
+				t.Errorf("Expected credential %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestResolveCredential_unreadableFile(t *testing.T) {
+	dir := t.TempDir()
+
+	empty := filepath.Join(dir, "empty")
+	if err := os.WriteFile(empty, []byte(" \n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name    string
+		file    types.String
+		envFile string
+	}{
+		{"missing file attribute", types.StringValue(filepath.Join(dir, "missing")), ""},
+		{"empty file attribute", types.StringValue(empty), ""},
+		{"missing environment file", types.StringNull(), filepath.Join(dir, "missing")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("JELLYFIN_USERNAME", "")
+			t.Setenv("JELLYFIN_USERNAME_FILE", tc.envFile)
+			resp := &provider.ConfigureResponse{}
+
+			got := resolveCredential(types.StringNull(), tc.file, path.Root("username_file"), "JELLYFIN_USERNAME", resp)
+
+			if !resp.Diagnostics.HasError() {
+				t.Fatal("Expected an error diagnostic")
+			}
+			if summary := resp.Diagnostics[0].Summary(); summary != "Unable to Read Credential File" {
+				t.Errorf("Expected summary %q, got %q", "Unable to Read Credential File", summary)
+			}
+			if got != "" {
+				t.Errorf("Expected no credential, got %q", got)
+			}
+		})
+	}
+}
+
+func TestJellyfinProvider_Configure_endpointWithoutScheme(t *testing.T) {
+	ctx := context.Background()
+	p := &JellyfinProvider{version: "test"}
+
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+
+	values := map[string]tftypes.Value{}
+	for name, attr := range schemaResp.Schema.Attributes {
+		values[name] = tftypes.NewValue(attr.GetType().TerraformType(ctx), nil)
+	}
+	values["endpoint"] = tftypes.NewValue(tftypes.String, "localhost:8096")
+	values["username"] = tftypes.NewValue(tftypes.String, "admin")
+	values["password"] = tftypes.NewValue(tftypes.String, "secret")
+
+	req := provider.ConfigureRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), values),
+		},
+	}
+	resp := &provider.ConfigureResponse{}
+
+	p.Configure(ctx, req, resp)
+
+	for _, d := range resp.Diagnostics.Errors() {
+		withPath, ok := d.(interface{ Path() path.Path })
+		if ok && withPath.Path().Equal(path.Root("endpoint")) && d.Summary() == "Invalid Jellyfin Endpoint" {
+			return
+		}
+	}
+
+	t.Errorf("Expected an Invalid Jellyfin Endpoint error on the endpoint attribute, got %v", resp.Diagnostics)
+}
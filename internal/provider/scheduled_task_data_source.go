@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ScheduledTaskDataSource{}
+
+func NewScheduledTaskDataSource() datasource.DataSource {
+	return &ScheduledTaskDataSource{}
+}
+
+// ScheduledTaskDataSource defines the data source implementation.
+type ScheduledTaskDataSource struct {
+	client *client.Client
+}
+
+// ScheduledTaskDataSourceModel describes the data source data model.
+type ScheduledTaskDataSourceModel struct {
+	Name                      types.String `tfsdk:"name"`
+	ID                        types.String `tfsdk:"id"`
+	Key                       types.String `tfsdk:"key"`
+	State                     types.String `tfsdk:"state"`
+	Category                  types.String `tfsdk:"category"`
+	LastExecutionStatus       types.String `tfsdk:"last_execution_status"`
+	LastExecutionErrorMessage types.String `tfsdk:"last_execution_error_message"`
+	LastExecutionEndTime      types.String `tfsdk:"last_execution_end_time"`
+}
+
+func (d *ScheduledTaskDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scheduled_task"
+}
+
+func (d *ScheduledTaskDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a scheduled task, such as the library scan, and reports the outcome of its last run. " +
+			"Useful for surfacing a failing nightly task in outputs or checks.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The display name (e.g., `Scan Media Library`) or key (e.g., `RefreshLibrary`) of the task, case-insensitive.",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the task.",
+			},
+			"key": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The task's stable key, which unlike its name isn't translated.",
+			},
+			"state": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The current state of the task: `Idle`, `Running` or `Cancelling`.",
+			},
+			"category": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The category the task belongs to (e.g., `Library`, `Maintenance`).",
+			},
+			"last_execution_status": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: "How the last run ended: `Completed`, `Failed`, `Cancelled` or `Aborted`. " +
+					"Null if the task hasn't run.",
+			},
+			"last_execution_error_message": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The error reported by the last run, or null if it didn't fail or the task hasn't run.",
+			},
+			"last_execution_end_time": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the last run ended, in RFC 3339 format (UTC). Null if the task hasn't run.",
+			},
+		},
+	}
+}
+
+func (d *ScheduledTaskDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ScheduledTaskDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ScheduledTaskDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tasks, err := d.client.GetScheduledTasks(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list scheduled tasks: %s", err))
+		return
+	}
+
+	task := findScheduledTask(tasks, data.Name.ValueString())
+
+	if task == nil {
+		resp.Diagnostics.AddError(
+			"Scheduled Task Not Found",
+			fmt.Sprintf("No scheduled task named %q was found.", data.Name.ValueString()),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(task.Id)
+	data.Key = optionalString(task.Key)
+	data.State = types.StringValue(task.State)
+	data.Category = optionalString(task.Category)
+	data.LastExecutionStatus = types.StringNull()
+	data.LastExecutionErrorMessage = types.StringNull()
+	data.LastExecutionEndTime = types.StringNull()
+
+	if result := task.LastExecutionResult; result != nil {
+		data.LastExecutionStatus = optionalString(result.Status)
+		data.LastExecutionErrorMessage = optionalString(result.ErrorMessage)
+		data.LastExecutionEndTime = normalizeJellyfinDate(result.EndTimeUtc)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// findScheduledTask returns the task whose name or key matches case-insensitively, or
+// nil if there is none.
+func findScheduledTask(tasks []client.ScheduledTask, name string) *client.ScheduledTask {
+	for i := range tasks {
+		if strings.EqualFold(tasks[i].Name, name) || strings.EqualFold(tasks[i].Key, name) {
+			return &tasks[i]
+		}
+	}
+
+	return nil
+}
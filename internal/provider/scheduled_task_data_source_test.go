@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccScheduledTaskDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduledTaskDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.jellyfin_scheduled_task.test", "id"),
+					resource.TestCheckResourceAttr("data.jellyfin_scheduled_task.test", "key", "RefreshLibrary"),
+					resource.TestCheckResourceAttrSet("data.jellyfin_scheduled_task.test", "state"),
+					resource.TestCheckResourceAttr("data.jellyfin_scheduled_task.test", "category", "Library"),
+				),
+			},
+		},
+	})
+}
+
+const testAccScheduledTaskDataSourceConfig = `
+data "jellyfin_scheduled_task" "test" {
+  name = "RefreshLibrary"
+}
+`
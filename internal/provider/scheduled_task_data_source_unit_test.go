@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestScheduledTaskDataSource_Metadata(t *testing.T) {
+	ds := &ScheduledTaskDataSource{}
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_scheduled_task"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestScheduledTaskDataSource_Schema(t *testing.T) {
+	ds := &ScheduledTaskDataSource{}
+	req := datasource.SchemaRequest{}
+	resp := &datasource.SchemaResponse{}
+
+	ds.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check name attribute
+	nameAttr, ok := resp.Schema.Attributes["name"]
+	if !ok {
+		t.Error("Expected 'name' attribute in schema")
+	} else {
+		if !nameAttr.IsRequired() {
+			t.Error("Expected 'name' attribute to be required")
+		}
+	}
+
+	// Check id attribute
+	idAttr, ok := resp.Schema.Attributes["id"]
+	if !ok {
+		t.Error("Expected 'id' attribute in schema")
+	} else {
+		if !idAttr.IsComputed() {
+			t.Error("Expected 'id' attribute to be computed")
+		}
+	}
+
+	// Check key attribute
+	keyAttr, ok := resp.Schema.Attributes["key"]
+	if !ok {
+		t.Error("Expected 'key' attribute in schema")
+	} else {
+		if !keyAttr.IsComputed() {
+			t.Error("Expected 'key' attribute to be computed")
+		}
+	}
+
+	// Check state attribute
+	stateAttr, ok := resp.Schema.Attributes["state"]
+	if !ok {
+		t.Error("Expected 'state' attribute in schema")
+	} else {
+		if !stateAttr.IsComputed() {
+			t.Error("Expected 'state' attribute to be computed")
+		}
+	}
+
+	// Check category attribute
+	categoryAttr, ok := resp.Schema.Attributes["category"]
+	if !ok {
+		t.Error("Expected 'category' attribute in schema")
+	} else {
+		if !categoryAttr.IsComputed() {
+			t.Error("Expected 'category' attribute to be computed")
+		}
+	}
+
+	// Check last_execution_status attribute
+	lastExecutionStatusAttr, ok := resp.Schema.Attributes["last_execution_status"]
+	if !ok {
+		t.Error("Expected 'last_execution_status' attribute in schema")
+	} else {
+		if !lastExecutionStatusAttr.IsComputed() {
+			t.Error("Expected 'last_execution_status' attribute to be computed")
+		}
+	}
+
+	// Check last_execution_error_message attribute
+	lastExecutionErrorMessageAttr, ok := resp.Schema.Attributes["last_execution_error_message"]
+	if !ok {
+		t.Error("Expected 'last_execution_error_message' attribute in schema")
+	} else {
+		if !lastExecutionErrorMessageAttr.IsComputed() {
+			t.Error("Expected 'last_execution_error_message' attribute to be computed")
+		}
+	}
+
+	// Check last_execution_end_time attribute
+	lastExecutionEndTimeAttr, ok := resp.Schema.Attributes["last_execution_end_time"]
+	if !ok {
+		t.Error("Expected 'last_execution_end_time' attribute in schema")
+	} else {
+		if !lastExecutionEndTimeAttr.IsComputed() {
+			t.Error("Expected 'last_execution_end_time' attribute to be computed")
+		}
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestScheduledTaskDataSource_Configure_nilProviderData(t *testing.T) {
+	ds := &ScheduledTaskDataSource{}
+	req := datasource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestScheduledTaskDataSource_Configure_wrongType(t *testing.T) {
+	ds := &ScheduledTaskDataSource{}
+	req := datasource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestScheduledTaskDataSource_Configure_success(t *testing.T) {
+	ds := &ScheduledTaskDataSource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := datasource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if ds.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewScheduledTaskDataSource(t *testing.T) {
+	ds := NewScheduledTaskDataSource()
+	if ds == nil {
+		t.Error("Expected data source to be instantiated")
+	}
+
+	_, ok := ds.(*ScheduledTaskDataSource)
+	if !ok {
+		t.Error("Expected data source to be *ScheduledTaskDataSource")
+	}
+}
+
+func TestFindScheduledTask(t *testing.T) {
+	tasks := []client.ScheduledTask{
+		{Id: "1", Name: "Scan Media Library", Key: "RefreshLibrary"},
+		{Id: "2", Name: "Clean Cache Directory", Key: "DeleteCacheFiles"},
+	}
+
+	if task := findScheduledTask(tasks, "scan media library"); task == nil || task.Id != "1" {
+		t.Errorf("Expected task 1 by name, got %+v", task)
+	}
+
+	if task := findScheduledTask(tasks, "deletecachefiles"); task == nil || task.Id != "2" {
+		t.Errorf("Expected task 2 by key, got %+v", task)
+	}
+
+	if task := findScheduledTask(tasks, "Missing"); task != nil {
+		t.Errorf("Expected no task, got %+v", task)
+	}
+}
@@ -0,0 +1,519 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// ticksPerDay is the number of 100-nanosecond ticks in a day.
+const ticksPerDay = 24 * 60 * 60 * 10_000_000
+
+// taskTriggerTypes maps each trigger type to its Jellyfin name.
+var taskTriggerTypes = map[string]string{
+	"daily":    "DailyTrigger",
+	"interval": "IntervalTrigger",
+	"startup":  "StartupTrigger",
+	"weekly":   "WeeklyTrigger",
+}
+
+// daysOfWeek are the values Jellyfin accepts for a weekly trigger's day.
+var daysOfWeek = []string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}
+
+// taskTriggerAttrTypes describes a trigger object in the triggers list.
+var taskTriggerAttrTypes = map[string]attr.Type{
+	"type":              types.StringType,
+	"interval_ticks":    types.Int64Type,
+	"time_of_day_ticks": types.Int64Type,
+	"day_of_week":       types.StringType,
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ScheduledTaskTriggerResource{}
+var _ resource.ResourceWithImportState = &ScheduledTaskTriggerResource{}
+
+func NewScheduledTaskTriggerResource() resource.Resource {
+	return &ScheduledTaskTriggerResource{}
+}
+
+// ScheduledTaskTriggerResource defines the resource implementation.
+type ScheduledTaskTriggerResource struct {
+	client *client.Client
+}
+
+// ScheduledTaskTriggerResourceModel describes the resource data model.
+type ScheduledTaskTriggerResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	Task                 types.String `tfsdk:"task"`
+	Triggers             types.List   `tfsdk:"triggers"`
+	OriginalTriggersJSON types.String `tfsdk:"original_triggers_json"`
+}
+
+// TaskTriggerModel describes a single trigger in the triggers list.
+type TaskTriggerModel struct {
+	Type           types.String `tfsdk:"type"`
+	IntervalTicks  types.Int64  `tfsdk:"interval_ticks"`
+	TimeOfDayTicks types.Int64  `tfsdk:"time_of_day_ticks"`
+	DayOfWeek      types.String `tfsdk:"day_of_week"`
+}
+
+func (r *ScheduledTaskTriggerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scheduled_task_trigger"
+}
+
+func (r *ScheduledTaskTriggerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the triggers of a Jellyfin scheduled task, such as the library scan. " +
+			"The configured triggers replace all of the task's existing ones. " +
+			"Jellyfin can't reset a task to its default triggers, so the triggers the task had before this resource " +
+			"managed it are recorded and restored when the resource is destroyed.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the task.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"task": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: "The ID, key (e.g., `RefreshLibrary`) or display name (e.g., `Scan Media Library`) of the task, " +
+					"case-insensitive. The key is preferred since, unlike the name, it isn't translated.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.ListNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "The triggers that start the task. An empty list leaves the task to be run only on demand.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The kind of trigger. One of `" + strings.Join(taskTriggerTypeNames(), "`, `") + "`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf(taskTriggerTypeNames()...),
+							},
+						},
+						"interval_ticks": schema.Int64Attribute{
+							Optional: true,
+							MarkdownDescription: "The time between runs in ticks of 100 nanoseconds (e.g., `432000000000` for 12 hours). " +
+								"Required for `interval` triggers.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"time_of_day_ticks": schema.Int64Attribute{
+							Optional: true,
+							MarkdownDescription: "The time after midnight the trigger fires, in ticks of 100 nanoseconds " +
+								"(e.g., `36000000000` for 01:00). Required for `daily` and `weekly` triggers.",
+							Validators: []validator.Int64{
+								int64validator.Between(0, ticksPerDay-1),
+							},
+						},
+						"day_of_week": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The day a `weekly` trigger fires. One of `" + strings.Join(daysOfWeek, "`, `") + "`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf(daysOfWeek...),
+							},
+						},
+					},
+				},
+			},
+			"original_triggers_json": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: "The task's triggers before this resource managed them, as JSON. " +
+					"They are restored when the resource is destroyed.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ScheduledTaskTriggerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ScheduledTaskTriggerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ScheduledTaskTriggerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tasks, err := r.client.GetScheduledTasks(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list scheduled tasks: %s", err))
+		return
+	}
+
+	task := resolveScheduledTask(tasks, data.Task.ValueString())
+
+	if task == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("task"),
+			"Scheduled Task Not Found",
+			fmt.Sprintf("No scheduled task with ID, key or name %q was found.", data.Task.ValueString()),
+		)
+		return
+	}
+
+	original, err := json.Marshal(taskTriggersOrEmpty(task.Triggers))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to record the task's triggers: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(task.Id)
+	data.OriginalTriggersJSON = types.StringValue(string(original))
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Created scheduled task trigger resource", map[string]interface{}{
+		"task_id": task.Id,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ScheduledTaskTriggerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ScheduledTaskTriggerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	task, err := r.client.GetScheduledTask(ctx, data.ID.ValueString())
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read scheduled task: %s", err))
+		return
+	}
+
+	triggers, diags := taskTriggersToList(task.Triggers)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Triggers = triggers
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ScheduledTaskTriggerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ScheduledTaskTriggerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ScheduledTaskTriggerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ScheduledTaskTriggerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var original []client.TaskTrigger
+	if err := json.Unmarshal([]byte(data.OriginalTriggersJSON.ValueString()), &original); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Recorded Triggers",
+			fmt.Sprintf("Unable to decode the task's original triggers, so they can't be restored: %s", err),
+		)
+		return
+	}
+
+	err := r.client.UpdateTaskTriggers(ctx, data.ID.ValueString(), original)
+
+	// A task that no longer exists has nothing to restore.
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to restore scheduled task triggers: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted scheduled task trigger resource", map[string]interface{}{
+		"restored_triggers": len(original),
+	})
+}
+
+func (r *ScheduledTaskTriggerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	task, err := r.client.GetScheduledTask(ctx, req.ID)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read scheduled task %q: %s", req.ID, err))
+		return
+	}
+
+	// Import adopts the task's current triggers, which are then the ones restored when
+	// the resource is destroyed.
+	original, err := json.Marshal(taskTriggersOrEmpty(task.Triggers))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to record the task's triggers: %s", err))
+		return
+	}
+
+	taskRef := task.Key
+	if taskRef == "" {
+		taskRef = task.Id
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), task.Id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("task"), taskRef)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("original_triggers_json"), string(original))...)
+}
+
+// apply writes the configured triggers to the task.
+func (r *ScheduledTaskTriggerResource) apply(ctx context.Context, data *ScheduledTaskTriggerResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var models []TaskTriggerModel
+	diags.Append(data.Triggers.ElementsAs(ctx, &models, false)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	triggers, triggerDiags := taskTriggersFromModels(models)
+	diags.Append(triggerDiags...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	taskID := data.ID.ValueString()
+
+	tflog.Debug(ctx, "Updating scheduled task triggers", map[string]interface{}{
+		"task_id":  taskID,
+		"triggers": len(triggers),
+	})
+
+	if err := r.client.UpdateTaskTriggers(ctx, taskID, triggers); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update scheduled task triggers: %s", err))
+	}
+
+	return diags
+}
+
+// resolveScheduledTask returns the task whose ID matches ref, ignoring case and dashes,
+// or failing that the task whose name or key matches case-insensitively. It returns nil
+// if there is none.
+func resolveScheduledTask(tasks []client.ScheduledTask, ref string) *client.ScheduledTask {
+	for i := range tasks {
+		if client.NormalizeGuid(tasks[i].Id) == client.NormalizeGuid(ref) {
+			return &tasks[i]
+		}
+	}
+
+	return findScheduledTask(tasks, ref)
+}
+
+// taskTriggersFromModels converts the configured triggers to the API form, checking
+// that each has the fields its type needs.
+func taskTriggersFromModels(models []TaskTriggerModel) ([]client.TaskTrigger, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	triggers := make([]client.TaskTrigger, 0, len(models))
+
+	for i, model := range models {
+		triggerPath := path.Root("triggers").AtListIndex(i)
+		triggerType := model.Type.ValueString()
+
+		trigger := client.TaskTrigger{
+			Type:           taskTriggerTypes[triggerType],
+			IntervalTicks:  model.IntervalTicks.ValueInt64Pointer(),
+			TimeOfDayTicks: model.TimeOfDayTicks.ValueInt64Pointer(),
+			DayOfWeek:      model.DayOfWeek.ValueString(),
+		}
+
+		var required, unsupported []string
+
+		switch triggerType {
+		case "interval":
+			if trigger.IntervalTicks == nil {
+				required = append(required, "interval_ticks")
+			}
+			if trigger.TimeOfDayTicks != nil {
+				unsupported = append(unsupported, "time_of_day_ticks")
+			}
+			if trigger.DayOfWeek != "" {
+				unsupported = append(unsupported, "day_of_week")
+			}
+		case "daily":
+			if trigger.TimeOfDayTicks == nil {
+				required = append(required, "time_of_day_ticks")
+			}
+			if trigger.IntervalTicks != nil {
+				unsupported = append(unsupported, "interval_ticks")
+			}
+			if trigger.DayOfWeek != "" {
+				unsupported = append(unsupported, "day_of_week")
+			}
+		case "weekly":
+			if trigger.TimeOfDayTicks == nil {
+				required = append(required, "time_of_day_ticks")
+			}
+			if trigger.DayOfWeek == "" {
+				required = append(required, "day_of_week")
+			}
+			if trigger.IntervalTicks != nil {
+				unsupported = append(unsupported, "interval_ticks")
+			}
+		case "startup":
+			if trigger.IntervalTicks != nil {
+				unsupported = append(unsupported, "interval_ticks")
+			}
+			if trigger.TimeOfDayTicks != nil {
+				unsupported = append(unsupported, "time_of_day_ticks")
+			}
+			if trigger.DayOfWeek != "" {
+				unsupported = append(unsupported, "day_of_week")
+			}
+		}
+
+		if len(required) > 0 {
+			diags.AddAttributeError(
+				triggerPath,
+				"Missing Trigger Attribute",
+				fmt.Sprintf("A %q trigger requires %s.", triggerType, strings.Join(required, " and ")),
+			)
+		}
+
+		if len(unsupported) > 0 {
+			diags.AddAttributeError(
+				triggerPath,
+				"Unsupported Trigger Attribute",
+				fmt.Sprintf("A %q trigger doesn't use %s.", triggerType, strings.Join(unsupported, " or ")),
+			)
+		}
+
+		triggers = append(triggers, trigger)
+	}
+
+	return triggers, diags
+}
+
+// taskTriggersToList converts the task's triggers from the API form to a list value.
+// Trigger types the provider doesn't know are kept under their Jellyfin name.
+func taskTriggersToList(triggers []client.TaskTrigger) (types.List, diag.Diagnostics) {
+	objectType := types.ObjectType{AttrTypes: taskTriggerAttrTypes}
+	values := make([]attr.Value, 0, len(triggers))
+
+	var diags diag.Diagnostics
+
+	for _, trigger := range triggers {
+		triggerType := trigger.Type
+		for name, jellyfinName := range taskTriggerTypes {
+			if jellyfinName == trigger.Type {
+				triggerType = name
+			}
+		}
+
+		value, objectDiags := types.ObjectValue(taskTriggerAttrTypes, map[string]attr.Value{
+			"type":              types.StringValue(triggerType),
+			"interval_ticks":    types.Int64PointerValue(trigger.IntervalTicks),
+			"time_of_day_ticks": types.Int64PointerValue(trigger.TimeOfDayTicks),
+			"day_of_week":       optionalString(trigger.DayOfWeek),
+		})
+		diags.Append(objectDiags...)
+
+		values = append(values, value)
+	}
+
+	if diags.HasError() {
+		return types.ListNull(objectType), diags
+	}
+
+	list, listDiags := types.ListValue(objectType, values)
+	diags.Append(listDiags...)
+
+	return list, diags
+}
+
+// taskTriggersOrEmpty returns triggers, or an empty list if it is nil, so that a task
+// without triggers is recorded as "[]" rather than "null".
+func taskTriggersOrEmpty(triggers []client.TaskTrigger) []client.TaskTrigger {
+	if triggers == nil {
+		return []client.TaskTrigger{}
+	}
+	return triggers
+}
+
+// taskTriggerTypeNames returns the supported trigger types, sorted.
+func taskTriggerTypeNames() []string {
+	names := make([]string, 0, len(taskTriggerTypes))
+	for name := range taskTriggerTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
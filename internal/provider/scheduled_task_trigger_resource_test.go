@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccScheduledTaskTriggerResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: `
+resource "jellyfin_scheduled_task_trigger" "test" {
+  task = "DeleteCacheFiles"
+
+  triggers = [
+    {
+      type           = "interval"
+      interval_ticks = 432000000000
+    },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("jellyfin_scheduled_task_trigger.test", "id"),
+					resource.TestCheckResourceAttrSet("jellyfin_scheduled_task_trigger.test", "original_triggers_json"),
+					resource.TestCheckResourceAttr("jellyfin_scheduled_task_trigger.test", "triggers.#", "1"),
+					resource.TestCheckResourceAttr("jellyfin_scheduled_task_trigger.test", "triggers.0.type", "interval"),
+					resource.TestCheckResourceAttr("jellyfin_scheduled_task_trigger.test", "triggers.0.interval_ticks", "432000000000"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "jellyfin_scheduled_task_trigger.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Import records the triggers current at import time.
+				ImportStateVerifyIgnore: []string{"original_triggers_json"},
+			},
+			// Update and Read testing
+			{
+				Config: `
+resource "jellyfin_scheduled_task_trigger" "test" {
+  task = "DeleteCacheFiles"
+
+  triggers = [
+    {
+      type              = "weekly"
+      time_of_day_ticks = 36000000000
+      day_of_week       = "Sunday"
+    },
+    {
+      type = "startup"
+    },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_scheduled_task_trigger.test", "triggers.#", "2"),
+					resource.TestCheckResourceAttr("jellyfin_scheduled_task_trigger.test", "triggers.0.day_of_week", "Sunday"),
+					resource.TestCheckResourceAttr("jellyfin_scheduled_task_trigger.test", "triggers.1.type", "startup"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccScheduledTaskTriggerResource_missingInterval(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "jellyfin_scheduled_task_trigger" "test" {
+  task     = "DeleteCacheFiles"
+  triggers = [{ type = "interval" }]
+}
+`,
+				ExpectError: regexp.MustCompile("Missing Trigger Attribute"),
+			},
+		},
+	})
+}
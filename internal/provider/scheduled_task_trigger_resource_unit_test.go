@@ -0,0 +1,257 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestScheduledTaskTriggerResource_Metadata(t *testing.T) {
+	r := &ScheduledTaskTriggerResource{}
+	req := resource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &resource.MetadataResponse{}
+
+	r.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_scheduled_task_trigger"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestScheduledTaskTriggerResource_Schema(t *testing.T) {
+	r := &ScheduledTaskTriggerResource{}
+	req := resource.SchemaRequest{}
+	resp := &resource.SchemaResponse{}
+
+	r.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check id attribute
+	idAttr, ok := resp.Schema.Attributes["id"]
+	if !ok {
+		t.Error("Expected 'id' attribute in schema")
+	} else {
+		if !idAttr.IsComputed() {
+			t.Error("Expected 'id' attribute to be computed")
+		}
+	}
+
+	// Check task attribute
+	taskAttr, ok := resp.Schema.Attributes["task"]
+	if !ok {
+		t.Error("Expected 'task' attribute in schema")
+	} else {
+		if !taskAttr.IsRequired() {
+			t.Error("Expected 'task' attribute to be required")
+		}
+	}
+
+	// Check triggers attribute
+	triggersAttr, ok := resp.Schema.Attributes["triggers"]
+	if !ok {
+		t.Error("Expected 'triggers' attribute in schema")
+	} else {
+		if !triggersAttr.IsRequired() {
+			t.Error("Expected 'triggers' attribute to be required")
+		}
+	}
+
+	// Check original_triggers_json attribute
+	originalTriggersJsonAttr, ok := resp.Schema.Attributes["original_triggers_json"]
+	if !ok {
+		t.Error("Expected 'original_triggers_json' attribute in schema")
+	} else {
+		if !originalTriggersJsonAttr.IsComputed() {
+			t.Error("Expected 'original_triggers_json' attribute to be computed")
+		}
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestScheduledTaskTriggerResource_Configure_nilProviderData(t *testing.T) {
+	r := &ScheduledTaskTriggerResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestScheduledTaskTriggerResource_Configure_wrongType(t *testing.T) {
+	r := &ScheduledTaskTriggerResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestScheduledTaskTriggerResource_Configure_success(t *testing.T) {
+	r := &ScheduledTaskTriggerResource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := resource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if r.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewScheduledTaskTriggerResource(t *testing.T) {
+	r := NewScheduledTaskTriggerResource()
+	if r == nil {
+		t.Error("Expected resource to be instantiated")
+	}
+
+	_, ok := r.(*ScheduledTaskTriggerResource)
+	if !ok {
+		t.Error("Expected resource to be *ScheduledTaskTriggerResource")
+	}
+}
+
+func TestResolveScheduledTask(t *testing.T) {
+	tasks := []client.ScheduledTask{
+		{Id: "7738148ffcd07979c7ceb148e06b3aed", Name: "Scan Media Library", Key: "RefreshLibrary"},
+		{Id: "0ed5a7d9e7c9a3f7e4a9b5c8d6e1f2a3", Name: "Clean Cache Directory", Key: "DeleteCacheFiles"},
+	}
+
+	for _, ref := range []string{"0ED5A7D9-E7C9-A3F7-E4A9-B5C8D6E1F2A3", "deletecachefiles", "clean cache directory"} {
+		task := resolveScheduledTask(tasks, ref)
+		if task == nil || task.Key != "DeleteCacheFiles" {
+			t.Errorf("Expected %q to resolve to DeleteCacheFiles, got %+v", ref, task)
+		}
+	}
+
+	if task := resolveScheduledTask(tasks, "Missing"); task != nil {
+		t.Errorf("Expected no task, got %+v", task)
+	}
+}
+
+func TestTaskTriggersFromModels(t *testing.T) {
+	triggers, diags := taskTriggersFromModels([]TaskTriggerModel{
+		{
+			Type:           types.StringValue("weekly"),
+			IntervalTicks:  types.Int64Null(),
+			TimeOfDayTicks: types.Int64Value(36000000000),
+			DayOfWeek:      types.StringValue("Sunday"),
+		},
+		{
+			Type:           types.StringValue("startup"),
+			IntervalTicks:  types.Int64Null(),
+			TimeOfDayTicks: types.Int64Null(),
+			DayOfWeek:      types.StringNull(),
+		},
+	})
+
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags.Errors())
+	}
+
+	if len(triggers) != 2 {
+		t.Fatalf("Expected 2 triggers, got %d", len(triggers))
+	}
+
+	weekly := triggers[0]
+	if weekly.Type != "WeeklyTrigger" || weekly.DayOfWeek != "Sunday" || weekly.TimeOfDayTicks == nil || *weekly.TimeOfDayTicks != 36000000000 {
+		t.Errorf("Expected a weekly trigger on Sunday at 01:00, got %+v", weekly)
+	}
+
+	if weekly.IntervalTicks != nil {
+		t.Errorf("Expected no interval for a weekly trigger, got %d", *weekly.IntervalTicks)
+	}
+
+	if triggers[1].Type != "StartupTrigger" {
+		t.Errorf("Expected a startup trigger, got %s", triggers[1].Type)
+	}
+}
+
+func TestTaskTriggersFromModels_invalid(t *testing.T) {
+	_, diags := taskTriggersFromModels([]TaskTriggerModel{
+		{
+			Type:           types.StringValue("interval"),
+			IntervalTicks:  types.Int64Null(),
+			TimeOfDayTicks: types.Int64Value(0),
+			DayOfWeek:      types.StringNull(),
+		},
+	})
+
+	if diags.ErrorsCount() != 2 {
+		t.Fatalf("Expected a missing and an unsupported attribute error, got %v", diags.Errors())
+	}
+
+	if diags.Errors()[0].Summary() != "Missing Trigger Attribute" {
+		t.Errorf("Expected 'Missing Trigger Attribute', got %q", diags.Errors()[0].Summary())
+	}
+}
+
+func TestTaskTriggersToList(t *testing.T) {
+	interval := int64(432000000000)
+	list, diags := taskTriggersToList([]client.TaskTrigger{
+		{Type: "IntervalTrigger", IntervalTicks: &interval},
+		{Type: "SomeFutureTrigger"},
+	})
+
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags.Errors())
+	}
+
+	var models []TaskTriggerModel
+	diags = list.ElementsAs(context.Background(), &models, false)
+
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags.Errors())
+	}
+
+	if len(models) != 2 {
+		t.Fatalf("Expected 2 triggers, got %d", len(models))
+	}
+
+	if models[0].Type.ValueString() != "interval" || models[0].IntervalTicks.ValueInt64() != interval {
+		t.Errorf("Expected an interval trigger of %d ticks, got %+v", interval, models[0])
+	}
+
+	if !models[0].TimeOfDayTicks.IsNull() || !models[0].DayOfWeek.IsNull() {
+		t.Errorf("Expected unused attributes to be null, got %+v", models[0])
+	}
+
+	if models[1].Type.ValueString() != "SomeFutureTrigger" {
+		t.Errorf("Expected an unknown trigger type to keep its Jellyfin name, got %s", models[1].Type.ValueString())
+	}
+}
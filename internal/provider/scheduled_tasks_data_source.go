@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ScheduledTasksDataSource{}
+
+func NewScheduledTasksDataSource() datasource.DataSource {
+	return &ScheduledTasksDataSource{}
+}
+
+// ScheduledTasksDataSource defines the data source implementation.
+type ScheduledTasksDataSource struct {
+	client *client.Client
+}
+
+// ScheduledTasksDataSourceModel describes the data source data model.
+type ScheduledTasksDataSourceModel struct {
+	Category   types.String               `tfsdk:"category"`
+	Tasks      []ScheduledTasksEntryModel `tfsdk:"tasks"`
+	Categories types.List                 `tfsdk:"categories"`
+}
+
+// ScheduledTasksEntryModel describes a single task in the list.
+type ScheduledTasksEntryModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Key                 types.String `tfsdk:"key"`
+	Category            types.String `tfsdk:"category"`
+	State               types.String `tfsdk:"state"`
+	LastExecutionStatus types.String `tfsdk:"last_execution_status"`
+}
+
+func (d *ScheduledTasksDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scheduled_tasks"
+}
+
+func (d *ScheduledTasksDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the server's scheduled tasks, optionally only those in one category, " +
+			"along with every category available.",
+
+		Attributes: map[string]schema.Attribute{
+			"category": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list tasks in this category (e.g., `Library`, `Maintenance`), case-insensitive. Omit to list all tasks.",
+			},
+			"tasks": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The matching tasks, in the order returned by the server.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the task.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The display name of the task.",
+						},
+						"key": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The task's stable key, which unlike its name isn't translated.",
+						},
+						"category": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The category the task belongs to.",
+						},
+						"state": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The current state of the task: `Idle`, `Running` or `Cancelling`.",
+						},
+						"last_execution_status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "How the last run ended, or null if the task hasn't run.",
+						},
+					},
+				},
+			},
+			"categories": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Every task category on the server, sorted, regardless of `category`.",
+			},
+		},
+	}
+}
+
+func (d *ScheduledTasksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ScheduledTasksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ScheduledTasksDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tasks, err := d.client.GetScheduledTasks(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list scheduled tasks: %s", err))
+		return
+	}
+
+	categories, diags := types.ListValueFrom(ctx, types.StringType, scheduledTaskCategories(tasks))
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	matching := tasks
+	if !data.Category.IsNull() {
+		matching = filterScheduledTasks(tasks, data.Category.ValueString())
+	}
+
+	data.Tasks = make([]ScheduledTasksEntryModel, 0, len(matching))
+	for _, task := range matching {
+		status := types.StringNull()
+		if task.LastExecutionResult != nil {
+			status = optionalString(task.LastExecutionResult.Status)
+		}
+
+		data.Tasks = append(data.Tasks, ScheduledTasksEntryModel{
+			ID:                  types.StringValue(task.Id),
+			Name:                types.StringValue(task.Name),
+			Key:                 optionalString(task.Key),
+			Category:            optionalString(task.Category),
+			State:               types.StringValue(task.State),
+			LastExecutionStatus: status,
+		})
+	}
+
+	data.Categories = categories
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// filterScheduledTasks returns the tasks whose category matches case-insensitively.
+func filterScheduledTasks(tasks []client.ScheduledTask, category string) []client.ScheduledTask {
+	var matching []client.ScheduledTask
+
+	for _, task := range tasks {
+		if strings.EqualFold(task.Category, category) {
+			matching = append(matching, task)
+		}
+	}
+
+	return matching
+}
+
+// scheduledTaskCategories returns the distinct, non-empty task categories in sorted order.
+func scheduledTaskCategories(tasks []client.ScheduledTask) []string {
+	categories := []string{}
+
+	for _, task := range tasks {
+		if task.Category != "" && !slices.Contains(categories, task.Category) {
+			categories = append(categories, task.Category)
+		}
+	}
+
+	slices.Sort(categories)
+
+	return categories
+}
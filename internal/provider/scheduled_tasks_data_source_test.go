@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccScheduledTasksDataSource_category(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduledTasksDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.jellyfin_scheduled_tasks.test", "tasks.0.id"),
+					resource.TestCheckResourceAttr("data.jellyfin_scheduled_tasks.test", "tasks.0.category", "Library"),
+					resource.TestCheckTypeSetElemAttr("data.jellyfin_scheduled_tasks.test", "categories.*", "Library"),
+				),
+			},
+		},
+	})
+}
+
+const testAccScheduledTasksDataSourceConfig = `
+data "jellyfin_scheduled_tasks" "test" {
+  category = "Library"
+}
+`
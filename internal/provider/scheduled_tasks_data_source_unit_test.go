@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestScheduledTasksDataSource_Metadata(t *testing.T) {
+	ds := &ScheduledTasksDataSource{}
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_scheduled_tasks"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestScheduledTasksDataSource_Schema(t *testing.T) {
+	ds := &ScheduledTasksDataSource{}
+	req := datasource.SchemaRequest{}
+	resp := &datasource.SchemaResponse{}
+
+	ds.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check category attribute
+	categoryAttr, ok := resp.Schema.Attributes["category"]
+	if !ok {
+		t.Error("Expected 'category' attribute in schema")
+	} else {
+		if !categoryAttr.IsOptional() {
+			t.Error("Expected 'category' attribute to be optional")
+		}
+	}
+
+	// Check tasks attribute
+	tasksAttr, ok := resp.Schema.Attributes["tasks"]
+	if !ok {
+		t.Error("Expected 'tasks' attribute in schema")
+	} else {
+		if !tasksAttr.IsComputed() {
+			t.Error("Expected 'tasks' attribute to be computed")
+		}
+	}
+
+	// Check categories attribute
+	categoriesAttr, ok := resp.Schema.Attributes["categories"]
+	if !ok {
+		t.Error("Expected 'categories' attribute in schema")
+	} else {
+		if !categoriesAttr.IsComputed() {
+			t.Error("Expected 'categories' attribute to be computed")
+		}
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestScheduledTasksDataSource_Configure_nilProviderData(t *testing.T) {
+	ds := &ScheduledTasksDataSource{}
+	req := datasource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestScheduledTasksDataSource_Configure_wrongType(t *testing.T) {
+	ds := &ScheduledTasksDataSource{}
+	req := datasource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestScheduledTasksDataSource_Configure_success(t *testing.T) {
+	ds := &ScheduledTasksDataSource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := datasource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if ds.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewScheduledTasksDataSource(t *testing.T) {
+	ds := NewScheduledTasksDataSource()
+	if ds == nil {
+		t.Error("Expected data source to be instantiated")
+	}
+
+	_, ok := ds.(*ScheduledTasksDataSource)
+	if !ok {
+		t.Error("Expected data source to be *ScheduledTasksDataSource")
+	}
+}
+
+func TestFilterScheduledTasks(t *testing.T) {
+	tasks := []client.ScheduledTask{
+		{Id: "1", Name: "Scan Media Library", Category: "Library"},
+		{Id: "2", Name: "Clean Cache Directory", Category: "Maintenance"},
+		{Id: "3", Name: "Extract Chapter Images", Category: "Library"},
+	}
+
+	matching := filterScheduledTasks(tasks, "library")
+	if len(matching) != 2 || matching[0].Id != "1" || matching[1].Id != "3" {
+		t.Errorf("Expected tasks 1 and 3, got %+v", matching)
+	}
+
+	if matching := filterScheduledTasks(tasks, "Missing"); len(matching) != 0 {
+		t.Errorf("Expected no tasks, got %+v", matching)
+	}
+}
+
+func TestScheduledTaskCategories(t *testing.T) {
+	categories := scheduledTaskCategories([]client.ScheduledTask{
+		{Category: "Maintenance"},
+		{Category: "Library"},
+		{Category: "Maintenance"},
+		{},
+	})
+
+	expected := []string{"Library", "Maintenance"}
+	if !slices.Equal(categories, expected) {
+		t.Errorf("Expected %v, got %v", expected, categories)
+	}
+}
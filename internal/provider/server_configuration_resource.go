@@ -0,0 +1,321 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// serverConfigurationID is the fixed identifier of the singleton server configuration.
+const serverConfigurationID = "server_configuration"
+
+// cultureRegexp matches culture codes such as "en", "en-US" or "zh-Hant-TW".
+var cultureRegexp = regexp.MustCompile(`^[a-z]{2,3}(-[A-Za-z0-9]{2,8})*$`)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ServerConfigurationResource{}
+var _ resource.ResourceWithImportState = &ServerConfigurationResource{}
+
+func NewServerConfigurationResource() resource.Resource {
+	return &ServerConfigurationResource{}
+}
+
+// ServerConfigurationResource defines the resource implementation.
+type ServerConfigurationResource struct {
+	client *client.Client
+}
+
+// ServerConfigurationResourceModel describes the resource data model.
+type ServerConfigurationResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	UICulture         types.String `tfsdk:"ui_culture"`
+	TrickplayInterval types.Int64  `tfsdk:"trickplay_interval"`
+	TrickplayWidths   types.List   `tfsdk:"trickplay_width_resolutions"`
+	DummyChapters     types.Int64  `tfsdk:"dummy_chapter_duration"`
+}
+
+func (r *ServerConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_configuration"
+}
+
+func (r *ServerConfigurationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages selected settings of the Jellyfin server configuration. " +
+			"Only the attributes set in the configuration are written; all other server settings are preserved. " +
+			"There is one configuration per server, and destroying this resource leaves the current settings in place.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the server configuration. Always `" + serverConfigurationID + "`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ui_culture": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The default display language of the web interface (e.g., `en-US` or `de`). Must be one of the languages offered by the server.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(cultureRegexp, "must be a culture code such as \"en-US\" or \"de\""),
+				},
+			},
+			"trickplay_interval": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The interval in milliseconds between trickplay (scrubbing preview) images. Must be at least `1000`. " +
+					"Requires Jellyfin 10.9 or later; null on servers without trickplay support. " +
+					"Trickplay generation itself is enabled per library.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1000),
+				},
+			},
+			"trickplay_width_resolutions": schema.ListAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.Int64Type,
+				MarkdownDescription: "The widths in pixels of the trickplay images to generate, one set per width (e.g., `[320]`). " +
+					"Each must be between `100` and `3840`. Requires Jellyfin 10.9 or later; null on servers without trickplay support.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueInt64sAre(int64validator.Between(100, 3840)),
+				},
+			},
+			"dummy_chapter_duration": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The interval in seconds at which chapters are generated for videos that have none, " +
+					"so they get chapter images too. `0` disables generated chapters.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+		},
+	}
+}
+
+func (r *ServerConfigurationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ServerConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ServerConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Created server configuration resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServerConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ServerConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.GetConfiguration(ctx, "")
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read server configuration: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(setServerConfigurationModel(ctx, &data, config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServerConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ServerConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServerConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The server configuration can't be deleted; removing the resource from state
+	// simply stops Terraform from managing it.
+	tflog.Trace(ctx, "Deleted server configuration resource (no-op)")
+}
+
+func (r *ServerConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply writes the configured settings to the server and refreshes the model from the result.
+func (r *ServerConfigurationResource) apply(ctx context.Context, data *ServerConfigurationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	fields := map[string]interface{}{}
+
+	if !data.UICulture.IsNull() && !data.UICulture.IsUnknown() {
+		culture := data.UICulture.ValueString()
+
+		options, err := r.client.GetLocalizationOptions(ctx)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to list available UI languages: %s", err))
+			return diags
+		}
+
+		if !isKnownCulture(options, culture) {
+			values := make([]string, 0, len(options))
+			for _, option := range options {
+				values = append(values, option.Value)
+			}
+
+			diags.AddAttributeError(
+				path.Root("ui_culture"),
+				"Unsupported UI Culture",
+				fmt.Sprintf("The server does not offer the UI language %q. Available values: %s.", culture, strings.Join(values, ", ")),
+			)
+			return diags
+		}
+
+		fields["UICulture"] = culture
+	}
+
+	if !data.TrickplayInterval.IsNull() && !data.TrickplayInterval.IsUnknown() {
+		fields["TrickplayOptions.Interval"] = data.TrickplayInterval.ValueInt64()
+	}
+
+	if !data.TrickplayWidths.IsNull() && !data.TrickplayWidths.IsUnknown() {
+		var widths []int64
+		diags.Append(data.TrickplayWidths.ElementsAs(ctx, &widths, false)...)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		fields["TrickplayOptions.WidthResolutions"] = widths
+	}
+
+	if !data.DummyChapters.IsNull() && !data.DummyChapters.IsUnknown() {
+		fields["DummyChapterDuration"] = data.DummyChapters.ValueInt64()
+	}
+
+	tflog.Debug(ctx, "Updating server configuration", map[string]interface{}{
+		"fields": len(fields),
+	})
+
+	config, err := r.client.PatchConfiguration(ctx, "", fields)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update server configuration: %s", err))
+		return diags
+	}
+
+	diags.Append(setServerConfigurationModel(ctx, data, config)...)
+
+	return diags
+}
+
+// setServerConfigurationModel copies the managed settings from the server configuration into the model.
+func setServerConfigurationModel(ctx context.Context, data *ServerConfigurationResourceModel, config client.ServerConfiguration) diag.Diagnostics {
+	data.ID = types.StringValue(serverConfigurationID)
+	data.UICulture = types.StringValue(config.String("UICulture"))
+	data.DummyChapters = types.Int64Value(config.Int64("DummyChapterDuration"))
+	data.TrickplayInterval = types.Int64Null()
+	data.TrickplayWidths = types.ListNull(types.Int64Type)
+
+	trickplay := config.Section("TrickplayOptions")
+	if trickplay == nil {
+		return nil
+	}
+
+	widths, diags := types.ListValueFrom(ctx, types.Int64Type, trickplay.Int64s("WidthResolutions"))
+	if diags.HasError() {
+		return diags
+	}
+
+	data.TrickplayInterval = types.Int64Value(trickplay.Int64("Interval"))
+	data.TrickplayWidths = widths
+
+	return diags
+}
+
+// isKnownCulture reports whether culture is one of the server's localization options.
+func isKnownCulture(options []client.LocalizationOption, culture string) bool {
+	for _, option := range options {
+		if strings.EqualFold(option.Value, culture) {
+			return true
+		}
+	}
+	return false
+}
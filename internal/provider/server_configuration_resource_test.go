@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccServerConfigurationResource_uiCulture(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccServerConfigurationResourceConfig("de"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_server_configuration.test", "id", "server_configuration"),
+					resource.TestCheckResourceAttr("jellyfin_server_configuration.test", "ui_culture", "de"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "jellyfin_server_configuration.test",
+				ImportState:       true,
+				ImportStateId:     "server_configuration",
+				ImportStateVerify: true,
+			},
+			// Update testing
+			{
+				Config: testAccServerConfigurationResourceConfig("en-US"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_server_configuration.test", "ui_culture", "en-US"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServerConfigurationResource_invalidCulture(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccServerConfigurationResourceConfig("english"),
+				ExpectError: regexp.MustCompile("must be a culture code"),
+			},
+			{
+				Config:      testAccServerConfigurationResourceConfig("xx-XX"),
+				ExpectError: regexp.MustCompile("Unsupported UI Culture"),
+			},
+		},
+	})
+}
+
+func TestAccServerConfigurationResource_trickplay(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerConfigurationResourceTrickplayConfig(5000, "320, 640"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_server_configuration.test", "trickplay_interval", "5000"),
+					resource.TestCheckResourceAttr("jellyfin_server_configuration.test", "trickplay_width_resolutions.#", "2"),
+					resource.TestCheckResourceAttr("jellyfin_server_configuration.test", "trickplay_width_resolutions.1", "640"),
+				),
+			},
+			{
+				Config: testAccServerConfigurationResourceTrickplayConfig(10000, "320"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_server_configuration.test", "trickplay_interval", "10000"),
+					resource.TestCheckResourceAttr("jellyfin_server_configuration.test", "trickplay_width_resolutions.#", "1"),
+				),
+			},
+			{
+				Config:      testAccServerConfigurationResourceTrickplayConfig(500, "320"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value"),
+			},
+		},
+	})
+}
+
+func TestAccServerConfigurationResource_dummyChapterDuration(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerConfigurationResourceDummyChapterConfig(300),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_server_configuration.test", "dummy_chapter_duration", "300"),
+				),
+			},
+			{
+				Config: testAccServerConfigurationResourceDummyChapterConfig(0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_server_configuration.test", "dummy_chapter_duration", "0"),
+				),
+			},
+			{
+				Config:      testAccServerConfigurationResourceDummyChapterConfig(-1),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value"),
+			},
+		},
+	})
+}
+
+func testAccServerConfigurationResourceDummyChapterConfig(seconds int) string {
+	return fmt.Sprintf(`
+resource "jellyfin_server_configuration" "test" {
+  dummy_chapter_duration = %[1]d
+}
+`, seconds)
+}
+
+func testAccServerConfigurationResourceTrickplayConfig(interval int, widths string) string {
+	return fmt.Sprintf(`
+resource "jellyfin_server_configuration" "test" {
+  trickplay_interval          = %[1]d
+  trickplay_width_resolutions = [%[2]s]
+}
+`, interval, widths)
+}
+
+func testAccServerConfigurationResourceConfig(uiCulture string) string {
+	return fmt.Sprintf(`
+resource "jellyfin_server_configuration" "test" {
+  ui_culture = %[1]q
+}
+`, uiCulture)
+}
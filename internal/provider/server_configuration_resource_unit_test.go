@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestServerConfigurationResource_Metadata(t *testing.T) {
+	r := &ServerConfigurationResource{}
+	req := resource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &resource.MetadataResponse{}
+
+	r.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_server_configuration"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestServerConfigurationResource_Schema(t *testing.T) {
+	r := &ServerConfigurationResource{}
+	req := resource.SchemaRequest{}
+	resp := &resource.SchemaResponse{}
+
+	r.Schema(context.Background(), req, resp)
+
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	idAttr, ok := resp.Schema.Attributes["id"]
+	if !ok {
+		t.Error("Expected 'id' attribute in schema")
+	} else if !idAttr.IsComputed() {
+		t.Error("Expected 'id' attribute to be computed")
+	}
+
+	cultureAttr, ok := resp.Schema.Attributes["ui_culture"]
+	if !ok {
+		t.Error("Expected 'ui_culture' attribute in schema")
+	} else {
+		if !cultureAttr.IsOptional() {
+			t.Error("Expected 'ui_culture' attribute to be optional")
+		}
+		if !cultureAttr.IsComputed() {
+			t.Error("Expected 'ui_culture' attribute to be computed")
+		}
+	}
+
+	for _, name := range []string{"trickplay_interval", "trickplay_width_resolutions", "dummy_chapter_duration"} {
+		attr, ok := resp.Schema.Attributes[name]
+		if !ok {
+			t.Errorf("Expected '%s' attribute in schema", name)
+		} else if !attr.IsOptional() || !attr.IsComputed() {
+			t.Errorf("Expected '%s' attribute to be optional and computed", name)
+		}
+	}
+
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestServerConfigurationResource_Configure_nilProviderData(t *testing.T) {
+	r := &ServerConfigurationResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestServerConfigurationResource_Configure_wrongType(t *testing.T) {
+	r := &ServerConfigurationResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: "wrong type",
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestServerConfigurationResource_Configure_success(t *testing.T) {
+	r := &ServerConfigurationResource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := resource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if r.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewServerConfigurationResource(t *testing.T) {
+	r := NewServerConfigurationResource()
+	if r == nil {
+		t.Error("Expected resource to be instantiated")
+	}
+
+	_, ok := r.(*ServerConfigurationResource)
+	if !ok {
+		t.Error("Expected resource to be *ServerConfigurationResource")
+	}
+}
+
+func TestCultureRegexp(t *testing.T) {
+	testCases := []struct {
+		value string
+		valid bool
+	}{
+		{"en-US", true},
+		{"de", true},
+		{"pt-BR", true},
+		{"zh-Hant-TW", true},
+		{"EN-us", false},
+		{"english", false},
+		{"en_US", false},
+		{"", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.value, func(t *testing.T) {
+			if got := cultureRegexp.MatchString(tc.value); got != tc.valid {
+				t.Errorf("Expected match %t for %q, got %t", tc.valid, tc.value, got)
+			}
+		})
+	}
+}
+
+func TestIsKnownCulture(t *testing.T) {
+	options := []client.LocalizationOption{
+		{Name: "English (United States)", Value: "en-US"},
+		{Name: "Deutsch", Value: "de"},
+	}
+
+	if !isKnownCulture(options, "en-US") {
+		t.Error("Expected en-US to be known")
+	}
+
+	if !isKnownCulture(options, "en-us") {
+		t.Error("Expected culture comparison to be case-insensitive")
+	}
+
+	if isKnownCulture(options, "fr") {
+		t.Error("Expected fr to be unknown")
+	}
+}
+
+func TestSetServerConfigurationModel(t *testing.T) {
+	t.Run("trickplay", func(t *testing.T) {
+		data := &ServerConfigurationResourceModel{}
+
+		diags := setServerConfigurationModel(context.Background(), data, client.ServerConfiguration{
+			"UICulture":            []byte(`"de"`),
+			"TrickplayOptions":     []byte(`{"Interval":10000,"WidthResolutions":[320,640]}`),
+			"DummyChapterDuration": []byte(`300`),
+		})
+		if diags.HasError() {
+			t.Fatalf("Expected no errors, got %v", diags)
+		}
+
+		if data.DummyChapters.ValueInt64() != 300 {
+			t.Errorf("Expected dummy_chapter_duration 300, got %s", data.DummyChapters)
+		}
+
+		if data.TrickplayInterval.ValueInt64() != 10000 {
+			t.Errorf("Expected trickplay_interval 10000, got %s", data.TrickplayInterval)
+		}
+
+		if len(data.TrickplayWidths.Elements()) != 2 {
+			t.Errorf("Expected 2 trickplay widths, got %s", data.TrickplayWidths)
+		}
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		data := &ServerConfigurationResourceModel{}
+
+		diags := setServerConfigurationModel(context.Background(), data, client.ServerConfiguration{
+			"UICulture": []byte(`"de"`),
+		})
+		if diags.HasError() {
+			t.Fatalf("Expected no errors, got %v", diags)
+		}
+
+		if !data.TrickplayInterval.IsNull() || !data.TrickplayWidths.IsNull() {
+			t.Errorf("Expected null trickplay settings without TrickplayOptions, got %s and %s", data.TrickplayInterval, data.TrickplayWidths)
+		}
+	})
+}
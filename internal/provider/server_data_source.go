@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ServerDataSource{}
+
+func NewServerDataSource() datasource.DataSource {
+	return &ServerDataSource{}
+}
+
+// ServerDataSource defines the data source implementation.
+type ServerDataSource struct {
+	client *client.Client
+}
+
+// ServerDataSourceModel describes the data source data model.
+type ServerDataSourceModel struct {
+	ID                     types.String `tfsdk:"id"`
+	ServerName             types.String `tfsdk:"server_name"`
+	Version                types.String `tfsdk:"version"`
+	ProductName            types.String `tfsdk:"product_name"`
+	OperatingSystem        types.String `tfsdk:"operating_system"`
+	LocalAddress           types.String `tfsdk:"local_address"`
+	StartupWizardCompleted types.Bool   `tfsdk:"startup_wizard_completed"`
+	HasPendingRestart      types.Bool   `tfsdk:"has_pending_restart"`
+	StartTime              types.String `tfsdk:"start_time"`
+	UptimeSeconds          types.Int64  `tfsdk:"uptime_seconds"`
+	MetadataPath           types.String `tfsdk:"metadata_path"`
+	CachePath              types.String `tfsdk:"cache_path"`
+	TranscodingTempPath    types.String `tfsdk:"transcoding_temp_path"`
+}
+
+func (d *ServerDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server"
+}
+
+func (d *ServerDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves information about the Jellyfin server the provider is connected to.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the server.",
+			},
+			"server_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The display name of the server.",
+			},
+			"version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The server version (e.g., `10.9.11`).",
+			},
+			"product_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The product name reported by the server.",
+			},
+			"operating_system": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The operating system the server runs on.",
+			},
+			"local_address": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The local network address of the server.",
+			},
+			"startup_wizard_completed": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the initial setup wizard has been completed.",
+			},
+			"has_pending_restart": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the server needs a restart to apply pending changes.",
+			},
+			"start_time": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: "When the server process started, in RFC 3339 format. " +
+					"Null if the server version doesn't report it.",
+			},
+			"uptime_seconds": schema.Int64Attribute{
+				Computed: true,
+				MarkdownDescription: "The number of seconds since the server process started, as of this read. " +
+					"Null if the server version doesn't report its start time.",
+			},
+			"metadata_path": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The directory where the server stores downloaded metadata. Null if the server version doesn't report it.",
+			},
+			"cache_path": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The server's cache directory. Null if the server version doesn't report it.",
+			},
+			"transcoding_temp_path": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The directory for temporary transcoding files. Null if the server version doesn't report it.",
+			},
+		},
+	}
+}
+
+func (d *ServerDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ServerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ServerDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	info, err := d.client.GetSystemInfo(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read server information: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(info.Id)
+	data.ServerName = types.StringValue(info.ServerName)
+	data.Version = types.StringValue(info.Version)
+	data.ProductName = types.StringValue(info.ProductName)
+	data.OperatingSystem = types.StringValue(info.OperatingSystem)
+	data.LocalAddress = types.StringValue(info.LocalAddress)
+	data.StartupWizardCompleted = types.BoolValue(info.StartupWizardCompleted)
+	data.HasPendingRestart = types.BoolValue(info.HasPendingRestart)
+	data.MetadataPath = types.StringPointerValue(info.InternalMetadataPath)
+	data.CachePath = types.StringPointerValue(info.CachePath)
+	data.TranscodingTempPath = types.StringPointerValue(info.TranscodingTempPath)
+	data.StartTime = types.StringNull()
+	data.UptimeSeconds = types.Int64Null()
+
+	if info.StartTime != nil {
+		data.StartTime = types.StringValue(info.StartTime.UTC().Format(time.RFC3339))
+		data.UptimeSeconds = types.Int64Value(uptimeSeconds(*info.StartTime, time.Now()))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// uptimeSeconds returns the whole seconds elapsed since start, never negative.
+func uptimeSeconds(start, now time.Time) int64 {
+	uptime := int64(now.Sub(start) / time.Second)
+	if uptime < 0 {
+		return 0
+	}
+	return uptime
+}
@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccServerDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.jellyfin_server.test", "id"),
+					resource.TestCheckResourceAttrSet("data.jellyfin_server.test", "version"),
+					resource.TestCheckResourceAttrSet("data.jellyfin_server.test", "server_name"),
+				),
+			},
+		},
+	})
+}
+
+const testAccServerDataSourceConfig = `
+data "jellyfin_server" "test" {}
+`
@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestServerDataSource_Metadata(t *testing.T) {
+	ds := &ServerDataSource{}
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_server"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestServerDataSource_Schema(t *testing.T) {
+	ds := &ServerDataSource{}
+	req := datasource.SchemaRequest{}
+	resp := &datasource.SchemaResponse{}
+
+	ds.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check id attribute
+	idAttr, ok := resp.Schema.Attributes["id"]
+	if !ok {
+		t.Error("Expected 'id' attribute in schema")
+	} else {
+		if !idAttr.IsComputed() {
+			t.Error("Expected 'id' attribute to be computed")
+		}
+	}
+
+	// Check server_name attribute
+	serverNameAttr, ok := resp.Schema.Attributes["server_name"]
+	if !ok {
+		t.Error("Expected 'server_name' attribute in schema")
+	} else {
+		if !serverNameAttr.IsComputed() {
+			t.Error("Expected 'server_name' attribute to be computed")
+		}
+	}
+
+	// Check version attribute
+	versionAttr, ok := resp.Schema.Attributes["version"]
+	if !ok {
+		t.Error("Expected 'version' attribute in schema")
+	} else {
+		if !versionAttr.IsComputed() {
+			t.Error("Expected 'version' attribute to be computed")
+		}
+	}
+
+	// Check start_time attribute
+	startTimeAttr, ok := resp.Schema.Attributes["start_time"]
+	if !ok {
+		t.Error("Expected 'start_time' attribute in schema")
+	} else {
+		if !startTimeAttr.IsComputed() {
+			t.Error("Expected 'start_time' attribute to be computed")
+		}
+	}
+
+	// Check uptime_seconds attribute
+	uptimeSecondsAttr, ok := resp.Schema.Attributes["uptime_seconds"]
+	if !ok {
+		t.Error("Expected 'uptime_seconds' attribute in schema")
+	} else {
+		if !uptimeSecondsAttr.IsComputed() {
+			t.Error("Expected 'uptime_seconds' attribute to be computed")
+		}
+	}
+
+	// Check metadata_path attribute
+	if attr, ok := resp.Schema.Attributes["metadata_path"]; !ok {
+		t.Error("Expected 'metadata_path' attribute in schema")
+	} else if !attr.IsComputed() {
+		t.Error("Expected 'metadata_path' attribute to be computed")
+	}
+
+	// Check cache_path attribute
+	if attr, ok := resp.Schema.Attributes["cache_path"]; !ok {
+		t.Error("Expected 'cache_path' attribute in schema")
+	} else if !attr.IsComputed() {
+		t.Error("Expected 'cache_path' attribute to be computed")
+	}
+
+	// Check transcoding_temp_path attribute
+	if attr, ok := resp.Schema.Attributes["transcoding_temp_path"]; !ok {
+		t.Error("Expected 'transcoding_temp_path' attribute in schema")
+	} else if !attr.IsComputed() {
+		t.Error("Expected 'transcoding_temp_path' attribute to be computed")
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestServerDataSource_Configure_nilProviderData(t *testing.T) {
+	ds := &ServerDataSource{}
+	req := datasource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestServerDataSource_Configure_wrongType(t *testing.T) {
+	ds := &ServerDataSource{}
+	req := datasource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestServerDataSource_Configure_success(t *testing.T) {
+	ds := &ServerDataSource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := datasource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if ds.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewServerDataSource(t *testing.T) {
+	ds := NewServerDataSource()
+	if ds == nil {
+		t.Error("Expected data source to be instantiated")
+	}
+
+	_, ok := ds.(*ServerDataSource)
+	if !ok {
+		t.Error("Expected data source to be *ServerDataSource")
+	}
+}
+
+func TestUptimeSeconds(t *testing.T) {
+	start := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	if got := uptimeSeconds(start, start.Add(90*time.Minute+500*time.Millisecond)); got != 5400 {
+		t.Errorf("Expected 5400 seconds, got %d", got)
+	}
+
+	// Clock skew between the server and Terraform must not produce a negative uptime.
+	if got := uptimeSeconds(start, start.Add(-time.Minute)); got != 0 {
+		t.Errorf("Expected 0 seconds for a start time in the future, got %d", got)
+	}
+}
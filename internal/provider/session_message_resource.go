@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SessionMessageResource{}
+
+func NewSessionMessageResource() resource.Resource {
+	return &SessionMessageResource{}
+}
+
+// SessionMessageResource defines the resource implementation.
+type SessionMessageResource struct {
+	client *client.Client
+}
+
+// SessionMessageResourceModel describes the resource data model.
+type SessionMessageResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	SessionID types.String `tfsdk:"session_id"`
+	Header    types.String `tfsdk:"header"`
+	Text      types.String `tfsdk:"text"`
+	TimeoutMs types.Int64  `tfsdk:"timeout_ms"`
+	Triggers  types.Map    `tfsdk:"triggers"`
+}
+
+func (r *SessionMessageResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_session_message"
+}
+
+func (r *SessionMessageResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Displays a message on a session's client when created, such as a maintenance notice. " +
+			"Change any argument or `triggers` to send the message again; destroying this resource does nothing.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of this resource. Same as `session_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"session_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the session to send the message to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"header": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The title shown above the message.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"text": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The message to display.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"timeout_ms": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "How long the client shows the message, in milliseconds. When unset the message stays until dismissed.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Arbitrary values that, when changed, send the message again.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SessionMessageResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SessionMessageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SessionMessageResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sessionID := data.SessionID.ValueString()
+
+	if err := r.client.SendSessionMessage(ctx, sessionID, sessionMessage(data)); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to send message to session %s: %s", sessionID, err))
+		return
+	}
+
+	data.ID = types.StringValue(sessionID)
+
+	tflog.Trace(ctx, "Sent session message", map[string]interface{}{
+		"session_id": sessionID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SessionMessageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Sending a message is a one-off action; there is nothing on the server to refresh.
+	var data SessionMessageResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SessionMessageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every attribute forces replacement, so Update is never called with real changes.
+	var data SessionMessageResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SessionMessageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// A displayed message can't be recalled; removing the resource only forgets it.
+	tflog.Trace(ctx, "Deleted session message resource (no-op)")
+}
+
+// sessionMessage builds the message to send from the resource model.
+func sessionMessage(data SessionMessageResourceModel) client.SessionMessage {
+	message := client.SessionMessage{
+		Header: data.Header.ValueString(),
+		Text:   data.Text.ValueString(),
+	}
+
+	if !data.TimeoutMs.IsNull() && !data.TimeoutMs.IsUnknown() {
+		timeout := data.TimeoutMs.ValueInt64()
+		message.TimeoutMs = &timeout
+	}
+
+	return message
+}
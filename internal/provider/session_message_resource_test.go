@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSessionMessageResource_goneSession(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// A message can't be delivered to a session that doesn't exist.
+			{
+				Config: `
+resource "jellyfin_session_message" "test" {
+  session_id = "00000000000000000000000000000000"
+  header     = "Maintenance"
+  text       = "The server restarts in 10 minutes."
+  timeout_ms = 10000
+}
+`,
+				ExpectError: regexp.MustCompile("Unable to send message to session"),
+			},
+		},
+	})
+}
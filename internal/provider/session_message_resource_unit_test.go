@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestSessionMessageResource_Metadata(t *testing.T) {
+	r := &SessionMessageResource{}
+	req := resource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &resource.MetadataResponse{}
+
+	r.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_session_message"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestSessionMessageResource_Schema(t *testing.T) {
+	r := &SessionMessageResource{}
+	req := resource.SchemaRequest{}
+	resp := &resource.SchemaResponse{}
+
+	r.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check id attribute
+	idAttr, ok := resp.Schema.Attributes["id"]
+	if !ok {
+		t.Error("Expected 'id' attribute in schema")
+	} else {
+		if !idAttr.IsComputed() {
+			t.Error("Expected 'id' attribute to be computed")
+		}
+	}
+
+	// Check session_id attribute
+	sessionIdAttr, ok := resp.Schema.Attributes["session_id"]
+	if !ok {
+		t.Error("Expected 'session_id' attribute in schema")
+	} else {
+		if !sessionIdAttr.IsRequired() {
+			t.Error("Expected 'session_id' attribute to be required")
+		}
+	}
+
+	// Check header attribute
+	headerAttr, ok := resp.Schema.Attributes["header"]
+	if !ok {
+		t.Error("Expected 'header' attribute in schema")
+	} else {
+		if !headerAttr.IsOptional() {
+			t.Error("Expected 'header' attribute to be optional")
+		}
+	}
+
+	// Check text attribute
+	textAttr, ok := resp.Schema.Attributes["text"]
+	if !ok {
+		t.Error("Expected 'text' attribute in schema")
+	} else {
+		if !textAttr.IsRequired() {
+			t.Error("Expected 'text' attribute to be required")
+		}
+	}
+
+	// Check timeout_ms attribute
+	timeoutMsAttr, ok := resp.Schema.Attributes["timeout_ms"]
+	if !ok {
+		t.Error("Expected 'timeout_ms' attribute in schema")
+	} else {
+		if !timeoutMsAttr.IsOptional() {
+			t.Error("Expected 'timeout_ms' attribute to be optional")
+		}
+	}
+
+	// Check triggers attribute
+	triggersAttr, ok := resp.Schema.Attributes["triggers"]
+	if !ok {
+		t.Error("Expected 'triggers' attribute in schema")
+	} else {
+		if !triggersAttr.IsOptional() {
+			t.Error("Expected 'triggers' attribute to be optional")
+		}
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestSessionMessageResource_Configure_nilProviderData(t *testing.T) {
+	r := &SessionMessageResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestSessionMessageResource_Configure_wrongType(t *testing.T) {
+	r := &SessionMessageResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestSessionMessageResource_Configure_success(t *testing.T) {
+	r := &SessionMessageResource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := resource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if r.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewSessionMessageResource(t *testing.T) {
+	r := NewSessionMessageResource()
+	if r == nil {
+		t.Error("Expected resource to be instantiated")
+	}
+
+	_, ok := r.(*SessionMessageResource)
+	if !ok {
+		t.Error("Expected resource to be *SessionMessageResource")
+	}
+}
+
+func TestSessionMessage(t *testing.T) {
+	data := SessionMessageResourceModel{
+		Header:    types.StringValue("Maintenance"),
+		Text:      types.StringValue("Restarting soon"),
+		TimeoutMs: types.Int64Value(5000),
+	}
+
+	message := sessionMessage(data)
+
+	if message.Header != "Maintenance" || message.Text != "Restarting soon" {
+		t.Errorf("Expected header and text to be copied, got %+v", message)
+	}
+
+	if message.TimeoutMs == nil || *message.TimeoutMs != 5000 {
+		t.Errorf("Expected timeout 5000, got %v", message.TimeoutMs)
+	}
+
+	data.Header = types.StringNull()
+	data.TimeoutMs = types.Int64Null()
+	message = sessionMessage(data)
+
+	if message.Header != "" {
+		t.Errorf("Expected empty header, got %q", message.Header)
+	}
+
+	if message.TimeoutMs != nil {
+		t.Errorf("Expected no timeout, got %d", *message.TimeoutMs)
+	}
+}
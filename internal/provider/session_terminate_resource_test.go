@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSessionTerminateResource_goneSession(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// A session that doesn't exist counts as already terminated.
+			{
+				Config: testAccSessionTerminateResourceConfig("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_session_terminate.test", "id", "00000000000000000000000000000000"),
+					resource.TestCheckResourceAttr("jellyfin_session_terminate.test", "triggers.run", "1"),
+				),
+			},
+			// Changing triggers terminates again.
+			{
+				Config: testAccSessionTerminateResourceConfig("2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_session_terminate.test", "triggers.run", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSessionTerminateResourceConfig(run string) string {
+	return fmt.Sprintf(`
+resource "jellyfin_session_terminate" "test" {
+  session_id = "00000000000000000000000000000000"
+
+  triggers = {
+    run = %[1]q
+  }
+}
+`, run)
+}
@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestSessionTerminateResource_Metadata(t *testing.T) {
+	r := &SessionTerminateResource{}
+	req := resource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &resource.MetadataResponse{}
+
+	r.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_session_terminate"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestSessionTerminateResource_Schema(t *testing.T) {
+	r := &SessionTerminateResource{}
+	req := resource.SchemaRequest{}
+	resp := &resource.SchemaResponse{}
+
+	r.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check id attribute
+	idAttr, ok := resp.Schema.Attributes["id"]
+	if !ok {
+		t.Error("Expected 'id' attribute in schema")
+	} else {
+		if !idAttr.IsComputed() {
+			t.Error("Expected 'id' attribute to be computed")
+		}
+	}
+
+	// Check session_id attribute
+	sessionIdAttr, ok := resp.Schema.Attributes["session_id"]
+	if !ok {
+		t.Error("Expected 'session_id' attribute in schema")
+	} else {
+		if !sessionIdAttr.IsRequired() {
+			t.Error("Expected 'session_id' attribute to be required")
+		}
+	}
+
+	// Check triggers attribute
+	triggersAttr, ok := resp.Schema.Attributes["triggers"]
+	if !ok {
+		t.Error("Expected 'triggers' attribute in schema")
+	} else {
+		if !triggersAttr.IsOptional() {
+			t.Error("Expected 'triggers' attribute to be optional")
+		}
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestSessionTerminateResource_Configure_nilProviderData(t *testing.T) {
+	r := &SessionTerminateResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestSessionTerminateResource_Configure_wrongType(t *testing.T) {
+	r := &SessionTerminateResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestSessionTerminateResource_Configure_success(t *testing.T) {
+	r := &SessionTerminateResource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := resource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if r.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewSessionTerminateResource(t *testing.T) {
+	r := NewSessionTerminateResource()
+	if r == nil {
+		t.Error("Expected resource to be instantiated")
+	}
+
+	_, ok := r.(*SessionTerminateResource)
+	if !ok {
+		t.Error("Expected resource to be *SessionTerminateResource")
+	}
+}
@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// subtitleModes are the subtitle playback modes supported by Jellyfin.
+var subtitleModes = []string{"Default", "Always", "OnlyForced", "None", "Smart"}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &UserConfigurationResource{}
+var _ resource.ResourceWithImportState = &UserConfigurationResource{}
+
+func NewUserConfigurationResource() resource.Resource {
+	return &UserConfigurationResource{}
+}
+
+// UserConfigurationResource defines the resource implementation.
+type UserConfigurationResource struct {
+	client *client.Client
+}
+
+// UserConfigurationResourceModel describes the resource data model.
+type UserConfigurationResourceModel struct {
+	ID                         types.String `tfsdk:"id"`
+	UserID                     types.String `tfsdk:"user_id"`
+	AudioLanguagePreference    types.String `tfsdk:"audio_language_preference"`
+	SubtitleLanguagePreference types.String `tfsdk:"subtitle_language_preference"`
+	SubtitleMode               types.String `tfsdk:"subtitle_mode"`
+}
+
+func (r *UserConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_configuration"
+}
+
+func (r *UserConfigurationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a user's playback preferences, such as preferred audio and subtitle languages. " +
+			"Only the attributes set in the configuration are written; all other user settings are preserved. " +
+			"Destroying this resource leaves the current settings in place.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for this resource. Same as `user_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the user whose preferences are managed.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"audio_language_preference": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The preferred audio language as a three-letter ISO 639-2 code (e.g., `eng`). An empty string means no preference.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subtitle_language_preference": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The preferred subtitle language as a three-letter ISO 639-2 code (e.g., `eng`). An empty string means no preference.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subtitle_mode": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "When subtitles are shown. One of `Default`, `Always`, `OnlyForced`, `None` or `Smart` " +
+					"(show subtitles when the audio language differs from the preferred one).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(subtitleModes...),
+				},
+			},
+		},
+	}
+}
+
+func (r *UserConfigurationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *UserConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Created user configuration resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.client.GetUser(ctx, data.UserID.ValueString())
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user configuration: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(user.Id)
+	setUserConfigurationModel(&data, user.Configuration)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data UserConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// A user's configuration can't be deleted; removing the resource from state
+	// simply stops Terraform from managing it.
+	tflog.Trace(ctx, "Deleted user configuration resource (no-op)")
+}
+
+func (r *UserConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), req.ID)...)
+}
+
+// apply writes the configured preferences to the server and refreshes the model from the result.
+func (r *UserConfigurationResource) apply(ctx context.Context, data *UserConfigurationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	fields := map[string]interface{}{}
+
+	if !data.AudioLanguagePreference.IsNull() && !data.AudioLanguagePreference.IsUnknown() {
+		fields["AudioLanguagePreference"] = data.AudioLanguagePreference.ValueString()
+	}
+	if !data.SubtitleLanguagePreference.IsNull() && !data.SubtitleLanguagePreference.IsUnknown() {
+		fields["SubtitleLanguagePreference"] = data.SubtitleLanguagePreference.ValueString()
+	}
+	if !data.SubtitleMode.IsNull() && !data.SubtitleMode.IsUnknown() {
+		fields["SubtitleMode"] = data.SubtitleMode.ValueString()
+	}
+
+	userID := data.UserID.ValueString()
+
+	tflog.Debug(ctx, "Updating user configuration", map[string]interface{}{
+		"user_id": userID,
+		"fields":  len(fields),
+	})
+
+	config, err := r.client.PatchUserConfiguration(ctx, userID, fields)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update user configuration: %s", err))
+		return diags
+	}
+
+	data.ID = types.StringValue(userID)
+	setUserConfigurationModel(data, config)
+
+	return diags
+}
+
+// setUserConfigurationModel copies the managed preferences from a user configuration into the model.
+func setUserConfigurationModel(data *UserConfigurationResourceModel, config client.UserConfiguration) {
+	data.AudioLanguagePreference = types.StringValue(config.String("AudioLanguagePreference"))
+	data.SubtitleLanguagePreference = types.StringValue(config.String("SubtitleLanguagePreference"))
+	data.SubtitleMode = types.StringValue(config.String("SubtitleMode"))
+}
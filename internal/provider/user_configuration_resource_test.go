@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserConfigurationResource_basic(t *testing.T) {
+	userID := os.Getenv("JELLYFIN_TEST_USER_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t); testAccPreCheckUser(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccUserConfigurationResourceConfig(userID, "eng", "Smart"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_user_configuration.test", "id", userID),
+					resource.TestCheckResourceAttr("jellyfin_user_configuration.test", "subtitle_language_preference", "eng"),
+					resource.TestCheckResourceAttr("jellyfin_user_configuration.test", "subtitle_mode", "Smart"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "jellyfin_user_configuration.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update testing
+			{
+				Config: testAccUserConfigurationResourceConfig(userID, "ger", "Always"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_user_configuration.test", "subtitle_language_preference", "ger"),
+					resource.TestCheckResourceAttr("jellyfin_user_configuration.test", "subtitle_mode", "Always"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUserConfigurationResource_invalidSubtitleMode(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccUserConfigurationResourceConfig("user", "eng", "Sometimes"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+		},
+	})
+}
+
+func testAccUserConfigurationResourceConfig(userID, subtitleLanguage, subtitleMode string) string {
+	return fmt.Sprintf(`
+resource "jellyfin_user_configuration" "test" {
+  user_id                      = %[1]q
+  subtitle_language_preference = %[2]q
+  subtitle_mode                = %[3]q
+}
+`, userID, subtitleLanguage, subtitleMode)
+}
@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestUserConfigurationResource_Metadata(t *testing.T) {
+	r := &UserConfigurationResource{}
+	req := resource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &resource.MetadataResponse{}
+
+	r.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_user_configuration"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestUserConfigurationResource_Schema(t *testing.T) {
+	r := &UserConfigurationResource{}
+	req := resource.SchemaRequest{}
+	resp := &resource.SchemaResponse{}
+
+	r.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check id attribute
+	idAttr, ok := resp.Schema.Attributes["id"]
+	if !ok {
+		t.Error("Expected 'id' attribute in schema")
+	} else {
+		if !idAttr.IsComputed() {
+			t.Error("Expected 'id' attribute to be computed")
+		}
+	}
+
+	// Check user_id attribute
+	userIdAttr, ok := resp.Schema.Attributes["user_id"]
+	if !ok {
+		t.Error("Expected 'user_id' attribute in schema")
+	} else {
+		if !userIdAttr.IsRequired() {
+			t.Error("Expected 'user_id' attribute to be required")
+		}
+	}
+
+	// Check audio_language_preference attribute
+	audioLanguagePreferenceAttr, ok := resp.Schema.Attributes["audio_language_preference"]
+	if !ok {
+		t.Error("Expected 'audio_language_preference' attribute in schema")
+	} else {
+		if !audioLanguagePreferenceAttr.IsOptional() {
+			t.Error("Expected 'audio_language_preference' attribute to be optional")
+		}
+		if !audioLanguagePreferenceAttr.IsComputed() {
+			t.Error("Expected 'audio_language_preference' attribute to be computed")
+		}
+	}
+
+	// Check subtitle_language_preference attribute
+	subtitleLanguagePreferenceAttr, ok := resp.Schema.Attributes["subtitle_language_preference"]
+	if !ok {
+		t.Error("Expected 'subtitle_language_preference' attribute in schema")
+	} else {
+		if !subtitleLanguagePreferenceAttr.IsOptional() {
+			t.Error("Expected 'subtitle_language_preference' attribute to be optional")
+		}
+		if !subtitleLanguagePreferenceAttr.IsComputed() {
+			t.Error("Expected 'subtitle_language_preference' attribute to be computed")
+		}
+	}
+
+	// Check subtitle_mode attribute
+	subtitleModeAttr, ok := resp.Schema.Attributes["subtitle_mode"]
+	if !ok {
+		t.Error("Expected 'subtitle_mode' attribute in schema")
+	} else {
+		if !subtitleModeAttr.IsOptional() {
+			t.Error("Expected 'subtitle_mode' attribute to be optional")
+		}
+		if !subtitleModeAttr.IsComputed() {
+			t.Error("Expected 'subtitle_mode' attribute to be computed")
+		}
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestUserConfigurationResource_Configure_nilProviderData(t *testing.T) {
+	r := &UserConfigurationResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestUserConfigurationResource_Configure_wrongType(t *testing.T) {
+	r := &UserConfigurationResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestUserConfigurationResource_Configure_success(t *testing.T) {
+	r := &UserConfigurationResource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := resource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if r.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewUserConfigurationResource(t *testing.T) {
+	r := NewUserConfigurationResource()
+	if r == nil {
+		t.Error("Expected resource to be instantiated")
+	}
+
+	_, ok := r.(*UserConfigurationResource)
+	if !ok {
+		t.Error("Expected resource to be *UserConfigurationResource")
+	}
+}
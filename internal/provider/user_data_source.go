@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UserDataSource{}
+
+func NewUserDataSource() datasource.DataSource {
+	return &UserDataSource{}
+}
+
+// UserDataSource defines the data source implementation.
+type UserDataSource struct {
+	client *client.Client
+}
+
+// UserDataSourceModel describes the data source data model.
+type UserDataSourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	IsAdministrator types.Bool   `tfsdk:"is_administrator"`
+	LastLoginDate   types.String `tfsdk:"last_login_date"`
+}
+
+func (d *UserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (d *UserDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves information about an existing Jellyfin user without managing it.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the user. Either id or name must be provided.",
+			},
+			"name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The name of the user, compared case-insensitively. Either id or name must be provided. " +
+					"When both are set, the user is looked up by id.",
+			},
+			"is_administrator": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the user is an administrator.",
+			},
+			"last_login_date": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the user last logged in, if ever.",
+			},
+		},
+	}
+}
+
+func (d *UserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UserDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasID := !data.ID.IsNull() && !data.ID.IsUnknown()
+	hasName := !data.Name.IsNull() && !data.Name.IsUnknown()
+
+	if !hasID && !hasName {
+		resp.Diagnostics.AddError(
+			"Missing Required Attribute",
+			"Either 'id' or 'name' must be provided to look up a user.",
+		)
+		return
+	}
+
+	var user *client.User
+	var err error
+
+	if hasID {
+		user, err = d.client.GetUser(ctx, data.ID.ValueString())
+		if client.IsNotFound(err) {
+			user, err = nil, nil
+		}
+	} else {
+		user, err = d.client.FindUserByName(ctx, data.Name.ValueString())
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user: %s", err))
+		return
+	}
+
+	if user == nil {
+		resp.Diagnostics.AddError(
+			"User Not Found",
+			"The specified user was not found.",
+		)
+		return
+	}
+
+	data.ID = types.StringValue(user.Id)
+	data.Name = types.StringValue(user.Name)
+	data.IsAdministrator = types.BoolValue(user.Policy.Bool("IsAdministrator"))
+	data.LastLoginDate = optionalString(user.LastLoginDate)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
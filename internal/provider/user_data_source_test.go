@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserDataSource_byName(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserDataSourceConfig("tf-acc-user-datasource", `name = jellyfin_user.source.name`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.jellyfin_user.test", "id",
+						"jellyfin_user.source", "id",
+					),
+					resource.TestCheckResourceAttr("data.jellyfin_user.test", "name", "tf-acc-user-datasource"),
+					resource.TestCheckResourceAttr("data.jellyfin_user.test", "is_administrator", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUserDataSource_byID(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserDataSourceConfig("tf-acc-user-datasource-id", `id = jellyfin_user.source.id`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.jellyfin_user.test", "name",
+						"jellyfin_user.source", "name",
+					),
+					resource.TestCheckResourceAttr("data.jellyfin_user.test", "is_administrator", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUserDataSource_missingLookup(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      `data "jellyfin_user" "test" {}`,
+				ExpectError: regexp.MustCompile("Missing Required Attribute"),
+			},
+		},
+	})
+}
+
+func testAccUserDataSourceConfig(name, lookup string) string {
+	return fmt.Sprintf(`
+resource "jellyfin_user" "source" {
+  name = %[1]q
+}
+
+data "jellyfin_user" "test" {
+  %[2]s
+}
+`, name, lookup)
+}
@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestUserDataSource_Metadata(t *testing.T) {
+	ds := &UserDataSource{}
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_user"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestUserDataSource_Schema(t *testing.T) {
+	ds := &UserDataSource{}
+	req := datasource.SchemaRequest{}
+	resp := &datasource.SchemaResponse{}
+
+	ds.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check id attribute
+	idAttr, ok := resp.Schema.Attributes["id"]
+	if !ok {
+		t.Error("Expected 'id' attribute in schema")
+	} else {
+		if !idAttr.IsOptional() {
+			t.Error("Expected 'id' attribute to be optional")
+		}
+		if !idAttr.IsComputed() {
+			t.Error("Expected 'id' attribute to be computed")
+		}
+	}
+
+	// Check name attribute
+	nameAttr, ok := resp.Schema.Attributes["name"]
+	if !ok {
+		t.Error("Expected 'name' attribute in schema")
+	} else {
+		if !nameAttr.IsOptional() {
+			t.Error("Expected 'name' attribute to be optional")
+		}
+		if !nameAttr.IsComputed() {
+			t.Error("Expected 'name' attribute to be computed")
+		}
+	}
+
+	// Check is_administrator attribute
+	isAdministratorAttr, ok := resp.Schema.Attributes["is_administrator"]
+	if !ok {
+		t.Error("Expected 'is_administrator' attribute in schema")
+	} else {
+		if !isAdministratorAttr.IsComputed() {
+			t.Error("Expected 'is_administrator' attribute to be computed")
+		}
+	}
+
+	// Check last_login_date attribute
+	lastLoginDateAttr, ok := resp.Schema.Attributes["last_login_date"]
+	if !ok {
+		t.Error("Expected 'last_login_date' attribute in schema")
+	} else {
+		if !lastLoginDateAttr.IsComputed() {
+			t.Error("Expected 'last_login_date' attribute to be computed")
+		}
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestUserDataSource_Configure_nilProviderData(t *testing.T) {
+	ds := &UserDataSource{}
+	req := datasource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestUserDataSource_Configure_wrongType(t *testing.T) {
+	ds := &UserDataSource{}
+	req := datasource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestUserDataSource_Configure_success(t *testing.T) {
+	ds := &UserDataSource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := datasource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if ds.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewUserDataSource(t *testing.T) {
+	ds := NewUserDataSource()
+	if ds == nil {
+		t.Error("Expected data source to be instantiated")
+	}
+
+	_, ok := ds.(*UserDataSource)
+	if !ok {
+		t.Error("Expected data source to be *UserDataSource")
+	}
+}
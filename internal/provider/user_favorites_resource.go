@@ -0,0 +1,318 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &UserFavoritesResource{}
+var _ resource.ResourceWithImportState = &UserFavoritesResource{}
+
+func NewUserFavoritesResource() resource.Resource {
+	return &UserFavoritesResource{}
+}
+
+// UserFavoritesResource defines the resource implementation.
+type UserFavoritesResource struct {
+	client *client.Client
+}
+
+// UserFavoritesResourceModel describes the resource data model.
+type UserFavoritesResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	UserID  types.String `tfsdk:"user_id"`
+	ItemIDs types.Set    `tfsdk:"item_ids"`
+}
+
+func (r *UserFavoritesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_favorites"
+}
+
+func (r *UserFavoritesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Marks a set of items, such as genres or collections, as favorites for a user to influence recommendations. " +
+			"Only the listed items are managed; favorites set outside Terraform are left alone. " +
+			"Destroying this resource unmarks the listed items. " +
+			"Do not manage the same item with both this resource and `jellyfin_item_userdata.is_favorite`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for this resource. Same as `user_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the user whose favorites are managed.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"item_ids": schema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The IDs of the items to mark as favorites.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *UserFavoritesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *UserFavoritesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserFavoritesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var itemIDs []string
+	resp.Diagnostics.Append(data.ItemIDs.ElementsAs(ctx, &itemIDs, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID := data.UserID.ValueString()
+
+	for _, itemID := range itemIDs {
+		if err := r.client.SetFavorite(ctx, userID, itemID, true); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to mark item %s as favorite: %s", itemID, err))
+			return
+		}
+	}
+
+	data.ID = types.StringValue(userID)
+
+	tflog.Trace(ctx, "Created user favorites resource", map[string]interface{}{
+		"user_id": userID,
+		"items":   len(itemIDs),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserFavoritesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserFavoritesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var itemIDs []string
+	resp.Diagnostics.Append(data.ItemIDs.ElementsAs(ctx, &itemIDs, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID := data.UserID.ValueString()
+
+	// Keep only the managed items that are still favorites so that items unmarked
+	// or deleted outside Terraform show up as drift.
+	favorites := make([]string, 0, len(itemIDs))
+
+	for _, itemID := range itemIDs {
+		userData, err := r.client.GetItemUserData(ctx, userID, itemID)
+
+		if client.IsNotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user data for item %s: %s", itemID, err))
+			return
+		}
+
+		if userData.IsFavorite {
+			favorites = append(favorites, itemID)
+		}
+	}
+
+	if len(favorites) == 0 {
+		tflog.Debug(ctx, "No managed favorites remain, removing from state", map[string]interface{}{
+			"user_id": userID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	itemSet, diags := types.SetValueFrom(ctx, types.StringType, favorites)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(userID)
+	data.ItemIDs = itemSet
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserFavoritesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state UserFavoritesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planned, current []string
+	resp.Diagnostics.Append(plan.ItemIDs.ElementsAs(ctx, &planned, false)...)
+	resp.Diagnostics.Append(state.ItemIDs.ElementsAs(ctx, &current, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID := plan.UserID.ValueString()
+	added, removed := diffStringSets(current, planned)
+
+	for _, itemID := range removed {
+		if err := r.client.SetFavorite(ctx, userID, itemID, false); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to unmark item %s as favorite: %s", itemID, err))
+			return
+		}
+	}
+
+	for _, itemID := range added {
+		if err := r.client.SetFavorite(ctx, userID, itemID, true); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to mark item %s as favorite: %s", itemID, err))
+			return
+		}
+	}
+
+	plan.ID = types.StringValue(userID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *UserFavoritesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data UserFavoritesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var itemIDs []string
+	resp.Diagnostics.Append(data.ItemIDs.ElementsAs(ctx, &itemIDs, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, itemID := range itemIDs {
+		if err := r.client.SetFavorite(ctx, data.UserID.ValueString(), itemID, false); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to unmark item %s as favorite: %s", itemID, err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Deleted user favorites resource")
+}
+
+func (r *UserFavoritesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	userID, items, ok := strings.Cut(req.ID, "/")
+
+	var itemIDs []string
+	for _, itemID := range strings.Split(items, ",") {
+		if itemID != "" {
+			itemIDs = append(itemIDs, itemID)
+		}
+	}
+
+	if !ok || userID == "" || len(itemIDs) == 0 {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in the form 'user_id/item_id,item_id,...', got: %q", req.ID),
+		)
+		return
+	}
+
+	itemSet, diags := types.SetValueFrom(ctx, types.StringType, itemIDs)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), userID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("item_ids"), itemSet)...)
+}
+
+// diffStringSets returns the values only in next (added) and only in prev (removed), sorted.
+func diffStringSets(prev, next []string) (added, removed []string) {
+	inPrev := make(map[string]bool, len(prev))
+	for _, v := range prev {
+		inPrev[v] = true
+	}
+
+	inNext := make(map[string]bool, len(next))
+	for _, v := range next {
+		inNext[v] = true
+		if !inPrev[v] {
+			added = append(added, v)
+		}
+	}
+
+	for _, v := range prev {
+		if !inNext[v] {
+			removed = append(removed, v)
+		}
+	}
+
+	sort.Strings(added)
+	sort.Strings(removed)
+
+	return added, removed
+}
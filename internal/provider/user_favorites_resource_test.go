@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserFavoritesResource_basic(t *testing.T) {
+	userID := os.Getenv("JELLYFIN_TEST_USER_ID")
+	itemID := os.Getenv("JELLYFIN_TEST_ITEM_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t); testAccPreCheckItem(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccUserFavoritesResourceConfig(userID, itemID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_user_favorites.test", "id", userID),
+					resource.TestCheckResourceAttr("jellyfin_user_favorites.test", "item_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr("jellyfin_user_favorites.test", "item_ids.*", itemID),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "jellyfin_user_favorites.test",
+				ImportState:       true,
+				ImportStateId:     userID + "/" + itemID,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccUserFavoritesResourceConfig(userID, itemID string) string {
+	return fmt.Sprintf(`
+resource "jellyfin_user_favorites" "test" {
+  user_id  = %[1]q
+  item_ids = [%[2]q]
+}
+`, userID, itemID)
+}
@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestUserFavoritesResource_Metadata(t *testing.T) {
+	r := &UserFavoritesResource{}
+	req := resource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &resource.MetadataResponse{}
+
+	r.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_user_favorites"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestUserFavoritesResource_Schema(t *testing.T) {
+	r := &UserFavoritesResource{}
+	req := resource.SchemaRequest{}
+	resp := &resource.SchemaResponse{}
+
+	r.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check id attribute
+	idAttr, ok := resp.Schema.Attributes["id"]
+	if !ok {
+		t.Error("Expected 'id' attribute in schema")
+	} else {
+		if !idAttr.IsComputed() {
+			t.Error("Expected 'id' attribute to be computed")
+		}
+	}
+
+	// Check user_id attribute
+	userIdAttr, ok := resp.Schema.Attributes["user_id"]
+	if !ok {
+		t.Error("Expected 'user_id' attribute in schema")
+	} else {
+		if !userIdAttr.IsRequired() {
+			t.Error("Expected 'user_id' attribute to be required")
+		}
+	}
+
+	// Check item_ids attribute
+	itemIdsAttr, ok := resp.Schema.Attributes["item_ids"]
+	if !ok {
+		t.Error("Expected 'item_ids' attribute in schema")
+	} else {
+		if !itemIdsAttr.IsRequired() {
+			t.Error("Expected 'item_ids' attribute to be required")
+		}
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestUserFavoritesResource_Configure_nilProviderData(t *testing.T) {
+	r := &UserFavoritesResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestUserFavoritesResource_Configure_wrongType(t *testing.T) {
+	r := &UserFavoritesResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestUserFavoritesResource_Configure_success(t *testing.T) {
+	r := &UserFavoritesResource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := resource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if r.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewUserFavoritesResource(t *testing.T) {
+	r := NewUserFavoritesResource()
+	if r == nil {
+		t.Error("Expected resource to be instantiated")
+	}
+
+	_, ok := r.(*UserFavoritesResource)
+	if !ok {
+		t.Error("Expected resource to be *UserFavoritesResource")
+	}
+}
+
+func TestDiffStringSets(t *testing.T) {
+	added, removed := diffStringSets([]string{"a", "b", "c"}, []string{"c", "d", "a"})
+
+	if len(added) != 1 || added[0] != "d" {
+		t.Errorf("Expected added [d], got %v", added)
+	}
+
+	if len(removed) != 1 || removed[0] != "b" {
+		t.Errorf("Expected removed [b], got %v", removed)
+	}
+}
+
+func TestUserFavoritesResource_ImportState_invalidID(t *testing.T) {
+	r := &UserFavoritesResource{}
+
+	for _, id := range []string{"", "user", "user/", "/item", "user/,"} {
+		resp := &resource.ImportStateResponse{}
+		r.ImportState(context.Background(), resource.ImportStateRequest{ID: id}, resp)
+
+		if !resp.Diagnostics.HasError() {
+			t.Errorf("Expected error for import ID %q", id)
+		}
+	}
+}
@@ -0,0 +1,281 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &UserLibraryAccessResource{}
+var _ resource.ResourceWithImportState = &UserLibraryAccessResource{}
+
+func NewUserLibraryAccessResource() resource.Resource {
+	return &UserLibraryAccessResource{}
+}
+
+// UserLibraryAccessResource defines the resource implementation.
+type UserLibraryAccessResource struct {
+	client *client.Client
+}
+
+// UserLibraryAccessResourceModel describes the resource data model.
+type UserLibraryAccessResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	UserID           types.String `tfsdk:"user_id"`
+	EnableAll        types.Bool   `tfsdk:"enable_all"`
+	EnabledFolderIDs types.Set    `tfsdk:"enabled_folder_ids"`
+}
+
+func (r *UserLibraryAccessResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_library_access"
+}
+
+func (r *UserLibraryAccessResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages which libraries a user can access. " +
+			"Only the library access settings of the user's policy are written; all other policy settings are preserved. " +
+			"Destroying this resource leaves the current access in place.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for this resource. Same as `user_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the user whose library access is managed.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enable_all": schema.BoolAttribute{
+				Required:            true,
+				MarkdownDescription: "Whether the user can access every library, including ones added later. When `true`, `enabled_folder_ids` is ignored.",
+			},
+			"enabled_folder_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				MarkdownDescription: "The item IDs of the libraries the user can access when `enable_all` is `false`, " +
+					"e.g. from the `item_id` of the `jellyfin_library` data source. Omit to deny access to every library.",
+			},
+		},
+	}
+}
+
+func (r *UserLibraryAccessResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *UserLibraryAccessResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserLibraryAccessResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Created user library access resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserLibraryAccessResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserLibraryAccessResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := r.client.GetUserPolicy(ctx, data.UserID.ValueString())
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user library access: %s", err))
+		return
+	}
+
+	data.ID = data.UserID
+	resp.Diagnostics.Append(setUserLibraryAccessModel(ctx, &data, policy)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserLibraryAccessResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data UserLibraryAccessResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserLibraryAccessResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Library access is part of the user's policy and can't be deleted; removing the
+	// resource from state simply stops Terraform from managing it.
+	tflog.Trace(ctx, "Deleted user library access resource (no-op)")
+}
+
+func (r *UserLibraryAccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), req.ID)...)
+}
+
+// apply writes the configured library access to the user's policy and refreshes the model from the result.
+func (r *UserLibraryAccessResource) apply(ctx context.Context, data *UserLibraryAccessResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var folderIDs []string
+	if !data.EnabledFolderIDs.IsNull() && !data.EnabledFolderIDs.IsUnknown() {
+		diags.Append(data.EnabledFolderIDs.ElementsAs(ctx, &folderIDs, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	userID := data.UserID.ValueString()
+	fields := libraryAccessPolicyFields(data.EnableAll.ValueBool(), folderIDs)
+
+	tflog.Debug(ctx, "Updating user library access", map[string]interface{}{
+		"user_id":    userID,
+		"enable_all": data.EnableAll.ValueBool(),
+		"folders":    len(folderIDs),
+	})
+
+	policy, err := r.client.PatchUserPolicy(ctx, userID, fields)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update user library access: %s", err))
+		return diags
+	}
+
+	data.ID = types.StringValue(userID)
+	diags.Append(setUserLibraryAccessModel(ctx, data, policy)...)
+
+	return diags
+}
+
+// libraryAccessPolicyFields returns the policy fields to write for the given access.
+// The folder list is left alone when every library is enabled, as Jellyfin ignores it.
+func libraryAccessPolicyFields(enableAll bool, folderIDs []string) map[string]interface{} {
+	if enableAll {
+		return map[string]interface{}{"EnableAllFolders": true}
+	}
+
+	if folderIDs == nil {
+		folderIDs = []string{}
+	}
+
+	return map[string]interface{}{
+		"EnableAllFolders": false,
+		"EnabledFolders":   folderIDs,
+	}
+}
+
+// setUserLibraryAccessModel copies the library access settings from a user policy into
+// the model. The folder IDs are only read when not every library is enabled, and keep
+// the form used in the configuration where the server reports the same GUIDs.
+func setUserLibraryAccessModel(ctx context.Context, data *UserLibraryAccessResourceModel, policy client.UserPolicy) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.EnableAll = types.BoolValue(policy.Bool("EnableAllFolders"))
+
+	if data.EnableAll.ValueBool() {
+		return diags
+	}
+
+	var configured []string
+	if !data.EnabledFolderIDs.IsNull() && !data.EnabledFolderIDs.IsUnknown() {
+		diags.Append(data.EnabledFolderIDs.ElementsAs(ctx, &configured, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	folderIDs := matchGuids(configured, policy.Strings("EnabledFolders"))
+
+	if len(folderIDs) == 0 && data.EnabledFolderIDs.IsNull() {
+		return diags
+	}
+
+	folderSet, d := types.SetValueFrom(ctx, types.StringType, folderIDs)
+	diags.Append(d...)
+	data.EnabledFolderIDs = folderSet
+
+	return diags
+}
+
+// matchGuids returns the actual GUIDs, replacing each with the matching configured
+// GUID so that differences in case or dashes don't show up as drift.
+func matchGuids(configured, actual []string) []string {
+	forms := make(map[string]string, len(configured))
+	for _, id := range configured {
+		forms[client.NormalizeGuid(id)] = id
+	}
+
+	matched := make([]string, 0, len(actual))
+	for _, id := range actual {
+		if form, ok := forms[client.NormalizeGuid(id)]; ok {
+			id = form
+		}
+		matched = append(matched, id)
+	}
+
+	return matched
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserLibraryAccessResource_basic(t *testing.T) {
+	userID := os.Getenv("JELLYFIN_TEST_USER_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t); testAccPreCheckUser(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccUserLibraryAccessResourceConfig(userID, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_user_library_access.test", "id", userID),
+					resource.TestCheckResourceAttr("jellyfin_user_library_access.test", "enable_all", "false"),
+					resource.TestCheckResourceAttr("jellyfin_user_library_access.test", "enabled_folder_ids.#", "0"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "jellyfin_user_library_access.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"enabled_folder_ids"},
+			},
+			// Update testing, restoring access to every library for other tests
+			{
+				Config: testAccUserLibraryAccessResourceConfig(userID, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_user_library_access.test", "enable_all", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUserLibraryAccessResourceConfig(userID string, enableAll bool) string {
+	return fmt.Sprintf(`
+resource "jellyfin_user_library_access" "test" {
+  user_id            = %[1]q
+  enable_all         = %[2]t
+  enabled_folder_ids = []
+}
+`, userID, enableAll)
+}
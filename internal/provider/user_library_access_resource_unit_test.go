@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestUserLibraryAccessResource_Metadata(t *testing.T) {
+	r := &UserLibraryAccessResource{}
+	req := resource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &resource.MetadataResponse{}
+
+	r.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_user_library_access"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestUserLibraryAccessResource_Schema(t *testing.T) {
+	r := &UserLibraryAccessResource{}
+	req := resource.SchemaRequest{}
+	resp := &resource.SchemaResponse{}
+
+	r.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check id attribute
+	idAttr, ok := resp.Schema.Attributes["id"]
+	if !ok {
+		t.Error("Expected 'id' attribute in schema")
+	} else {
+		if !idAttr.IsComputed() {
+			t.Error("Expected 'id' attribute to be computed")
+		}
+	}
+
+	// Check user_id attribute
+	userIdAttr, ok := resp.Schema.Attributes["user_id"]
+	if !ok {
+		t.Error("Expected 'user_id' attribute in schema")
+	} else {
+		if !userIdAttr.IsRequired() {
+			t.Error("Expected 'user_id' attribute to be required")
+		}
+	}
+
+	// Check enable_all attribute
+	enableAllAttr, ok := resp.Schema.Attributes["enable_all"]
+	if !ok {
+		t.Error("Expected 'enable_all' attribute in schema")
+	} else {
+		if !enableAllAttr.IsRequired() {
+			t.Error("Expected 'enable_all' attribute to be required")
+		}
+	}
+
+	// Check enabled_folder_ids attribute
+	enabledFolderIdsAttr, ok := resp.Schema.Attributes["enabled_folder_ids"]
+	if !ok {
+		t.Error("Expected 'enabled_folder_ids' attribute in schema")
+	} else {
+		if !enabledFolderIdsAttr.IsOptional() {
+			t.Error("Expected 'enabled_folder_ids' attribute to be optional")
+		}
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestUserLibraryAccessResource_Configure_nilProviderData(t *testing.T) {
+	r := &UserLibraryAccessResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestUserLibraryAccessResource_Configure_wrongType(t *testing.T) {
+	r := &UserLibraryAccessResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestUserLibraryAccessResource_Configure_success(t *testing.T) {
+	r := &UserLibraryAccessResource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := resource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if r.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewUserLibraryAccessResource(t *testing.T) {
+	r := NewUserLibraryAccessResource()
+	if r == nil {
+		t.Error("Expected resource to be instantiated")
+	}
+
+	_, ok := r.(*UserLibraryAccessResource)
+	if !ok {
+		t.Error("Expected resource to be *UserLibraryAccessResource")
+	}
+}
+
+func TestLibraryAccessPolicyFields(t *testing.T) {
+	testCases := []struct {
+		name      string
+		enableAll bool
+		folderIDs []string
+		expected  map[string]interface{}
+	}{
+		{
+			name:      "all libraries ignores folders",
+			enableAll: true,
+			folderIDs: []string{"f137a2dd21bbc1b99aa5c0f6bf02a805"},
+			expected:  map[string]interface{}{"EnableAllFolders": true},
+		},
+		{
+			name:      "selected libraries",
+			folderIDs: []string{"f137a2dd21bbc1b99aa5c0f6bf02a805"},
+			expected: map[string]interface{}{
+				"EnableAllFolders": false,
+				"EnabledFolders":   []string{"f137a2dd21bbc1b99aa5c0f6bf02a805"},
+			},
+		},
+		{
+			name: "no libraries",
+			expected: map[string]interface{}{
+				"EnableAllFolders": false,
+				"EnabledFolders":   []string{},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := libraryAccessPolicyFields(tc.enableAll, tc.folderIDs)
+
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestMatchGuids(t *testing.T) {
+	configured := []string{"F137A2DD-21BB-C1B9-9AA5-C0F6BF02A805"}
+	actual := []string{"f137a2dd21bbc1b99aa5c0f6bf02a805", "a656b907eb3a73532e40e44b968d0225"}
+
+	got := matchGuids(configured, actual)
+	expected := []string{"F137A2DD-21BB-C1B9-9AA5-C0F6BF02A805", "a656b907eb3a73532e40e44b968d0225"}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
+
+func TestSetUserLibraryAccessModel(t *testing.T) {
+	ctx := context.Background()
+
+	var policy client.UserPolicy
+	if err := json.Unmarshal([]byte(`{"EnableAllFolders":true,"EnabledFolders":["a656b907eb3a73532e40e44b968d0225"]}`), &policy); err != nil {
+		t.Fatalf("Failed to decode policy: %v", err)
+	}
+
+	configured, _ := types.SetValueFrom(ctx, types.StringType, []string{"f137a2dd21bbc1b99aa5c0f6bf02a805"})
+	data := UserLibraryAccessResourceModel{EnabledFolderIDs: configured}
+
+	if diags := setUserLibraryAccessModel(ctx, &data, policy); diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	if !data.EnableAll.ValueBool() {
+		t.Error("Expected enable_all to be true")
+	}
+
+	if !data.EnabledFolderIDs.Equal(configured) {
+		t.Errorf("Expected the configured folders to be kept when every library is enabled, got %s", data.EnabledFolderIDs)
+	}
+
+	if err := json.Unmarshal([]byte(`{"EnableAllFolders":false,"EnabledFolders":[]}`), &policy); err != nil {
+		t.Fatalf("Failed to decode policy: %v", err)
+	}
+
+	data = UserLibraryAccessResourceModel{EnabledFolderIDs: types.SetNull(types.StringType)}
+
+	if diags := setUserLibraryAccessModel(ctx, &data, policy); diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	if !data.EnabledFolderIDs.IsNull() {
+		t.Errorf("Expected unset folders to stay null when no library is enabled, got %s", data.EnabledFolderIDs)
+	}
+}
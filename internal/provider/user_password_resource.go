@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &UserPasswordResource{}
+
+func NewUserPasswordResource() resource.Resource {
+	return &UserPasswordResource{}
+}
+
+// UserPasswordResource defines the resource implementation.
+type UserPasswordResource struct {
+	client *client.Client
+}
+
+// UserPasswordResourceModel describes the resource data model.
+type UserPasswordResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	UserID          types.String `tfsdk:"user_id"`
+	Password        types.String `tfsdk:"password"`
+	CurrentPassword types.String `tfsdk:"current_password"`
+}
+
+func (r *UserPasswordResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_password"
+}
+
+func (r *UserPasswordResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sets the password of an existing user, e.g. to rotate it. " +
+			"The server never returns passwords, so changes made outside Terraform aren't detected; change `password` to set it again. " +
+			"Destroying this resource leaves the current password in place.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for this resource. Same as `user_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the user whose password is set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"password": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The new password.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"current_password": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				MarkdownDescription: "The user's current password. Only needed when the provider isn't authenticated as an administrator, " +
+					"e.g. when users rotate their own password.",
+			},
+		},
+	}
+}
+
+func (r *UserPasswordResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *UserPasswordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserPasswordResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setPassword(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set user password: %s", err))
+		return
+	}
+
+	data.ID = data.UserID
+
+	tflog.Trace(ctx, "Created user password resource", map[string]interface{}{
+		"user_id": data.UserID.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserPasswordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// The password can't be read back, so only check that the user still exists.
+	var data UserPasswordResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.GetUser(ctx, data.UserID.ValueString())
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserPasswordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state UserPasswordResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Changing only current_password has nothing to send.
+	if !plan.Password.Equal(state.Password) {
+		if err := r.setPassword(ctx, &plan); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set user password: %s", err))
+			return
+		}
+	}
+
+	plan.ID = state.ID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *UserPasswordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Removing the resource leaves the password as it is rather than resetting it.
+	tflog.Trace(ctx, "Deleted user password resource (no-op)")
+}
+
+// setPassword sends the planned password to the server. The passwords themselves are
+// never logged.
+func (r *UserPasswordResource) setPassword(ctx context.Context, data *UserPasswordResourceModel) error {
+	tflog.Debug(ctx, "Setting user password", map[string]interface{}{
+		"user_id":               data.UserID.ValueString(),
+		"with_current_password": data.CurrentPassword.ValueString() != "",
+	})
+
+	return r.client.SetUserPassword(ctx, data.UserID.ValueString(), data.CurrentPassword.ValueString(), data.Password.ValueString())
+}
@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserPasswordResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccUserPasswordResourceConfig("tf-acc-password-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("jellyfin_user_password.test", "id", "jellyfin_user.test", "id"),
+					resource.TestCheckResourceAttr("jellyfin_user_password.test", "password", "tf-acc-password-1"),
+				),
+			},
+			// Update testing
+			{
+				Config: testAccUserPasswordResourceConfig("tf-acc-password-2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_user_password.test", "password", "tf-acc-password-2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUserPasswordResourceConfig(password string) string {
+	return fmt.Sprintf(`
+resource "jellyfin_user" "test" {
+  name = "tf-acc-password-user"
+}
+
+resource "jellyfin_user_password" "test" {
+  user_id  = jellyfin_user.test.id
+  password = %[1]q
+}
+`, password)
+}
@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestUserPasswordResource_Metadata(t *testing.T) {
+	r := &UserPasswordResource{}
+	req := resource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &resource.MetadataResponse{}
+
+	r.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_user_password"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestUserPasswordResource_Schema(t *testing.T) {
+	r := &UserPasswordResource{}
+	req := resource.SchemaRequest{}
+	resp := &resource.SchemaResponse{}
+
+	r.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check id attribute
+	idAttr, ok := resp.Schema.Attributes["id"]
+	if !ok {
+		t.Error("Expected 'id' attribute in schema")
+	} else {
+		if !idAttr.IsComputed() {
+			t.Error("Expected 'id' attribute to be computed")
+		}
+	}
+
+	// Check user_id attribute
+	userIdAttr, ok := resp.Schema.Attributes["user_id"]
+	if !ok {
+		t.Error("Expected 'user_id' attribute in schema")
+	} else {
+		if !userIdAttr.IsRequired() {
+			t.Error("Expected 'user_id' attribute to be required")
+		}
+	}
+
+	// Check password attribute
+	passwordAttr, ok := resp.Schema.Attributes["password"]
+	if !ok {
+		t.Error("Expected 'password' attribute in schema")
+	} else {
+		if !passwordAttr.IsRequired() {
+			t.Error("Expected 'password' attribute to be required")
+		}
+		if !passwordAttr.IsSensitive() {
+			t.Error("Expected 'password' attribute to be sensitive")
+		}
+	}
+
+	// Check current_password attribute
+	currentPasswordAttr, ok := resp.Schema.Attributes["current_password"]
+	if !ok {
+		t.Error("Expected 'current_password' attribute in schema")
+	} else {
+		if !currentPasswordAttr.IsOptional() {
+			t.Error("Expected 'current_password' attribute to be optional")
+		}
+		if !currentPasswordAttr.IsSensitive() {
+			t.Error("Expected 'current_password' attribute to be sensitive")
+		}
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestUserPasswordResource_Configure_nilProviderData(t *testing.T) {
+	r := &UserPasswordResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestUserPasswordResource_Configure_wrongType(t *testing.T) {
+	r := &UserPasswordResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestUserPasswordResource_Configure_success(t *testing.T) {
+	r := &UserPasswordResource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := resource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if r.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewUserPasswordResource(t *testing.T) {
+	r := NewUserPasswordResource()
+	if r == nil {
+		t.Error("Expected resource to be instantiated")
+	}
+
+	_, ok := r.(*UserPasswordResource)
+	if !ok {
+		t.Error("Expected resource to be *UserPasswordResource")
+	}
+}
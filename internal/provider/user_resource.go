@@ -0,0 +1,425 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &UserResource{}
+var _ resource.ResourceWithImportState = &UserResource{}
+
+func NewUserResource() resource.Resource {
+	return &UserResource{}
+}
+
+// UserResource defines the resource implementation.
+type UserResource struct {
+	client *client.Client
+}
+
+// UserResourceModel describes the resource data model.
+type UserResourceModel struct {
+	ID                      types.String `tfsdk:"id"`
+	Name                    types.String `tfsdk:"name"`
+	Password                types.String `tfsdk:"password"`
+	AuthProviderID          types.String `tfsdk:"auth_provider_id"`
+	PasswordResetProviderID types.String `tfsdk:"password_reset_provider_id"`
+	LoginAttemptsLockout    types.Int64  `tfsdk:"login_attempts_before_lockout"`
+	MaxActiveSessions       types.Int64  `tfsdk:"max_active_sessions"`
+	EnableRemoteAccess      types.Bool   `tfsdk:"enable_remote_access"`
+}
+
+func (r *UserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Jellyfin user account. " +
+			"Only the policy settings set in the configuration are written; all other policy settings are preserved.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The user's login name.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"password": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				MarkdownDescription: "The user's password. Omit to create the user without one. " +
+					"The server never returns passwords, so changes made outside Terraform aren't detected.",
+			},
+			"auth_provider_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The ID of the authentication provider the user signs in with, such as the built-in provider or an LDAP plugin. " +
+					"Validated against the installed providers when the server lists them.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"password_reset_provider_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The ID of the provider that handles the user's password resets. " +
+					"Validated against the installed providers when the server lists them.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"login_attempts_before_lockout": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The number of failed sign-in attempts after which the account is locked. " +
+					"`0` uses the server default of three attempts, or five for administrators. " +
+					"The server reports `-1` for accounts whose lockout was disabled outside Terraform.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"max_active_sessions": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The maximum number of simultaneous sessions the user may have. `0` means unlimited.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"enable_remote_access": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "Whether the user may connect from outside the local network. " +
+					"Set to `false` to restrict the account to the local network.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *UserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.validateProviders(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.client.CreateUser(ctx, data.Name.ValueString(), data.Password.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create user: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(user.Id)
+
+	tflog.Trace(ctx, "Created user resource", map[string]interface{}{
+		"id": user.Id,
+	})
+
+	// Save the ID before applying the policy so a failure doesn't orphan the user.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), data.ID)...)
+
+	resp.Diagnostics.Append(r.applyPolicy(ctx, &data, user.Policy)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.client.GetUser(ctx, data.ID.ValueString())
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(user.Id)
+	data.Name = types.StringValue(user.Name)
+	setUserPolicyModel(&data, user.Policy)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state UserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.validateProviders(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID := state.ID.ValueString()
+	plan.ID = state.ID
+
+	if !plan.Name.Equal(state.Name) {
+		if err := r.client.RenameUser(ctx, userID, plan.Name.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to rename user: %s", err))
+			return
+		}
+	}
+
+	if !plan.Password.Equal(state.Password) {
+		if err := r.client.SetUserPassword(ctx, userID, "", plan.Password.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set user password: %s", err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.applyPolicy(ctx, &plan, nil)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data UserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteUser(ctx, data.ID.ValueString())
+
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete user: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted user resource")
+}
+
+func (r *UserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// userPolicyFields returns the policy fields set in the configuration.
+func userPolicyFields(data *UserResourceModel) map[string]interface{} {
+	fields := map[string]interface{}{}
+
+	if !data.AuthProviderID.IsNull() && !data.AuthProviderID.IsUnknown() {
+		fields["AuthenticationProviderId"] = data.AuthProviderID.ValueString()
+	}
+	if !data.PasswordResetProviderID.IsNull() && !data.PasswordResetProviderID.IsUnknown() {
+		fields["PasswordResetProviderId"] = data.PasswordResetProviderID.ValueString()
+	}
+	if !data.LoginAttemptsLockout.IsNull() && !data.LoginAttemptsLockout.IsUnknown() {
+		fields["LoginAttemptsBeforeLockout"] = data.LoginAttemptsLockout.ValueInt64()
+	}
+	if !data.MaxActiveSessions.IsNull() && !data.MaxActiveSessions.IsUnknown() {
+		fields["MaxActiveSessions"] = data.MaxActiveSessions.ValueInt64()
+	}
+	if !data.EnableRemoteAccess.IsNull() && !data.EnableRemoteAccess.IsUnknown() {
+		fields["EnableRemoteAccess"] = data.EnableRemoteAccess.ValueBool()
+	}
+
+	return fields
+}
+
+// applyPolicy writes the configured policy fields and refreshes the model from the result.
+// If no fields are configured the policy is left untouched and current is used to
+// populate the model, or the user is re-read when current is nil.
+func (r *UserResource) applyPolicy(ctx context.Context, data *UserResourceModel, current client.UserPolicy) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	userID := data.ID.ValueString()
+	fields := userPolicyFields(data)
+
+	if len(fields) == 0 {
+		if current == nil {
+			user, err := r.client.GetUser(ctx, userID)
+			if err != nil {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to read user: %s", err))
+				return diags
+			}
+			current = user.Policy
+		}
+
+		setUserPolicyModel(data, current)
+		return diags
+	}
+
+	tflog.Debug(ctx, "Updating user policy", map[string]interface{}{
+		"user_id": userID,
+		"fields":  len(fields),
+	})
+
+	policy, err := r.client.PatchUserPolicy(ctx, userID, fields)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update user policy: %s", err))
+		return diags
+	}
+
+	setUserPolicyModel(data, policy)
+
+	return diags
+}
+
+// validateProviders checks the configured provider IDs against the providers installed
+// on the server. Servers that don't list their providers, or deny the request, skip
+// the check rather than fail the plan.
+func (r *UserResource) validateProviders(ctx context.Context, data *UserResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	checks := []struct {
+		attr  string
+		value types.String
+		kind  string
+		list  func(context.Context) ([]client.AuthProvider, error)
+	}{
+		{"auth_provider_id", data.AuthProviderID, "Authentication", r.client.GetAuthProviders},
+		{"password_reset_provider_id", data.PasswordResetProviderID, "Password Reset", r.client.GetPasswordResetProviders},
+	}
+
+	for _, check := range checks {
+		if check.value.IsNull() || check.value.IsUnknown() {
+			continue
+		}
+
+		providers, err := check.list(ctx)
+		if err != nil || len(providers) == 0 {
+			tflog.Debug(ctx, "Unable to list installed providers, skipping validation", map[string]interface{}{
+				"attribute": check.attr,
+				"error":     fmt.Sprint(err),
+			})
+			continue
+		}
+
+		if !hasAuthProvider(providers, check.value.ValueString()) {
+			diags.AddAttributeError(
+				path.Root(check.attr),
+				fmt.Sprintf("Unknown %s Provider", check.kind),
+				fmt.Sprintf("No installed provider has ID %q. Installed providers: %s.", check.value.ValueString(), describeAuthProviders(providers)),
+			)
+		}
+	}
+
+	return diags
+}
+
+// hasAuthProvider reports whether providers includes one with the given ID.
+func hasAuthProvider(providers []client.AuthProvider, id string) bool {
+	for _, p := range providers {
+		if p.Id == id {
+			return true
+		}
+	}
+	return false
+}
+
+// describeAuthProviders formats providers as a list of "Name (Id)" for error messages.
+func describeAuthProviders(providers []client.AuthProvider) string {
+	names := make([]string, 0, len(providers))
+	for _, p := range providers {
+		names = append(names, fmt.Sprintf("%s (%s)", p.Name, p.Id))
+	}
+	return strings.Join(names, ", ")
+}
+
+// setUserPolicyModel copies the managed policy settings into the model.
+func setUserPolicyModel(data *UserResourceModel, policy client.UserPolicy) {
+	data.AuthProviderID = types.StringValue(policy.String("AuthenticationProviderId"))
+	data.PasswordResetProviderID = types.StringValue(policy.String("PasswordResetProviderId"))
+	data.LoginAttemptsLockout = types.Int64Value(policy.Int64("LoginAttemptsBeforeLockout"))
+	data.MaxActiveSessions = types.Int64Value(policy.Int64("MaxActiveSessions"))
+	data.EnableRemoteAccess = types.BoolValue(policy.Bool("EnableRemoteAccess"))
+}
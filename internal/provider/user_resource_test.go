@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccUserResourceConfig("tf-acc-user"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("jellyfin_user.test", "id"),
+					resource.TestCheckResourceAttr("jellyfin_user.test", "name", "tf-acc-user"),
+					resource.TestCheckResourceAttrSet("jellyfin_user.test", "auth_provider_id"),
+					resource.TestCheckResourceAttr("jellyfin_user.test", "login_attempts_before_lockout", "5"),
+					resource.TestCheckResourceAttr("jellyfin_user.test", "max_active_sessions", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "jellyfin_user.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			// Update testing
+			{
+				Config: testAccUserResourceConfig("tf-acc-user-renamed"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_user.test", "name", "tf-acc-user-renamed"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUserResource_enableRemoteAccess(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserResourceConfig_enableRemoteAccess(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_user.test", "enable_remote_access", "false"),
+					resource.TestCheckResourceAttr("jellyfin_user.test", "max_active_sessions", "2"),
+				),
+			},
+			{
+				Config: testAccUserResourceConfig_enableRemoteAccess(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_user.test", "enable_remote_access", "true"),
+					resource.TestCheckResourceAttr("jellyfin_user.test", "max_active_sessions", "2"),
+				),
+			},
+			{
+				ResourceName:            "jellyfin_user.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func TestAccUserResource_unknownAuthProvider(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "jellyfin_user" "test" {
+  name             = %[1]q
+  auth_provider_id = "Not.An.Installed.Provider"
+}
+`, "tf-acc-user"),
+				ExpectError: regexp.MustCompile("Unknown Authentication Provider"),
+			},
+		},
+	})
+}
+
+func TestAccUserResource_negativeLockout(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "jellyfin_user" "test" {
+  name                          = "tf-acc-user"
+  login_attempts_before_lockout = -1
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value"),
+			},
+		},
+	})
+}
+
+func testAccUserResourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "jellyfin_user" "test" {
+  name     = %[1]q
+  password = "tf-acc-password"
+
+  login_attempts_before_lockout = 5
+  max_active_sessions           = 2
+}
+`, name)
+}
+
+func testAccUserResourceConfig_enableRemoteAccess(enabled bool) string {
+	return fmt.Sprintf(`
+resource "jellyfin_user" "test" {
+  name                 = "tf-acc-user-remote"
+  max_active_sessions  = 2
+  enable_remote_access = %[1]t
+}
+`, enabled)
+}
@@ -0,0 +1,256 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestUserResource_Metadata(t *testing.T) {
+	r := &UserResource{}
+	req := resource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &resource.MetadataResponse{}
+
+	r.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_user"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestUserResource_Schema(t *testing.T) {
+	r := &UserResource{}
+	req := resource.SchemaRequest{}
+	resp := &resource.SchemaResponse{}
+
+	r.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check id attribute
+	idAttr, ok := resp.Schema.Attributes["id"]
+	if !ok {
+		t.Error("Expected 'id' attribute in schema")
+	} else {
+		if !idAttr.IsComputed() {
+			t.Error("Expected 'id' attribute to be computed")
+		}
+	}
+
+	// Check name attribute
+	nameAttr, ok := resp.Schema.Attributes["name"]
+	if !ok {
+		t.Error("Expected 'name' attribute in schema")
+	} else {
+		if !nameAttr.IsRequired() {
+			t.Error("Expected 'name' attribute to be required")
+		}
+	}
+
+	// Check password attribute
+	passwordAttr, ok := resp.Schema.Attributes["password"]
+	if !ok {
+		t.Error("Expected 'password' attribute in schema")
+	} else {
+		if !passwordAttr.IsOptional() {
+			t.Error("Expected 'password' attribute to be optional")
+		}
+		if !passwordAttr.IsSensitive() {
+			t.Error("Expected 'password' attribute to be sensitive")
+		}
+	}
+
+	// Check auth_provider_id attribute
+	authProviderIdAttr, ok := resp.Schema.Attributes["auth_provider_id"]
+	if !ok {
+		t.Error("Expected 'auth_provider_id' attribute in schema")
+	} else {
+		if !authProviderIdAttr.IsOptional() {
+			t.Error("Expected 'auth_provider_id' attribute to be optional")
+		}
+		if !authProviderIdAttr.IsComputed() {
+			t.Error("Expected 'auth_provider_id' attribute to be computed")
+		}
+	}
+
+	// Check password_reset_provider_id attribute
+	passwordResetProviderIdAttr, ok := resp.Schema.Attributes["password_reset_provider_id"]
+	if !ok {
+		t.Error("Expected 'password_reset_provider_id' attribute in schema")
+	} else {
+		if !passwordResetProviderIdAttr.IsOptional() {
+			t.Error("Expected 'password_reset_provider_id' attribute to be optional")
+		}
+		if !passwordResetProviderIdAttr.IsComputed() {
+			t.Error("Expected 'password_reset_provider_id' attribute to be computed")
+		}
+	}
+
+	// Check login_attempts_before_lockout and max_active_sessions attributes
+	for _, name := range []string{"login_attempts_before_lockout", "max_active_sessions"} {
+		attr, ok := resp.Schema.Attributes[name]
+		if !ok {
+			t.Errorf("Expected '%s' attribute in schema", name)
+		} else if !attr.IsOptional() || !attr.IsComputed() {
+			t.Errorf("Expected '%s' attribute to be optional and computed", name)
+		}
+	}
+
+	// Check enable_remote_access attribute
+	enableRemoteAccessAttr, ok := resp.Schema.Attributes["enable_remote_access"]
+	if !ok {
+		t.Error("Expected 'enable_remote_access' attribute in schema")
+	} else if !enableRemoteAccessAttr.IsOptional() || !enableRemoteAccessAttr.IsComputed() {
+		t.Error("Expected 'enable_remote_access' attribute to be optional and computed")
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestUserResource_Configure_nilProviderData(t *testing.T) {
+	r := &UserResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestUserResource_Configure_wrongType(t *testing.T) {
+	r := &UserResource{}
+	req := resource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestUserResource_Configure_success(t *testing.T) {
+	r := &UserResource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := resource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if r.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewUserResource(t *testing.T) {
+	r := NewUserResource()
+	if r == nil {
+		t.Error("Expected resource to be instantiated")
+	}
+
+	_, ok := r.(*UserResource)
+	if !ok {
+		t.Error("Expected resource to be *UserResource")
+	}
+}
+
+func TestHasAuthProvider(t *testing.T) {
+	providers := []client.AuthProvider{
+		{Name: "Default", Id: "Jellyfin.Server.Implementations.Users.DefaultAuthenticationProvider"},
+		{Name: "LDAP-Auth", Id: "Jellyfin.Plugin.LDAP_Auth.LdapAuthenticationProviderPlugin"},
+	}
+
+	if !hasAuthProvider(providers, "Jellyfin.Plugin.LDAP_Auth.LdapAuthenticationProviderPlugin") {
+		t.Error("Expected LDAP provider to be found")
+	}
+
+	if hasAuthProvider(providers, "LDAP-Auth") {
+		t.Error("Expected lookup to match on ID, not name")
+	}
+}
+
+func TestSetUserPolicyModel(t *testing.T) {
+	data := &UserResourceModel{}
+
+	setUserPolicyModel(data, client.UserPolicy{
+		"AuthenticationProviderId":   []byte(`"ldap"`),
+		"LoginAttemptsBeforeLockout": []byte(`-1`),
+		"MaxActiveSessions":          []byte(`3`),
+		"EnableRemoteAccess":         []byte(`true`),
+	})
+
+	if data.AuthProviderID.ValueString() != "ldap" {
+		t.Errorf("Expected auth_provider_id 'ldap', got %s", data.AuthProviderID)
+	}
+
+	if data.LoginAttemptsLockout.ValueInt64() != -1 {
+		t.Errorf("Expected login_attempts_before_lockout -1, got %s", data.LoginAttemptsLockout)
+	}
+
+	if data.MaxActiveSessions.ValueInt64() != 3 {
+		t.Errorf("Expected max_active_sessions 3, got %s", data.MaxActiveSessions)
+	}
+
+	if !data.EnableRemoteAccess.ValueBool() {
+		t.Errorf("Expected enable_remote_access true, got %s", data.EnableRemoteAccess)
+	}
+
+	if data.PasswordResetProviderID.IsNull() || data.PasswordResetProviderID.ValueString() != "" {
+		t.Errorf("Expected empty password_reset_provider_id for a missing field, got %s", data.PasswordResetProviderID)
+	}
+}
+
+func TestUserPolicyFields_enableRemoteAccess(t *testing.T) {
+	data := &UserResourceModel{
+		AuthProviderID:          types.StringNull(),
+		PasswordResetProviderID: types.StringNull(),
+		LoginAttemptsLockout:    types.Int64Null(),
+		MaxActiveSessions:       types.Int64Null(),
+		EnableRemoteAccess:      types.BoolValue(false),
+	}
+
+	fields := userPolicyFields(data)
+
+	if len(fields) != 1 {
+		t.Fatalf("Expected only EnableRemoteAccess to be written, got %v", fields)
+	}
+
+	if fields["EnableRemoteAccess"] != false {
+		t.Errorf("Expected EnableRemoteAccess false, got %v", fields["EnableRemoteAccess"])
+	}
+
+	data.EnableRemoteAccess = types.BoolUnknown()
+
+	if fields := userPolicyFields(data); len(fields) != 0 {
+		t.Errorf("Expected no fields for an unknown value, got %v", fields)
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &UserStateResource{}
+var _ resource.ResourceWithImportState = &UserStateResource{}
+
+func NewUserStateResource() resource.Resource {
+	return &UserStateResource{}
+}
+
+// UserStateResource defines the resource implementation.
+type UserStateResource struct {
+	client *client.Client
+}
+
+// UserStateResourceModel describes the resource data model.
+type UserStateResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	UserID     types.String `tfsdk:"user_id"`
+	IsDisabled types.Bool   `tfsdk:"is_disabled"`
+}
+
+func (r *UserStateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_state"
+}
+
+func (r *UserStateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Enables or disables an existing user account, e.g. when offboarding someone, without managing the rest of the user. " +
+			"All other policy settings are preserved. Destroying this resource leaves the account and its current state in place.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier for this resource. Same as `user_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the user whose account is enabled or disabled.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"is_disabled": schema.BoolAttribute{
+				Required:            true,
+				MarkdownDescription: "Whether the account is disabled. A disabled user can't sign in.",
+			},
+		},
+	}
+}
+
+func (r *UserStateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *UserStateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserStateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.apply(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update user state: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "Created user state resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserStateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserStateResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := r.client.GetUserPolicy(ctx, data.UserID.ValueString())
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user state: %s", err))
+		return
+	}
+
+	data.ID = data.UserID
+	data.IsDisabled = types.BoolValue(policy.Bool("IsDisabled"))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserStateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data UserStateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.apply(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update user state: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserStateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The resource only manages a flag on the account; removing it from state simply
+	// stops Terraform from managing the flag and leaves the account as it is.
+	tflog.Trace(ctx, "Deleted user state resource (no-op)")
+}
+
+func (r *UserStateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), req.ID)...)
+}
+
+// apply writes the configured state to the user's policy and refreshes the model from the result.
+func (r *UserStateResource) apply(ctx context.Context, data *UserStateResourceModel) error {
+	userID := data.UserID.ValueString()
+
+	tflog.Debug(ctx, "Updating user state", map[string]interface{}{
+		"user_id":     userID,
+		"is_disabled": data.IsDisabled.ValueBool(),
+	})
+
+	policy, err := r.client.PatchUserPolicy(ctx, userID, map[string]interface{}{
+		"IsDisabled": data.IsDisabled.ValueBool(),
+	})
+	if err != nil {
+		return err
+	}
+
+	data.ID = types.StringValue(userID)
+	data.IsDisabled = types.BoolValue(policy.Bool("IsDisabled"))
+
+	return nil
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserStateResource_basic(t *testing.T) {
+	userID := os.Getenv("JELLYFIN_TEST_USER_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t); testAccPreCheckUser(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccUserStateResourceConfig(userID, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_user_state.test", "id", userID),
+					resource.TestCheckResourceAttr("jellyfin_user_state.test", "is_disabled", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "jellyfin_user_state.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update testing, leaving the user enabled for other tests
+			{
+				Config: testAccUserStateResourceConfig(userID, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jellyfin_user_state.test", "is_disabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUserStateResourceConfig(userID string, disabled bool) string {
+	return fmt.Sprintf(`
+resource "jellyfin_user_state" "test" {
+  user_id     = %[1]q
+  is_disabled = %[2]t
+}
+`, userID, disabled)
+}
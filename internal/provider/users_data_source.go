@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UsersDataSource{}
+
+func NewUsersDataSource() datasource.DataSource {
+	return &UsersDataSource{}
+}
+
+// UsersDataSource defines the data source implementation.
+type UsersDataSource struct {
+	client *client.Client
+}
+
+// UsersDataSourceModel describes the data source data model.
+type UsersDataSourceModel struct {
+	NameContains    types.String      `tfsdk:"name_contains"`
+	IsAdministrator types.Bool        `tfsdk:"is_administrator"`
+	IsDisabled      types.Bool        `tfsdk:"is_disabled"`
+	Users           []UsersEntryModel `tfsdk:"users"`
+	ReturnedCount   types.Int64       `tfsdk:"returned_count"`
+}
+
+// UsersEntryModel describes a single user in the list.
+type UsersEntryModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	HasPassword      types.Bool   `tfsdk:"has_password"`
+	IsAdministrator  types.Bool   `tfsdk:"is_administrator"`
+	IsDisabled       types.Bool   `tfsdk:"is_disabled"`
+	LastLoginDate    types.String `tfsdk:"last_login_date"`
+	LastActivityDate types.String `tfsdk:"last_activity_date"`
+}
+
+func (d *UsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *UsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the users on the Jellyfin server, optionally filtered by name, administrator status or whether they are disabled.",
+
+		Attributes: map[string]schema.Attribute{
+			"name_contains": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Only return users whose name contains this value, compared case-insensitively. " +
+					"Jellyfin has no server-side user search, so the filter is applied after listing all users.",
+			},
+			"is_administrator": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return administrators when `true`, or only users who aren't administrators when `false`.",
+			},
+			"is_disabled": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return disabled users when `true`, or only enabled users when `false`.",
+			},
+			"users": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The matching users, in the order returned by the server.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the user.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the user.",
+						},
+						"has_password": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the user has a password set.",
+						},
+						"is_administrator": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the user is an administrator.",
+						},
+						"is_disabled": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the user is disabled and can't sign in.",
+						},
+						"last_login_date": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the user last logged in, if ever.",
+						},
+						"last_activity_date": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the user was last active, if ever.",
+						},
+					},
+				},
+			},
+			"returned_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of users returned.",
+			},
+		},
+	}
+}
+
+func (d *UsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UsersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, err := d.client.GetUsers(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list users: %s", err))
+		return
+	}
+
+	users = filterUsersByName(users, data.NameContains.ValueString())
+	users = filterUsersByPolicy(users, "IsAdministrator", data.IsAdministrator)
+	users = filterUsersByPolicy(users, "IsDisabled", data.IsDisabled)
+
+	data.Users = make([]UsersEntryModel, 0, len(users))
+	for _, user := range users {
+		data.Users = append(data.Users, UsersEntryModel{
+			ID:               types.StringValue(user.Id),
+			Name:             types.StringValue(user.Name),
+			HasPassword:      types.BoolValue(user.HasPassword),
+			IsAdministrator:  types.BoolValue(user.Policy.Bool("IsAdministrator")),
+			IsDisabled:       types.BoolValue(user.Policy.Bool("IsDisabled")),
+			LastLoginDate:    optionalString(user.LastLoginDate),
+			LastActivityDate: optionalString(user.LastActivityDate),
+		})
+	}
+
+	data.ReturnedCount = types.Int64Value(int64(len(data.Users)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// filterUsersByName returns the users whose name contains substr, ignoring case.
+// An empty substr matches every user.
+func filterUsersByName(users []client.User, substr string) []client.User {
+	if substr == "" {
+		return users
+	}
+
+	needle := strings.ToLower(substr)
+	matches := make([]client.User, 0, len(users))
+
+	for _, user := range users {
+		if strings.Contains(strings.ToLower(user.Name), needle) {
+			matches = append(matches, user)
+		}
+	}
+
+	return matches
+}
+
+// filterUsersByPolicy returns the users whose boolean policy field matches want.
+// A null or unknown want matches every user.
+func filterUsersByPolicy(users []client.User, field string, want types.Bool) []client.User {
+	if want.IsNull() || want.IsUnknown() {
+		return users
+	}
+
+	matches := make([]client.User, 0, len(users))
+
+	for _, user := range users {
+		if user.Policy.Bool(field) == want.ValueBool() {
+			matches = append(matches, user)
+		}
+	}
+
+	return matches
+}
+
+// optionalString returns a null string for "" and the value otherwise.
+func optionalString(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUsersDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsersDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.jellyfin_users.all", "returned_count"),
+					resource.TestCheckResourceAttr("data.jellyfin_users.none", "returned_count", "0"),
+					resource.TestCheckResourceAttr("data.jellyfin_users.none", "users.#", "0"),
+					resource.TestCheckResourceAttr("data.jellyfin_users.admins", "users.0.is_administrator", "true"),
+					resource.TestCheckResourceAttr("data.jellyfin_users.admins", "users.0.is_disabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+const testAccUsersDataSourceConfig = `
+data "jellyfin_users" "all" {}
+
+data "jellyfin_users" "none" {
+  name_contains = "no-such-user-12345"
+}
+
+data "jellyfin_users" "admins" {
+  is_administrator = true
+  is_disabled      = false
+}
+`
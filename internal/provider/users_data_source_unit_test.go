@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/jkossis/terraform-provider-jellyfin/internal/client"
+)
+
+func TestUsersDataSource_Metadata(t *testing.T) {
+	ds := &UsersDataSource{}
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "jellyfin",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), req, resp)
+
+	expected := "jellyfin_users"
+	if resp.TypeName != expected {
+		t.Errorf("Expected TypeName %q, got %q", expected, resp.TypeName)
+	}
+}
+
+func TestUsersDataSource_Schema(t *testing.T) {
+	ds := &UsersDataSource{}
+	req := datasource.SchemaRequest{}
+	resp := &datasource.SchemaResponse{}
+
+	ds.Schema(context.Background(), req, resp)
+
+	// Check that schema has expected attributes
+	if resp.Schema.Attributes == nil {
+		t.Fatal("Expected schema attributes to be defined")
+	}
+
+	// Check name_contains attribute
+	nameContainsAttr, ok := resp.Schema.Attributes["name_contains"]
+	if !ok {
+		t.Error("Expected 'name_contains' attribute in schema")
+	} else {
+		if !nameContainsAttr.IsOptional() {
+			t.Error("Expected 'name_contains' attribute to be optional")
+		}
+	}
+
+	// Check policy filter attributes
+	for _, name := range []string{"is_administrator", "is_disabled"} {
+		attr, ok := resp.Schema.Attributes[name]
+		if !ok {
+			t.Errorf("Expected '%s' attribute in schema", name)
+		} else if !attr.IsOptional() {
+			t.Errorf("Expected '%s' attribute to be optional", name)
+		}
+	}
+
+	// Check users attribute
+	usersAttr, ok := resp.Schema.Attributes["users"]
+	if !ok {
+		t.Error("Expected 'users' attribute in schema")
+	} else {
+		if !usersAttr.IsComputed() {
+			t.Error("Expected 'users' attribute to be computed")
+		}
+	}
+
+	// Check returned_count attribute
+	returnedCountAttr, ok := resp.Schema.Attributes["returned_count"]
+	if !ok {
+		t.Error("Expected 'returned_count' attribute in schema")
+	} else {
+		if !returnedCountAttr.IsComputed() {
+			t.Error("Expected 'returned_count' attribute to be computed")
+		}
+	}
+
+	// Check schema has a description
+	if resp.Schema.MarkdownDescription == "" {
+		t.Error("Expected schema to have a markdown description")
+	}
+}
+
+func TestUsersDataSource_Configure_nilProviderData(t *testing.T) {
+	ds := &UsersDataSource{}
+	req := datasource.ConfigureRequest{
+		ProviderData: nil,
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	// Should not error when provider data is nil (early return)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestUsersDataSource_Configure_wrongType(t *testing.T) {
+	ds := &UsersDataSource{}
+	req := datasource.ConfigureRequest{
+		ProviderData: "wrong type", // Should be *client.Client
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error when provider data is wrong type")
+	}
+}
+
+func TestUsersDataSource_Configure_success(t *testing.T) {
+	ds := &UsersDataSource{}
+	c := client.NewClient("http://localhost:8096", "test-key")
+	req := datasource.ConfigureRequest{
+		ProviderData: c,
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	ds.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics.Errors())
+	}
+
+	if ds.client != c {
+		t.Error("Expected client to be set")
+	}
+}
+
+func TestNewUsersDataSource(t *testing.T) {
+	ds := NewUsersDataSource()
+	if ds == nil {
+		t.Error("Expected data source to be instantiated")
+	}
+
+	_, ok := ds.(*UsersDataSource)
+	if !ok {
+		t.Error("Expected data source to be *UsersDataSource")
+	}
+}
+
+func TestFilterUsersByName(t *testing.T) {
+	users := []client.User{
+		{Id: "1", Name: "Alice"},
+		{Id: "2", Name: "bob"},
+		{Id: "3", Name: "MALICE"},
+	}
+
+	if got := filterUsersByName(users, ""); len(got) != 3 {
+		t.Errorf("Expected empty filter to match all 3 users, got %d", len(got))
+	}
+
+	got := filterUsersByName(users, "alic")
+	if len(got) != 2 || got[0].Id != "1" || got[1].Id != "3" {
+		t.Errorf("Expected users 1 and 3, got %+v", got)
+	}
+
+	if got := filterUsersByName(users, "carol"); len(got) != 0 {
+		t.Errorf("Expected no matches, got %d", len(got))
+	}
+}
+
+func TestFilterUsersByPolicy(t *testing.T) {
+	var users []client.User
+	payload := `[
+		{"Id":"1","Name":"admin","Policy":{"IsAdministrator":true,"IsDisabled":false}},
+		{"Id":"2","Name":"alice","Policy":{"IsAdministrator":false,"IsDisabled":false}},
+		{"Id":"3","Name":"former","Policy":{"IsAdministrator":false,"IsDisabled":true}},
+		{"Id":"4","Name":"legacy"}
+	]`
+	if err := json.Unmarshal([]byte(payload), &users); err != nil {
+		t.Fatalf("Failed to decode users: %v", err)
+	}
+
+	if got := filterUsersByPolicy(users, "IsDisabled", types.BoolNull()); len(got) != 4 {
+		t.Errorf("Expected a null filter to match all 4 users, got %d", len(got))
+	}
+
+	got := filterUsersByPolicy(users, "IsDisabled", types.BoolValue(true))
+	if len(got) != 1 || got[0].Id != "3" {
+		t.Errorf("Expected only user 3 to be disabled, got %+v", got)
+	}
+
+	got = filterUsersByPolicy(users, "IsDisabled", types.BoolValue(false))
+	if len(got) != 3 || got[0].Id != "1" || got[1].Id != "2" || got[2].Id != "4" {
+		t.Errorf("Expected users 1, 2 and 4 to be enabled, got %+v", got)
+	}
+
+	got = filterUsersByPolicy(filterUsersByPolicy(users, "IsAdministrator", types.BoolValue(false)), "IsDisabled", types.BoolValue(false))
+	if len(got) != 2 || got[0].Id != "2" || got[1].Id != "4" {
+		t.Errorf("Expected users 2 and 4 to be enabled non-administrators, got %+v", got)
+	}
+}